@@ -17,25 +17,450 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
+	BearerAuthScopes = "bearerAuth.Scopes"
 	CookieAuthScopes = "cookieAuth.Scopes"
 	CsrfTokenScopes  = "csrfToken.Scopes"
 )
 
+// Defines values for AccessTokenScope.
+const (
+	Read  AccessTokenScope = "read"
+	Write AccessTokenScope = "write"
+)
+
+// Defines values for CalendarEntryColumn.
+const (
+	CalendarEntryColumnBacklog CalendarEntryColumn = "backlog"
+	CalendarEntryColumnDoing   CalendarEntryColumn = "doing"
+	CalendarEntryColumnDone    CalendarEntryColumn = "done"
+	CalendarEntryColumnTodo    CalendarEntryColumn = "todo"
+)
+
+// Defines values for CrdtOpColumn.
+const (
+	CrdtOpColumnBacklog CrdtOpColumn = "backlog"
+	CrdtOpColumnDoing   CrdtOpColumn = "doing"
+	CrdtOpColumnDone    CrdtOpColumn = "done"
+	CrdtOpColumnTodo    CrdtOpColumn = "todo"
+)
+
+// Defines values for CrdtOpKind.
+const (
+	CardDelete    CrdtOpKind = "card.delete"
+	CardInsert    CrdtOpKind = "card.insert"
+	DoneSet       CrdtOpKind = "done.set"
+	LabelSet      CrdtOpKind = "label.set"
+	NodeCreate    CrdtOpKind = "node.create"
+	NodeDelete    CrdtOpKind = "node.delete"
+	NodeMove      CrdtOpKind = "node.move"
+	NodePosition  CrdtOpKind = "node.position"
+	ProjectCreate CrdtOpKind = "project.create"
+	ProjectDelete CrdtOpKind = "project.delete"
+	ProjectRename CrdtOpKind = "project.rename"
+)
+
+// Defines values for JsonPatchOpOp.
+const (
+	Add     JsonPatchOpOp = "add"
+	Remove  JsonPatchOpOp = "remove"
+	Replace JsonPatchOpOp = "replace"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	CardDone       WebhookEventType = "card.done"
+	CardMoved      WebhookEventType = "card.moved"
+	NodeCompleted  WebhookEventType = "node.completed"
+	NodeCreated    WebhookEventType = "node.created"
+	NodeDeleted    WebhookEventType = "node.deleted"
+	NodeRenamed    WebhookEventType = "node.renamed"
+	NodeReopened   WebhookEventType = "node.reopened"
+	ProjectCreated WebhookEventType = "project.created"
+	ProjectDeleted WebhookEventType = "project.deleted"
+	ProjectRenamed WebhookEventType = "project.renamed"
+)
+
+// Defines values for GetMinkanParamsInclude.
+const (
+	Progress GetMinkanParamsInclude = "progress"
+)
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt 有効期限（nullの場合は無期限）
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	Id         int64      `json:"id"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	Name       string     `json:"name"`
+
+	// Scope read はGET・HEADのみ、write は更新も可
+	Scope AccessTokenScope `json:"scope"`
+}
+
+// AccessTokenCreateReq defines model for AccessTokenCreateReq.
+type AccessTokenCreateReq struct {
+	// ExpiresAt 有効期限（省略・nullの場合は無期限）
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	// Name 用途が分かる名前（例 "バックアップ用cron"）
+	Name string `json:"name"`
+
+	// Scope read はGET・HEADのみ、write は更新も可
+	Scope AccessTokenScope `json:"scope"`
+}
+
+// AccessTokenCreated defines model for AccessTokenCreated.
+type AccessTokenCreated struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	Id        int64      `json:"id"`
+	Name      string     `json:"name"`
+
+	// Scope read はGET・HEADのみ、write は更新も可
+	Scope AccessTokenScope `json:"scope"`
+
+	// Token 発行したトークン（このレスポンスでのみ返す）
+	Token string `json:"token"`
+}
+
+// AccessTokenScope read はGET・HEADのみ、write は更新も可
+type AccessTokenScope string
+
+// ActivityEvent defines model for ActivityEvent.
+type ActivityEvent struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data 種類ごとの詳細。stateの更新は pjName・label・prevLabel(node.renamed)・from/to(card.moved)、 ログイン・ログアウトは ip・userAgent
+	Data   map[string]interface{} `json:"data"`
+	Id     int64                  `json:"id"`
+	NodeId *string                `json:"nodeId"`
+
+	// Origin 更新元（例 caldav / inbound:http / scheduler:recurrence。画面からの更新はクライアントのID）
+	Origin string  `json:"origin"`
+	PjId   *string `json:"pjId"`
+
+	// Type auth.login / auth.logout / auth.identity_linked / auth.identity_unlinked / account.deletion_requested、 またはWebhookと同じイベントの種類（card.doneを除く）
+	Type string `json:"type"`
+
+	// Version 更新後のminkanのversion（stateの更新以外はnull）
+	Version *int32 `json:"version"`
+}
+
+// ActivityRes defines model for ActivityRes.
+type ActivityRes struct {
+	Events []ActivityEvent `json:"events"`
+
+	// NextBefore 続きを取得する場合のbefore（続きが無い場合はnull）
+	NextBefore *int64 `json:"nextBefore"`
+}
+
+// AnalyticsAgingItem defines model for AnalyticsAgingItem.
+type AnalyticsAgingItem struct {
+	AgeHours *float32 `json:"ageHours"`
+	Label    string   `json:"label"`
+	NodeId   string   `json:"nodeId"`
+	PjId     string   `json:"pjId"`
+
+	// StartedAt doingに入った日時（記録開始前から入っている場合はnull）
+	StartedAt *time.Time `json:"startedAt"`
+}
+
+// AnalyticsFlowDay defines model for AnalyticsFlowDay.
+type AnalyticsFlowDay struct {
+	Backlog int                `json:"backlog"`
+	Date    openapi_types.Date `json:"date"`
+	Doing   int                `json:"doing"`
+	Done    int                `json:"done"`
+	Todo    int                `json:"todo"`
+}
+
+// AnalyticsPercentiles 期間内に完了したカードの所要時間（時間単位、対象が無い場合はnull）
+type AnalyticsPercentiles struct {
+	Count int      `json:"count"`
+	P50   *float32 `json:"p50"`
+	P85   *float32 `json:"p85"`
+	P95   *float32 `json:"p95"`
+}
+
+// AnalyticsRes defines model for AnalyticsRes.
+type AnalyticsRes struct {
+	// CumulativeFlow 日ごとの終わり時点（今日は現在時点）の各カラムのカード数
+	CumulativeFlow []AnalyticsFlowDay `json:"cumulativeFlow"`
+
+	// CycleTime 期間内に完了したカードの所要時間（時間単位、対象が無い場合はnull）
+	CycleTime AnalyticsPercentiles `json:"cycleTime"`
+	From      openapi_types.Date   `json:"from"`
+
+	// LeadTime 期間内に完了したカードの所要時間（時間単位、対象が無い場合はnull）
+	LeadTime AnalyticsPercentiles `json:"leadTime"`
+
+	// Throughput 週（月曜始まり）ごとの完了数
+	Throughput []AnalyticsWeek `json:"throughput"`
+
+	// TimeZone 日付・週の区切りに使ったタイムゾーン
+	TimeZone string             `json:"timeZone"`
+	To       openapi_types.Date `json:"to"`
+
+	// WorkItemAge doingにあるカード（経過時間の長い順）
+	WorkItemAge []AnalyticsAgingItem `json:"workItemAge"`
+}
+
+// AnalyticsWeek defines model for AnalyticsWeek.
+type AnalyticsWeek struct {
+	Count     int                `json:"count"`
+	WeekStart openapi_types.Date `json:"weekStart"`
+}
+
+// AppPassword defines model for AppPassword.
+type AppPassword struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	Id         int64      `json:"id"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	Name       string     `json:"name"`
+}
+
+// AppPasswordCreateReq defines model for AppPasswordCreateReq.
+type AppPasswordCreateReq struct {
+	// Name 用途が分かる名前（例 "iPhone リマインダー"）
+	Name string `json:"name"`
+}
+
+// AppPasswordCreated defines model for AppPasswordCreated.
+type AppPasswordCreated struct {
+	// CaldavUrl CalDAVクライアントに設定するサーバURL
+	CaldavUrl string    `json:"caldavUrl"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+
+	// Password 発行したパスワード（このレスポンスでのみ返す）
+	Password string `json:"password"`
+}
+
+// AuthProvider defines model for AuthProvider.
+type AuthProvider struct {
+	// Id IdPの識別子（GET /auth/login?provider= に指定する）
+	Id string `json:"id"`
+
+	// Name 表示名
+	Name string `json:"name"`
+}
+
+// CalendarEntry defines model for CalendarEntry.
+type CalendarEntry struct {
+	// Column カンバンのカラム（カンバンに無い場合はnull）
+	Column *CalendarEntryColumn `json:"column"`
+	DueAt  *time.Time           `json:"dueAt,omitempty"`
+	IsDone bool                 `json:"isDone"`
+	Label  string               `json:"label"`
+	NodeId string               `json:"nodeId"`
+
+	// Overdue 未完了かつ期限切れ
+	Overdue  bool       `json:"overdue"`
+	PjId     string     `json:"pjId"`
+	PjName   string     `json:"pjName"`
+	Priority *int       `json:"priority,omitempty"`
+	StartAt  *time.Time `json:"startAt,omitempty"`
+}
+
+// CalendarEntryColumn カンバンのカラム（カンバンに無い場合はnull）
+type CalendarEntryColumn string
+
+// CalendarFeedStatus defines model for CalendarFeedStatus.
+type CalendarFeedStatus struct {
+	// CreatedAt URLの発行日時
+	CreatedAt *time.Time `json:"createdAt"`
+
+	// Enabled フィードのURLが発行済みの場合true
+	Enabled bool `json:"enabled"`
+
+	// LastAccessedAt カレンダーアプリから最後に取得された日時
+	LastAccessedAt *time.Time `json:"lastAccessedAt"`
+}
+
+// CalendarFeedToken defines model for CalendarFeedToken.
+type CalendarFeedToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Url フィードの秘密URL（このレスポンスでのみ返す）
+	Url string `json:"url"`
+}
+
+// CalendarRes defines model for CalendarRes.
+type CalendarRes struct {
+	Entries []CalendarEntry    `json:"entries"`
+	From    openapi_types.Date `json:"from"`
+
+	// TimeZone from・toの解釈に使ったタイムゾーン
+	TimeZone string             `json:"timeZone"`
+	To       openapi_types.Date `json:"to"`
+}
+
+// CrdtId Lamportタイムスタンプ（操作ID）。(c, r)の辞書順で全順序
+type CrdtId struct {
+	// C カウンタ
+	C int64 `json:"c"`
+
+	// R レプリカID
+	R string `json:"r"`
+}
+
+// CrdtOp CRDT操作。kindごとに必要なフィールドが異なる
+type CrdtOp struct {
+	// After Lamportタイムスタンプ（操作ID）。(c, r)の辞書順で全順序
+	After  *CrdtId       `json:"after,omitempty"`
+	Column *CrdtOpColumn `json:"column,omitempty"`
+	Done   *bool         `json:"done,omitempty"`
+
+	// Id Lamportタイムスタンプ（操作ID）。(c, r)の辞書順で全順序
+	Id       CrdtId     `json:"id"`
+	Kind     CrdtOpKind `json:"kind"`
+	Label    *string    `json:"label,omitempty"`
+	NodeId   *string    `json:"nodeId,omitempty"`
+	ParentId *string    `json:"parentId,omitempty"`
+	PjId     string     `json:"pjId"`
+	Position *struct {
+		X float32 `json:"x"`
+		Y float32 `json:"y"`
+	} `json:"position,omitempty"`
+
+	// Target Lamportタイムスタンプ（操作ID）。(c, r)の辞書順で全順序
+	Target *CrdtId `json:"target,omitempty"`
+}
+
+// CrdtOpColumn defines model for CrdtOp.Column.
+type CrdtOpColumn string
+
+// CrdtOpKind defines model for CrdtOp.Kind.
+type CrdtOpKind string
+
 // Healthz defines model for Healthz.
 type Healthz struct {
 	Message string `json:"message"`
 }
 
+// InboundCaptureReq defines model for InboundCaptureReq.
+type InboundCaptureReq struct {
+	// Body ノードのコメント（10000文字を超える分は切り詰める）
+	Body *string `json:"body,omitempty"`
+
+	// Title ノードのラベル（200文字を超える分は切り詰める）
+	Title *string `json:"title,omitempty"`
+}
+
+// InboundCaptureRes defines model for InboundCaptureRes.
+type InboundCaptureRes struct {
+	NodeId string `json:"nodeId"`
+	PjId   string `json:"pjId"`
+
+	// Version 取り込み後のminkanのversion
+	Version int32 `json:"version"`
+}
+
+// InboundSettingsReq defines model for InboundSettingsReq.
+type InboundSettingsReq struct {
+	// PjId 取り込み先のプロジェクト（省略・nullの場合は作業中のプロジェクト）
+	PjId *string `json:"pjId"`
+}
+
+// InboundStatus defines model for InboundStatus.
+type InboundStatus struct {
+	CreatedAt *time.Time `json:"createdAt"`
+
+	// Enabled URLが発行済みの場合true
+	Enabled bool `json:"enabled"`
+
+	// LastUsedAt 最後に取り込んだ日時
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+
+	// MailEnabled メールでの取り込みが使える場合true（サーバ設定による）
+	MailEnabled bool `json:"mailEnabled"`
+
+	// PjId 取り込み先のプロジェクト（nullの場合は作業中のプロジェクト）
+	PjId *string `json:"pjId"`
+}
+
+// InboundToken defines model for InboundToken.
+type InboundToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email 取り込み用のメールアドレス（メールでの取り込みが使えない場合はnull）
+	Email *string `json:"email"`
+	PjId  *string `json:"pjId"`
+
+	// Url 取り込み用の秘密URL（このレスポンスでのみ返す）
+	Url string `json:"url"`
+}
+
+// JsonPatchOp JSON Patch(RFC 6902)の1操作。サーバが生成するのは add / remove / replace のみ
+type JsonPatchOp struct {
+	Op JsonPatchOpOp `json:"op"`
+
+	// Path JSON Pointer(RFC 6901)
+	Path string `json:"path"`
+
+	// Value add / replace の値（removeでは省略）
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JsonPatchOpOp defines model for JsonPatchOp.Op.
+type JsonPatchOpOp string
+
+// LogoutResult defines model for LogoutResult.
+type LogoutResult struct {
+	// EndSessionUrl IdPからもログアウトするURL（IdPが対応していない場合はnull）
+	EndSessionUrl *string `json:"endSessionUrl"`
+}
+
+// MinkanChangesRes defines model for MinkanChangesRes.
+type MinkanChangesRes struct {
+	Changes []MinkanRevision `json:"changes"`
+
+	// Reload trueの場合は差分を返せないため、GET /minkan で全体を取得し直す
+	Reload bool `json:"reload"`
+
+	// Version changesを全て適用した後のversion（reload時は現在のversion）
+	Version int32 `json:"version"`
+}
+
+// MinkanEvent minkan更新通知(SSEのdata部)
+type MinkanEvent struct {
+	// Origin 更新元クライアントの識別子(X-Minkan-Origin)。不明な場合は空文字
+	Origin    string    `json:"origin"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Version 更新後のversion
+	Version int32 `json:"version"`
+}
+
 // MinkanGetRes Minkan + version(GET/minkanのresボディ)
 type MinkanGetRes struct {
 	// Minkan Raw JSON blob of Minkan state
 	Minkan json.RawMessage `json:"minkan"`
 
+	// Progress include=progressの場合のみ。pjId → プロジェクトの進捗
+	Progress *json.RawMessage `json:"progress,omitempty"`
+
+	// SchemaVersion minkanのJSONスキーマのバージョン（2でNodeDataにdueAt/startAt/priorityを追加）
+	SchemaVersion int `json:"schemaVersion"`
+
 	// Version 楽観ロック用version
 	Version int32 `json:"version"`
 }
@@ -55,984 +480,8482 @@ type MinkanPutRes struct {
 	Version int32 `json:"version"`
 }
 
-// User defines model for User.
-type User struct {
-	DisplayName *string `json:"displayName"`
-	Email       *string `json:"email"`
+// MinkanRevision defines model for MinkanRevision.
+type MinkanRevision struct {
+	// Origin 更新元クライアントの識別子。不明な場合は空文字
+	Origin    string        `json:"origin"`
+	Patch     []JsonPatchOp `json:"patch"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+
+	// Version このpatchを適用した後のversion
+	Version int32 `json:"version"`
 }
 
-// PutMinkanJSONRequestBody defines body for PutMinkan for application/json ContentType.
-type PutMinkanJSONRequestBody = MinkanPutReq
+// MinkanSyncReq defines model for MinkanSyncReq.
+type MinkanSyncReq struct {
+	// Ops 前回の同期以降に端末で行った操作（カウンタ順）
+	Ops []CrdtOp `json:"ops"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// ReplicaId 端末(レプリカ)のID。opsのid.rと一致させる
+	ReplicaId string `json:"replicaId"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// Since 前回の同期で受け取ったseq（初回は0）
+	Since int64 `json:"since"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// MinkanSyncRes defines model for MinkanSyncRes.
+type MinkanSyncRes struct {
+	// Clock サーバが観測した最大カウンタ。端末のLamportクロックをこれ以上に進める
+	Clock int64 `json:"clock"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// Document CRDTドキュメント全体（reset時のみ）
+	Document *json.RawMessage `json:"document,omitempty"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Ops 端末が未受信の他レプリカの操作（seq順）
+	Ops []struct {
+		// Op CRDT操作。kindごとに必要なフィールドが異なる
+		Op  CrdtOp `json:"op"`
+		Seq int64  `json:"seq"`
+	} `json:"ops"`
+
+	// Rejected 適用できなかった操作
+	Rejected []struct {
+		Error string `json:"error"`
+
+		// Id Lamportタイムスタンプ（操作ID）。(c, r)の辞書順で全順序
+		Id CrdtId `json:"id"`
+	} `json:"rejected"`
+
+	// Reset trueの場合、端末はdocumentで状態を置き換える
+	Reset bool `json:"reset"`
+
+	// Seq 次回の同期でsinceに指定する値
+	Seq int64 `json:"seq"`
+
+	// Version 同期後のminkan_stateのversion
+	Version int32 `json:"version"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// NotificationSettings defines model for NotificationSettings.
+type NotificationSettings struct {
+	// EmailOverdue 期限切れの通知メール
+	EmailOverdue bool `json:"emailOverdue"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// EmailReminders 期限前のリマインダーメール
+	EmailReminders bool `json:"emailReminders"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// PendingIdentityLink defines model for PendingIdentityLink.
+type PendingIdentityLink struct {
+	// Email 連携するIdPのメールアドレス（既存のアカウントと一致したもの）
+	Email    string       `json:"email"`
+	Provider AuthProvider `json:"provider"`
+
+	// SignInWith 既存のアカウントにログインできるIdP
+	SignInWith []AuthProvider `json:"signInWith"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// ProgressCounts 末端タスクの数と、そのうち完了したものの数
+type ProgressCounts struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GetAuthCallback request
-	GetAuthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// ProjectProgress defines model for ProjectProgress.
+type ProjectProgress struct {
+	Done int `json:"done"`
 
-	// GetAuthLogin request
-	GetAuthLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Nodes ノードID → そのノードを根とする部分木の集計
+	Nodes map[string]ProgressCounts `json:"nodes"`
+	PjId  string                    `json:"pjId"`
 
-	// PostAuthLogout request
-	PostAuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Total プロジェクト全体の末端タスクの数（ルートノードを除く）
+	Total int `json:"total"`
 
-	// GetHealthz request
-	GetHealthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Version 集計したminkanのversion
+	Version int32 `json:"version"`
+}
 
-	// GetMinkan request
-	GetMinkan(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// RecurrencePreviewReq defines model for RecurrencePreviewReq.
+type RecurrencePreviewReq struct {
+	// DueAt 最初の期限（繰り返しの起点）
+	DueAt time.Time `json:"dueAt"`
 
-	// PutMinkanWithBody request with any body
-	PutMinkanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Rule RRULE
+	Rule string `json:"rule"`
+}
 
-	PutMinkan(ctx context.Context, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// RecurrencePreviewRes defines model for RecurrencePreviewRes.
+type RecurrencePreviewRes struct {
+	Occurrences []time.Time `json:"occurrences"`
 
-	// DeleteUsersMe request
-	DeleteUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Rule 正規化したRRULE
+	Rule string `json:"rule"`
 
-	// GetUsersMe request
-	GetUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TimeZone 曜日・日付の判定に使ったタイムゾーン
+	TimeZone string `json:"timeZone"`
 }
 
-func (c *Client) GetAuthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAuthCallbackRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// User defines model for User.
+type User struct {
+	DisplayName *string `json:"displayName"`
+	Email       *string `json:"email"`
+
+	// TimeZone 日付の解釈に使うIANAタイムゾーン名（未設定の場合はサーバのデフォルト）
+	TimeZone string `json:"timeZone"`
+
+	// TimeZoneIsDefault タイムゾーンが未設定でサーバのデフォルトを使っている場合true
+	TimeZoneIsDefault bool `json:"timeZoneIsDefault"`
 }
 
-func (c *Client) GetAuthLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAuthLoginRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UserIdentity defines model for UserIdentity.
+type UserIdentity struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email 連携時のIdPのメールアドレス
+	Email       *string    `json:"email"`
+	Id          int64      `json:"id"`
+	LastLoginAt *time.Time `json:"lastLoginAt"`
+
+	// Provider IdPの識別子
+	Provider string `json:"provider"`
+
+	// ProviderName IdPの表示名（設定から外したIdPは識別子）
+	ProviderName string `json:"providerName"`
 }
 
-func (c *Client) PostAuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAuthLogoutRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UserIdentityLinkReq defines model for UserIdentityLinkReq.
+type UserIdentityLinkReq struct {
+	// Provider 連携するIdPの識別子（GET /auth/providers の id）
+	Provider string `json:"provider"`
 }
 
-func (c *Client) GetHealthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthzRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UserIdentityLinkStarted defines model for UserIdentityLinkStarted.
+type UserIdentityLinkStarted struct {
+	// LoginUrl IdPへのログインURL（このURLへ遷移する）
+	LoginUrl string `json:"loginUrl"`
 }
 
-func (c *Client) GetMinkan(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMinkanRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UserPatchReq defines model for UserPatchReq.
+type UserPatchReq struct {
+	// TimeZone IANAタイムゾーン名（例 Asia/Tokyo）。空文字で未設定に戻す
+	TimeZone string `json:"timeZone"`
 }
 
-func (c *Client) PutMinkanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutMinkanRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UserSession defines model for UserSession.
+type UserSession struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current このリクエストのセッションかどうか
+	Current   bool      `json:"current"`
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id セッションの識別子（セッションIDそのものではない）
+	Id string `json:"id"`
+
+	// Ip ログイン時の接続元IPアドレス
+	Ip         string    `json:"ip"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+
+	// UserAgent ログイン時のUser-Agent
+	UserAgent string `json:"userAgent"`
 }
 
-func (c *Client) PutMinkan(ctx context.Context, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutMinkanRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active    bool               `json:"active"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Events    []WebhookEventType `json:"events"`
+	Id        int64              `json:"id"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	Url       string             `json:"url"`
 }
 
-func (c *Client) DeleteUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUsersMeRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// WebhookCreated defines model for WebhookCreated.
+type WebhookCreated struct {
+	Active    bool               `json:"active"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Events    []WebhookEventType `json:"events"`
+	Id        int64              `json:"id"`
+
+	// Secret 署名の鍵（このレスポンスでのみ返す）
+	Secret    string    `json:"secret"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Url       string    `json:"url"`
 }
 
-func (c *Client) GetUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersMeRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// WebhookDeliveriesRes defines model for WebhookDeliveriesRes.
+type WebhookDeliveriesRes struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// NextBefore 続きを取得する場合のbefore（続きが無い場合はnull）
+	NextBefore *int64 `json:"nextBefore"`
 }
 
-// NewGetAuthCallbackRequest generates requests for GetAuthCallback
-func NewGetAuthCallbackRequest(server string) (*http.Request, error) {
-	var err error
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// EventId リクエストボディのid（再送でも同じ値）
+	EventId    string     `json:"eventId"`
+	EventType  string     `json:"eventType"`
+	FinishedAt *time.Time `json:"finishedAt"`
 
-	operationPath := fmt.Sprintf("/auth/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Id X-Minkan-Delivery と同じ値
+	Id        int64   `json:"id"`
+	LastError *string `json:"lastError"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// LastStatusCode 最後の送信のHTTPステータス（未送信・接続失敗の場合はnull）
+	LastStatusCode *int `json:"lastStatusCode"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// NextAttemptAt 次に送信する日時（pendingの場合のみ）
+	NextAttemptAt *time.Time `json:"nextAttemptAt"`
 
-	return req, nil
+	// Payload 送信するリクエストボディ
+	Payload json.RawMessage       `json:"payload"`
+	Status  WebhookDeliveryStatus `json:"status"`
 }
 
-// NewGetAuthLoginRequest generates requests for GetAuthLogin
-func NewGetAuthLoginRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
 
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// WebhookEventType node.renamed はノードのラベルの変更で、dataに変更前のラベル(prevLabel)を含む。 同じ更新で完了・完了の取り消しも起きた場合は node.completed / node.reopened も発生する。 card.moved はカンバンのカラム移動（追加・除外を含む）。 card.done は完了カラムへの移動で、card.moved と同時に発生する。
+type WebhookEventType string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// WebhookReq defines model for WebhookReq.
+type WebhookReq struct {
+	// Active falseの場合は送信しない（省略時true）
+	Active *bool              `json:"active,omitempty"`
+	Events []WebhookEventType `json:"events"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Secret 署名の鍵（登録時に省略した場合はサーバで生成する）
+	Secret *string `json:"secret,omitempty"`
 
-	return req, nil
+	// Url 送信先（http/https）
+	Url string `json:"url"`
 }
 
-// NewPostAuthLogoutRequest generates requests for PostAuthLogout
-func NewPostAuthLogoutRequest(server string) (*http.Request, error) {
-	var err error
+// MinkanOrigin defines model for MinkanOrigin.
+type MinkanOrigin = string
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PreviewCount defines model for PreviewCount.
+type PreviewCount = int
 
-	operationPath := fmt.Sprintf("/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// UnsubscribeToken defines model for UnsubscribeToken.
+type UnsubscribeToken = string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// WebhookId defines model for WebhookId.
+type WebhookId = int64
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetActivityParams defines parameters for GetActivity.
+type GetActivityParams struct {
+	PjId *string `form:"pjId,omitempty" json:"pjId,omitempty"`
 
-	return req, nil
-}
+	// NodeId pjIdと併せて指定する
+	NodeId *string `form:"nodeId,omitempty" json:"nodeId,omitempty"`
 
-// NewGetHealthzRequest generates requests for GetHealthz
-func NewGetHealthzRequest(server string) (*http.Request, error) {
-	var err error
+	// From この日時以降の履歴を返す
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// To この日時より前の履歴を返す
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 
-	operationPath := fmt.Sprintf("/healthz")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Limit 返す件数（既定50、最大200）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Before 前回のレスポンスのnextBefore（このIDより古い履歴を返す）
+	Before *int64 `form:"before,omitempty" json:"before,omitempty"`
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetAnalyticsParams defines parameters for GetAnalytics.
+type GetAnalyticsParams struct {
+	// PjId 指定した場合はそのプロジェクトのカードのみを対象とする
+	PjId *string             `form:"pjId,omitempty" json:"pjId,omitempty"`
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To   *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
 
-	return req, nil
+// GetAuthLoginParams defines parameters for GetAuthLogin.
+type GetAuthLoginParams struct {
+	// Provider IdPの識別子（GET /auth/providers の id）
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
 }
 
-// NewGetMinkanRequest generates requests for GetMinkan
-func NewGetMinkanRequest(server string) (*http.Request, error) {
-	var err error
+// GetCalendarParams defines parameters for GetCalendar.
+type GetCalendarParams struct {
+	From openapi_types.Date `form:"from" json:"from"`
+	To   openapi_types.Date `form:"to" json:"to"`
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// GetMinkanParams defines parameters for GetMinkan.
+type GetMinkanParams struct {
+	// Include progressを指定した場合は全プロジェクトの進捗(progress)も返す
+	Include *GetMinkanParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+}
 
-	operationPath := fmt.Sprintf("/minkan")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// GetMinkanParamsInclude defines parameters for GetMinkan.
+type GetMinkanParamsInclude string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PutMinkanParams defines parameters for PutMinkan.
+type PutMinkanParams struct {
+	// XMinkanOrigin 更新元クライアント(タブ・端末)の識別子。更新通知のoriginとしてそのまま配信される
+	XMinkanOrigin *MinkanOrigin `json:"X-Minkan-Origin,omitempty"`
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetMinkanChangesParams defines parameters for GetMinkanChanges.
+type GetMinkanChangesParams struct {
+	// Since クライアントが保持しているminkanのversion
+	Since int32 `form:"since" json:"since"`
+}
 
-	return req, nil
+// GetMinkanLiveParams defines parameters for GetMinkanLive.
+type GetMinkanLiveParams struct {
+	// CsrfToken csrf_token Cookieの値（セッションIDに紐づくトークン）。 パーソナルアクセストークン(scope=write)で接続する場合は検証しないため任意の値でよい（scope=readは接続不可）
+	CsrfToken string `form:"csrfToken" json:"csrfToken"`
+
+	// DeviceId 端末の識別子（presence表示用）
+	DeviceId *string `form:"deviceId,omitempty" json:"deviceId,omitempty"`
+
+	// DeviceName 端末の表示名（presence表示用）
+	DeviceName *string `form:"deviceName,omitempty" json:"deviceName,omitempty"`
 }
 
-// NewPutMinkanRequest calls the generic PutMinkan builder with application/json body
-func NewPutMinkanRequest(server string, body PutMinkanJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutMinkanRequestWithBody(server, "application/json", bodyReader)
+// GetNotificationsUnsubscribeParams defines parameters for GetNotificationsUnsubscribe.
+type GetNotificationsUnsubscribeParams struct {
+	// Token 配信停止トークン（メール内のリンクに含まれる。発行から90日を過ぎると無効）
+	Token UnsubscribeToken `form:"token" json:"token"`
 }
 
-// NewPutMinkanRequestWithBody generates requests for PutMinkan with any type of body
-func NewPutMinkanRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// PostNotificationsUnsubscribeParams defines parameters for PostNotificationsUnsubscribe.
+type PostNotificationsUnsubscribeParams struct {
+	// Token 配信停止トークン（メール内のリンクに含まれる。発行から90日を過ぎると無効）
+	Token UnsubscribeToken `form:"token" json:"token"`
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// GetProjectsPjIdNodesNodeIdOccurrencesParams defines parameters for GetProjectsPjIdNodesNodeIdOccurrences.
+type GetProjectsPjIdNodesNodeIdOccurrencesParams struct {
+	// Count 返す件数（既定5、最大50）
+	Count *PreviewCount `form:"count,omitempty" json:"count,omitempty"`
+}
 
-	operationPath := fmt.Sprintf("/minkan")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PostRecurrencePreviewParams defines parameters for PostRecurrencePreview.
+type PostRecurrencePreviewParams struct {
+	// Count 返す件数（既定5、最大50）
+	Count *PreviewCount `form:"count,omitempty" json:"count,omitempty"`
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// GetWebhooksWebhookIdDeliveriesParams defines parameters for GetWebhooksWebhookIdDeliveries.
+type GetWebhooksWebhookIdDeliveriesParams struct {
+	// Limit 返す件数（既定20、最大100）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// Before 前回のレスポンスのnextBefore（このIDより古い記録を返す）
+	Before *int64 `form:"before,omitempty" json:"before,omitempty"`
+}
 
-	req.Header.Add("Content-Type", contentType)
+// PostInboundInboundTokenJSONRequestBody defines body for PostInboundInboundToken for application/json ContentType.
+type PostInboundInboundTokenJSONRequestBody = InboundCaptureReq
 
-	return req, nil
-}
+// PutMinkanJSONRequestBody defines body for PutMinkan for application/json ContentType.
+type PutMinkanJSONRequestBody = MinkanPutReq
 
-// NewDeleteUsersMeRequest generates requests for DeleteUsersMe
-func NewDeleteUsersMeRequest(server string) (*http.Request, error) {
-	var err error
+// PostMinkanSyncJSONRequestBody defines body for PostMinkanSync for application/json ContentType.
+type PostMinkanSyncJSONRequestBody = MinkanSyncReq
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PostRecurrencePreviewJSONRequestBody defines body for PostRecurrencePreview for application/json ContentType.
+type PostRecurrencePreviewJSONRequestBody = RecurrencePreviewReq
 
-	operationPath := fmt.Sprintf("/users/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PatchUsersMeJSONRequestBody defines body for PatchUsersMe for application/json ContentType.
+type PatchUsersMeJSONRequestBody = UserPatchReq
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PostUsersMeAppPasswordsJSONRequestBody defines body for PostUsersMeAppPasswords for application/json ContentType.
+type PostUsersMeAppPasswordsJSONRequestBody = AppPasswordCreateReq
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// PostUsersMeIdentitiesJSONRequestBody defines body for PostUsersMeIdentities for application/json ContentType.
+type PostUsersMeIdentitiesJSONRequestBody = UserIdentityLinkReq
 
-	return req, nil
-}
+// PostUsersMeInboundJSONRequestBody defines body for PostUsersMeInbound for application/json ContentType.
+type PostUsersMeInboundJSONRequestBody = InboundSettingsReq
 
-// NewGetUsersMeRequest generates requests for GetUsersMe
-func NewGetUsersMeRequest(server string) (*http.Request, error) {
-	var err error
+// PutUsersMeInboundJSONRequestBody defines body for PutUsersMeInbound for application/json ContentType.
+type PutUsersMeInboundJSONRequestBody = InboundSettingsReq
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PutUsersMeNotificationsJSONRequestBody defines body for PutUsersMeNotifications for application/json ContentType.
+type PutUsersMeNotificationsJSONRequestBody = NotificationSettings
 
-	operationPath := fmt.Sprintf("/users/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PostUsersMeTokensJSONRequestBody defines body for PostUsersMeTokens for application/json ContentType.
+type PostUsersMeTokensJSONRequestBody = AccessTokenCreateReq
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookReq
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// PutWebhooksWebhookIdJSONRequestBody defines body for PutWebhooksWebhookId for application/json ContentType.
+type PutWebhooksWebhookIdJSONRequestBody = WebhookReq
 
-	return req, nil
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
 	}
-	return &ClientWithResponses{client}, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
+		c.RequestEditors = append(c.RequestEditors, fn)
 		return nil
 	}
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAuthCallbackWithResponse request
-	GetAuthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthCallbackResponse, error)
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetActivity request
+	GetActivity(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAuthLoginWithResponse request
-	GetAuthLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthLoginResponse, error)
+	// GetAnalytics request
+	GetAnalytics(ctx context.Context, params *GetAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostAuthLogoutWithResponse request
-	PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+	// GetAuthCallback request
+	GetAuthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetHealthzWithResponse request
-	GetHealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthzResponse, error)
+	// GetAuthCallbackProvider request
+	GetAuthCallbackProvider(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetMinkanWithResponse request
-	GetMinkanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinkanResponse, error)
+	// DeleteAuthLink request
+	DeleteAuthLink(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PutMinkanWithBodyWithResponse request with any body
-	PutMinkanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error)
+	// GetAuthLink request
+	GetAuthLink(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthLogin request
+	GetAuthLogin(ctx context.Context, params *GetAuthLoginParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutMinkanWithResponse(ctx context.Context, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error)
+	// PostAuthLogout request
+	PostAuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteUsersMeWithResponse request
-	DeleteUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeResponse, error)
+	// GetAuthProviders request
+	GetAuthProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetUsersMeWithResponse request
-	GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error)
+	// GetCalendar request
+	GetCalendar(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarFeedFeedFile request
+	GetCalendarFeedFeedFile(ctx context.Context, feedFile string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealthz request
+	GetHealthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInboundInboundTokenWithBody request with any body
+	PostInboundInboundTokenWithBody(ctx context.Context, inboundToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostInboundInboundToken(ctx context.Context, inboundToken string, body PostInboundInboundTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMinkan request
+	GetMinkan(ctx context.Context, params *GetMinkanParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutMinkanWithBody request with any body
+	PutMinkanWithBody(ctx context.Context, params *PutMinkanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutMinkan(ctx context.Context, params *PutMinkanParams, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMinkanChanges request
+	GetMinkanChanges(ctx context.Context, params *GetMinkanChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMinkanEvents request
+	GetMinkanEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMinkanLive request
+	GetMinkanLive(ctx context.Context, params *GetMinkanLiveParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMinkanSyncWithBody request with any body
+	PostMinkanSyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMinkanSync(ctx context.Context, body PostMinkanSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationsUnsubscribe request
+	GetNotificationsUnsubscribe(ctx context.Context, params *GetNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNotificationsUnsubscribe request
+	PostNotificationsUnsubscribe(ctx context.Context, params *PostNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectsPjIdNodesNodeIdOccurrences request
+	GetProjectsPjIdNodesNodeIdOccurrences(ctx context.Context, pjId string, nodeId string, params *GetProjectsPjIdNodesNodeIdOccurrencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectsPjIdProgress request
+	GetProjectsPjIdProgress(ctx context.Context, pjId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRecurrencePreviewWithBody request with any body
+	PostRecurrencePreviewWithBody(ctx context.Context, params *PostRecurrencePreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRecurrencePreview(ctx context.Context, params *PostRecurrencePreviewParams, body PostRecurrencePreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMe request
+	DeleteUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMe request
+	GetUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchUsersMeWithBody request with any body
+	PatchUsersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchUsersMe(ctx context.Context, body PatchUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeAppPasswords request
+	GetUsersMeAppPasswords(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeAppPasswordsWithBody request with any body
+	PostUsersMeAppPasswordsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersMeAppPasswords(ctx context.Context, body PostUsersMeAppPasswordsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeAppPasswordsAppPasswordId request
+	DeleteUsersMeAppPasswordsAppPasswordId(ctx context.Context, appPasswordId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeCalendarFeed request
+	DeleteUsersMeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeCalendarFeed request
+	GetUsersMeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeCalendarFeed request
+	PostUsersMeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeIdentities request
+	GetUsersMeIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeIdentitiesWithBody request with any body
+	PostUsersMeIdentitiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersMeIdentities(ctx context.Context, body PostUsersMeIdentitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeIdentitiesPending request
+	PostUsersMeIdentitiesPending(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeIdentitiesIdentityId request
+	DeleteUsersMeIdentitiesIdentityId(ctx context.Context, identityId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeInbound request
+	DeleteUsersMeInbound(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeInbound request
+	GetUsersMeInbound(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeInboundWithBody request with any body
+	PostUsersMeInboundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersMeInbound(ctx context.Context, body PostUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutUsersMeInboundWithBody request with any body
+	PutUsersMeInboundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUsersMeInbound(ctx context.Context, body PutUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeNotifications request
+	GetUsersMeNotifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutUsersMeNotificationsWithBody request with any body
+	PutUsersMeNotificationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUsersMeNotifications(ctx context.Context, body PutUsersMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeSessions request
+	DeleteUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeSessions request
+	GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeSessionsSessionId request
+	DeleteUsersMeSessionsSessionId(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeTokens request
+	GetUsersMeTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeTokensWithBody request with any body
+	PostUsersMeTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersMeTokens(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeTokensTokenId request
+	DeleteUsersMeTokensTokenId(ctx context.Context, tokenId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWithBody request with any body
+	PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhooksWebhookId request
+	DeleteWebhooksWebhookId(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutWebhooksWebhookIdWithBody request with any body
+	PutWebhooksWebhookIdWithBody(ctx context.Context, webhookId WebhookId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutWebhooksWebhookId(ctx context.Context, webhookId WebhookId, body PutWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksWebhookIdDeliveries request
+	GetWebhooksWebhookIdDeliveries(ctx context.Context, webhookId WebhookId, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWebhookIdPing request
+	PostWebhooksWebhookIdPing(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-type GetAuthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *Client) GetActivity(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActivityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAuthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetAnalytics(ctx context.Context, params *GetAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnalyticsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAuthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetAuthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthCallbackRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetAuthLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *Client) GetAuthCallbackProvider(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthCallbackProviderRequest(c.Server, provider)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAuthLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) DeleteAuthLink(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAuthLinkRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAuthLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetAuthLink(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthLinkRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthLogin(ctx context.Context, params *GetAuthLoginParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthLoginRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthProvidersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendar(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendarFeedFeedFile(ctx context.Context, feedFile string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarFeedFeedFileRequest(c.Server, feedFile)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInboundInboundTokenWithBody(ctx context.Context, inboundToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInboundInboundTokenRequestWithBody(c.Server, inboundToken, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInboundInboundToken(ctx context.Context, inboundToken string, body PostInboundInboundTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInboundInboundTokenRequest(c.Server, inboundToken, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMinkan(ctx context.Context, params *GetMinkanParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMinkanRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMinkanWithBody(ctx context.Context, params *PutMinkanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMinkanRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMinkan(ctx context.Context, params *PutMinkanParams, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMinkanRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMinkanChanges(ctx context.Context, params *GetMinkanChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMinkanChangesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMinkanEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMinkanEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMinkanLive(ctx context.Context, params *GetMinkanLiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMinkanLiveRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMinkanSyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMinkanSyncRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMinkanSync(ctx context.Context, body PostMinkanSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMinkanSyncRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNotificationsUnsubscribe(ctx context.Context, params *GetNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsUnsubscribeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostNotificationsUnsubscribe(ctx context.Context, params *PostNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNotificationsUnsubscribeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectsPjIdNodesNodeIdOccurrences(ctx context.Context, pjId string, nodeId string, params *GetProjectsPjIdNodesNodeIdOccurrencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsPjIdNodesNodeIdOccurrencesRequest(c.Server, pjId, nodeId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectsPjIdProgress(ctx context.Context, pjId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsPjIdProgressRequest(c.Server, pjId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRecurrencePreviewWithBody(ctx context.Context, params *PostRecurrencePreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRecurrencePreviewRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRecurrencePreview(ctx context.Context, params *PostRecurrencePreviewParams, body PostRecurrencePreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRecurrencePreviewRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchUsersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsersMeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchUsersMe(ctx context.Context, body PatchUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsersMeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeAppPasswords(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeAppPasswordsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeAppPasswordsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeAppPasswordsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeAppPasswords(ctx context.Context, body PostUsersMeAppPasswordsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeAppPasswordsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeAppPasswordsAppPasswordId(ctx context.Context, appPasswordId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeAppPasswordsAppPasswordIdRequest(c.Server, appPasswordId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeIdentitiesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeIdentitiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeIdentitiesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeIdentities(ctx context.Context, body PostUsersMeIdentitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeIdentitiesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeIdentitiesPending(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeIdentitiesPendingRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeIdentitiesIdentityId(ctx context.Context, identityId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeIdentitiesIdentityIdRequest(c.Server, identityId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeInbound(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeInboundRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeInbound(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeInboundRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeInboundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeInboundRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeInbound(ctx context.Context, body PostUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeInboundRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersMeInboundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersMeInboundRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersMeInbound(ctx context.Context, body PutUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersMeInboundRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeNotifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeNotificationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersMeNotificationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersMeNotificationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersMeNotifications(ctx context.Context, body PutUsersMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersMeNotificationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeSessionsSessionId(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeSessionsSessionIdRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersMeTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeTokensRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeTokensRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersMeTokens(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeTokensRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeTokensTokenId(ctx context.Context, tokenId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeTokensTokenIdRequest(c.Server, tokenId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhooksWebhookId(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhooksWebhookIdRequest(c.Server, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutWebhooksWebhookIdWithBody(ctx context.Context, webhookId WebhookId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWebhooksWebhookIdRequestWithBody(c.Server, webhookId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutWebhooksWebhookId(ctx context.Context, webhookId WebhookId, body PutWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWebhooksWebhookIdRequest(c.Server, webhookId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhooksWebhookIdDeliveries(ctx context.Context, webhookId WebhookId, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksWebhookIdDeliveriesRequest(c.Server, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksWebhookIdPing(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksWebhookIdPingRequest(c.Server, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetActivityRequest generates requests for GetActivity
+func NewGetActivityRequest(server string, params *GetActivityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/activity")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PjId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pjId", runtime.ParamLocationQuery, *params.PjId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NodeId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nodeId", runtime.ParamLocationQuery, *params.NodeId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAnalyticsRequest generates requests for GetAnalytics
+func NewGetAnalyticsRequest(server string, params *GetAnalyticsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/analytics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PjId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pjId", runtime.ParamLocationQuery, *params.PjId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthCallbackRequest generates requests for GetAuthCallback
+func NewGetAuthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthCallbackProviderRequest generates requests for GetAuthCallbackProvider
+func NewGetAuthCallbackProviderRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/callback/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAuthLinkRequest generates requests for DeleteAuthLink
+func NewDeleteAuthLinkRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/link")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthLinkRequest generates requests for GetAuthLink
+func NewGetAuthLinkRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/link")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthLoginRequest generates requests for GetAuthLogin
+func NewGetAuthLoginRequest(server string, params *GetAuthLoginParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Provider != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provider", runtime.ParamLocationQuery, *params.Provider); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthLogoutRequest generates requests for PostAuthLogout
+func NewPostAuthLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthProvidersRequest generates requests for GetAuthProviders
+func NewGetAuthProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarRequest generates requests for GetCalendar
+func NewGetCalendarRequest(server string, params *GetCalendarParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendar")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarFeedFeedFileRequest generates requests for GetCalendarFeedFeedFile
+func NewGetCalendarFeedFeedFileRequest(server string, feedFile string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "feedFile", runtime.ParamLocationPath, feedFile)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendar/feed/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthzRequest generates requests for GetHealthz
+func NewGetHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInboundInboundTokenRequest calls the generic PostInboundInboundToken builder with application/json body
+func NewPostInboundInboundTokenRequest(server string, inboundToken string, body PostInboundInboundTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostInboundInboundTokenRequestWithBody(server, inboundToken, "application/json", bodyReader)
+}
+
+// NewPostInboundInboundTokenRequestWithBody generates requests for PostInboundInboundToken with any type of body
+func NewPostInboundInboundTokenRequestWithBody(server string, inboundToken string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "inboundToken", runtime.ParamLocationPath, inboundToken)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inbound/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMinkanRequest generates requests for GetMinkan
+func NewGetMinkanRequest(server string, params *GetMinkanParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/minkan")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Include != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutMinkanRequest calls the generic PutMinkan builder with application/json body
+func NewPutMinkanRequest(server string, params *PutMinkanParams, body PutMinkanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutMinkanRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutMinkanRequestWithBody generates requests for PutMinkan with any type of body
+func NewPutMinkanRequestWithBody(server string, params *PutMinkanParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/minkan")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XMinkanOrigin != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Minkan-Origin", runtime.ParamLocationHeader, *params.XMinkanOrigin)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Minkan-Origin", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetMinkanChangesRequest generates requests for GetMinkanChanges
+func NewGetMinkanChangesRequest(server string, params *GetMinkanChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/minkan/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMinkanEventsRequest generates requests for GetMinkanEvents
+func NewGetMinkanEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/minkan/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMinkanLiveRequest generates requests for GetMinkanLive
+func NewGetMinkanLiveRequest(server string, params *GetMinkanLiveParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/minkan/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "csrfToken", runtime.ParamLocationQuery, params.CsrfToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.DeviceId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deviceId", runtime.ParamLocationQuery, *params.DeviceId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DeviceName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deviceName", runtime.ParamLocationQuery, *params.DeviceName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMinkanSyncRequest calls the generic PostMinkanSync builder with application/json body
+func NewPostMinkanSyncRequest(server string, body PostMinkanSyncJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMinkanSyncRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMinkanSyncRequestWithBody generates requests for PostMinkanSync with any type of body
+func NewPostMinkanSyncRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/minkan/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNotificationsUnsubscribeRequest generates requests for GetNotificationsUnsubscribe
+func NewGetNotificationsUnsubscribeRequest(server string, params *GetNotificationsUnsubscribeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/unsubscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostNotificationsUnsubscribeRequest generates requests for PostNotificationsUnsubscribe
+func NewPostNotificationsUnsubscribeRequest(server string, params *PostNotificationsUnsubscribeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/unsubscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectsPjIdNodesNodeIdOccurrencesRequest generates requests for GetProjectsPjIdNodesNodeIdOccurrences
+func NewGetProjectsPjIdNodesNodeIdOccurrencesRequest(server string, pjId string, nodeId string, params *GetProjectsPjIdNodesNodeIdOccurrencesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pjId", runtime.ParamLocationPath, pjId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/nodes/%s/occurrences", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectsPjIdProgressRequest generates requests for GetProjectsPjIdProgress
+func NewGetProjectsPjIdProgressRequest(server string, pjId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pjId", runtime.ParamLocationPath, pjId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/progress", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostRecurrencePreviewRequest calls the generic PostRecurrencePreview builder with application/json body
+func NewPostRecurrencePreviewRequest(server string, params *PostRecurrencePreviewParams, body PostRecurrencePreviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRecurrencePreviewRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRecurrencePreviewRequestWithBody generates requests for PostRecurrencePreview with any type of body
+func NewPostRecurrencePreviewRequestWithBody(server string, params *PostRecurrencePreviewParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recurrence/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsersMeRequest generates requests for DeleteUsersMe
+func NewDeleteUsersMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersMeRequest generates requests for GetUsersMe
+func NewGetUsersMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUsersMeRequest calls the generic PatchUsersMe builder with application/json body
+func NewPatchUsersMeRequest(server string, body PatchUsersMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUsersMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchUsersMeRequestWithBody generates requests for PatchUsersMe with any type of body
+func NewPatchUsersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersMeAppPasswordsRequest generates requests for GetUsersMeAppPasswords
+func NewGetUsersMeAppPasswordsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/app-passwords")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersMeAppPasswordsRequest calls the generic PostUsersMeAppPasswords builder with application/json body
+func NewPostUsersMeAppPasswordsRequest(server string, body PostUsersMeAppPasswordsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersMeAppPasswordsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersMeAppPasswordsRequestWithBody generates requests for PostUsersMeAppPasswords with any type of body
+func NewPostUsersMeAppPasswordsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/app-passwords")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsersMeAppPasswordsAppPasswordIdRequest generates requests for DeleteUsersMeAppPasswordsAppPasswordId
+func NewDeleteUsersMeAppPasswordsAppPasswordIdRequest(server string, appPasswordId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appPasswordId", runtime.ParamLocationPath, appPasswordId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/app-passwords/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersMeCalendarFeedRequest generates requests for DeleteUsersMeCalendarFeed
+func NewDeleteUsersMeCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/calendar-feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersMeCalendarFeedRequest generates requests for GetUsersMeCalendarFeed
+func NewGetUsersMeCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/calendar-feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersMeCalendarFeedRequest generates requests for PostUsersMeCalendarFeed
+func NewPostUsersMeCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/calendar-feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersMeIdentitiesRequest generates requests for GetUsersMeIdentities
+func NewGetUsersMeIdentitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/identities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersMeIdentitiesRequest calls the generic PostUsersMeIdentities builder with application/json body
+func NewPostUsersMeIdentitiesRequest(server string, body PostUsersMeIdentitiesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersMeIdentitiesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersMeIdentitiesRequestWithBody generates requests for PostUsersMeIdentities with any type of body
+func NewPostUsersMeIdentitiesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/identities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersMeIdentitiesPendingRequest generates requests for PostUsersMeIdentitiesPending
+func NewPostUsersMeIdentitiesPendingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/identities/pending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersMeIdentitiesIdentityIdRequest generates requests for DeleteUsersMeIdentitiesIdentityId
+func NewDeleteUsersMeIdentitiesIdentityIdRequest(server string, identityId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identityId", runtime.ParamLocationPath, identityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/identities/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersMeInboundRequest generates requests for DeleteUsersMeInbound
+func NewDeleteUsersMeInboundRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/inbound")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersMeInboundRequest generates requests for GetUsersMeInbound
+func NewGetUsersMeInboundRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/inbound")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersMeInboundRequest calls the generic PostUsersMeInbound builder with application/json body
+func NewPostUsersMeInboundRequest(server string, body PostUsersMeInboundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersMeInboundRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersMeInboundRequestWithBody generates requests for PostUsersMeInbound with any type of body
+func NewPostUsersMeInboundRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/inbound")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutUsersMeInboundRequest calls the generic PutUsersMeInbound builder with application/json body
+func NewPutUsersMeInboundRequest(server string, body PutUsersMeInboundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersMeInboundRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutUsersMeInboundRequestWithBody generates requests for PutUsersMeInbound with any type of body
+func NewPutUsersMeInboundRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/inbound")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersMeNotificationsRequest generates requests for GetUsersMeNotifications
+func NewGetUsersMeNotificationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutUsersMeNotificationsRequest calls the generic PutUsersMeNotifications builder with application/json body
+func NewPutUsersMeNotificationsRequest(server string, body PutUsersMeNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersMeNotificationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutUsersMeNotificationsRequestWithBody generates requests for PutUsersMeNotifications with any type of body
+func NewPutUsersMeNotificationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsersMeSessionsRequest generates requests for DeleteUsersMeSessions
+func NewDeleteUsersMeSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersMeSessionsRequest generates requests for GetUsersMeSessions
+func NewGetUsersMeSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersMeSessionsSessionIdRequest generates requests for DeleteUsersMeSessionsSessionId
+func NewDeleteUsersMeSessionsSessionIdRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersMeTokensRequest generates requests for GetUsersMeTokens
+func NewGetUsersMeTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersMeTokensRequest calls the generic PostUsersMeTokens builder with application/json body
+func NewPostUsersMeTokensRequest(server string, body PostUsersMeTokensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersMeTokensRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersMeTokensRequestWithBody generates requests for PostUsersMeTokens with any type of body
+func NewPostUsersMeTokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsersMeTokensTokenIdRequest generates requests for DeleteUsersMeTokensTokenId
+func NewDeleteUsersMeTokensTokenIdRequest(server string, tokenId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tokenId", runtime.ParamLocationPath, tokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksRequest calls the generic PostWebhooks builder with application/json body
+func NewPostWebhooksRequest(server string, body PostWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksRequestWithBody generates requests for PostWebhooks with any type of body
+func NewPostWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhooksWebhookIdRequest generates requests for DeleteWebhooksWebhookId
+func NewDeleteWebhooksWebhookIdRequest(server string, webhookId WebhookId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutWebhooksWebhookIdRequest calls the generic PutWebhooksWebhookId builder with application/json body
+func NewPutWebhooksWebhookIdRequest(server string, webhookId WebhookId, body PutWebhooksWebhookIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutWebhooksWebhookIdRequestWithBody(server, webhookId, "application/json", bodyReader)
+}
+
+// NewPutWebhooksWebhookIdRequestWithBody generates requests for PutWebhooksWebhookId with any type of body
+func NewPutWebhooksWebhookIdRequestWithBody(server string, webhookId WebhookId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhooksWebhookIdDeliveriesRequest generates requests for GetWebhooksWebhookIdDeliveries
+func NewGetWebhooksWebhookIdDeliveriesRequest(server string, webhookId WebhookId, params *GetWebhooksWebhookIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksWebhookIdPingRequest generates requests for PostWebhooksWebhookIdPing
+func NewPostWebhooksWebhookIdPingRequest(server string, webhookId WebhookId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/ping", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetActivityWithResponse request
+	GetActivityWithResponse(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*GetActivityResponse, error)
+
+	// GetAnalyticsWithResponse request
+	GetAnalyticsWithResponse(ctx context.Context, params *GetAnalyticsParams, reqEditors ...RequestEditorFn) (*GetAnalyticsResponse, error)
+
+	// GetAuthCallbackWithResponse request
+	GetAuthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthCallbackResponse, error)
+
+	// GetAuthCallbackProviderWithResponse request
+	GetAuthCallbackProviderWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*GetAuthCallbackProviderResponse, error)
+
+	// DeleteAuthLinkWithResponse request
+	DeleteAuthLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAuthLinkResponse, error)
+
+	// GetAuthLinkWithResponse request
+	GetAuthLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthLinkResponse, error)
+
+	// GetAuthLoginWithResponse request
+	GetAuthLoginWithResponse(ctx context.Context, params *GetAuthLoginParams, reqEditors ...RequestEditorFn) (*GetAuthLoginResponse, error)
+
+	// PostAuthLogoutWithResponse request
+	PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+
+	// GetAuthProvidersWithResponse request
+	GetAuthProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthProvidersResponse, error)
+
+	// GetCalendarWithResponse request
+	GetCalendarWithResponse(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*GetCalendarResponse, error)
+
+	// GetCalendarFeedFeedFileWithResponse request
+	GetCalendarFeedFeedFileWithResponse(ctx context.Context, feedFile string, reqEditors ...RequestEditorFn) (*GetCalendarFeedFeedFileResponse, error)
+
+	// GetHealthzWithResponse request
+	GetHealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthzResponse, error)
+
+	// PostInboundInboundTokenWithBodyWithResponse request with any body
+	PostInboundInboundTokenWithBodyWithResponse(ctx context.Context, inboundToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInboundInboundTokenResponse, error)
+
+	PostInboundInboundTokenWithResponse(ctx context.Context, inboundToken string, body PostInboundInboundTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInboundInboundTokenResponse, error)
+
+	// GetMinkanWithResponse request
+	GetMinkanWithResponse(ctx context.Context, params *GetMinkanParams, reqEditors ...RequestEditorFn) (*GetMinkanResponse, error)
+
+	// PutMinkanWithBodyWithResponse request with any body
+	PutMinkanWithBodyWithResponse(ctx context.Context, params *PutMinkanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error)
+
+	PutMinkanWithResponse(ctx context.Context, params *PutMinkanParams, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error)
+
+	// GetMinkanChangesWithResponse request
+	GetMinkanChangesWithResponse(ctx context.Context, params *GetMinkanChangesParams, reqEditors ...RequestEditorFn) (*GetMinkanChangesResponse, error)
+
+	// GetMinkanEventsWithResponse request
+	GetMinkanEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinkanEventsResponse, error)
+
+	// GetMinkanLiveWithResponse request
+	GetMinkanLiveWithResponse(ctx context.Context, params *GetMinkanLiveParams, reqEditors ...RequestEditorFn) (*GetMinkanLiveResponse, error)
+
+	// PostMinkanSyncWithBodyWithResponse request with any body
+	PostMinkanSyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMinkanSyncResponse, error)
+
+	PostMinkanSyncWithResponse(ctx context.Context, body PostMinkanSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMinkanSyncResponse, error)
+
+	// GetNotificationsUnsubscribeWithResponse request
+	GetNotificationsUnsubscribeWithResponse(ctx context.Context, params *GetNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*GetNotificationsUnsubscribeResponse, error)
+
+	// PostNotificationsUnsubscribeWithResponse request
+	PostNotificationsUnsubscribeWithResponse(ctx context.Context, params *PostNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*PostNotificationsUnsubscribeResponse, error)
+
+	// GetProjectsPjIdNodesNodeIdOccurrencesWithResponse request
+	GetProjectsPjIdNodesNodeIdOccurrencesWithResponse(ctx context.Context, pjId string, nodeId string, params *GetProjectsPjIdNodesNodeIdOccurrencesParams, reqEditors ...RequestEditorFn) (*GetProjectsPjIdNodesNodeIdOccurrencesResponse, error)
+
+	// GetProjectsPjIdProgressWithResponse request
+	GetProjectsPjIdProgressWithResponse(ctx context.Context, pjId string, reqEditors ...RequestEditorFn) (*GetProjectsPjIdProgressResponse, error)
+
+	// PostRecurrencePreviewWithBodyWithResponse request with any body
+	PostRecurrencePreviewWithBodyWithResponse(ctx context.Context, params *PostRecurrencePreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRecurrencePreviewResponse, error)
+
+	PostRecurrencePreviewWithResponse(ctx context.Context, params *PostRecurrencePreviewParams, body PostRecurrencePreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRecurrencePreviewResponse, error)
+
+	// DeleteUsersMeWithResponse request
+	DeleteUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeResponse, error)
+
+	// GetUsersMeWithResponse request
+	GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error)
+
+	// PatchUsersMeWithBodyWithResponse request with any body
+	PatchUsersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsersMeResponse, error)
+
+	PatchUsersMeWithResponse(ctx context.Context, body PatchUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsersMeResponse, error)
+
+	// GetUsersMeAppPasswordsWithResponse request
+	GetUsersMeAppPasswordsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeAppPasswordsResponse, error)
+
+	// PostUsersMeAppPasswordsWithBodyWithResponse request with any body
+	PostUsersMeAppPasswordsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeAppPasswordsResponse, error)
+
+	PostUsersMeAppPasswordsWithResponse(ctx context.Context, body PostUsersMeAppPasswordsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeAppPasswordsResponse, error)
+
+	// DeleteUsersMeAppPasswordsAppPasswordIdWithResponse request
+	DeleteUsersMeAppPasswordsAppPasswordIdWithResponse(ctx context.Context, appPasswordId int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeAppPasswordsAppPasswordIdResponse, error)
+
+	// DeleteUsersMeCalendarFeedWithResponse request
+	DeleteUsersMeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeCalendarFeedResponse, error)
+
+	// GetUsersMeCalendarFeedWithResponse request
+	GetUsersMeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeCalendarFeedResponse, error)
+
+	// PostUsersMeCalendarFeedWithResponse request
+	PostUsersMeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostUsersMeCalendarFeedResponse, error)
+
+	// GetUsersMeIdentitiesWithResponse request
+	GetUsersMeIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeIdentitiesResponse, error)
+
+	// PostUsersMeIdentitiesWithBodyWithResponse request with any body
+	PostUsersMeIdentitiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeIdentitiesResponse, error)
+
+	PostUsersMeIdentitiesWithResponse(ctx context.Context, body PostUsersMeIdentitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeIdentitiesResponse, error)
+
+	// PostUsersMeIdentitiesPendingWithResponse request
+	PostUsersMeIdentitiesPendingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostUsersMeIdentitiesPendingResponse, error)
+
+	// DeleteUsersMeIdentitiesIdentityIdWithResponse request
+	DeleteUsersMeIdentitiesIdentityIdWithResponse(ctx context.Context, identityId int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeIdentitiesIdentityIdResponse, error)
+
+	// DeleteUsersMeInboundWithResponse request
+	DeleteUsersMeInboundWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeInboundResponse, error)
+
+	// GetUsersMeInboundWithResponse request
+	GetUsersMeInboundWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeInboundResponse, error)
+
+	// PostUsersMeInboundWithBodyWithResponse request with any body
+	PostUsersMeInboundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeInboundResponse, error)
+
+	PostUsersMeInboundWithResponse(ctx context.Context, body PostUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeInboundResponse, error)
+
+	// PutUsersMeInboundWithBodyWithResponse request with any body
+	PutUsersMeInboundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersMeInboundResponse, error)
+
+	PutUsersMeInboundWithResponse(ctx context.Context, body PutUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersMeInboundResponse, error)
+
+	// GetUsersMeNotificationsWithResponse request
+	GetUsersMeNotificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeNotificationsResponse, error)
+
+	// PutUsersMeNotificationsWithBodyWithResponse request with any body
+	PutUsersMeNotificationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersMeNotificationsResponse, error)
+
+	PutUsersMeNotificationsWithResponse(ctx context.Context, body PutUsersMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersMeNotificationsResponse, error)
+
+	// DeleteUsersMeSessionsWithResponse request
+	DeleteUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsResponse, error)
+
+	// GetUsersMeSessionsWithResponse request
+	GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error)
+
+	// DeleteUsersMeSessionsSessionIdWithResponse request
+	DeleteUsersMeSessionsSessionIdWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIdResponse, error)
+
+	// GetUsersMeTokensWithResponse request
+	GetUsersMeTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeTokensResponse, error)
+
+	// PostUsersMeTokensWithBodyWithResponse request with any body
+	PostUsersMeTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error)
+
+	PostUsersMeTokensWithResponse(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error)
+
+	// DeleteUsersMeTokensTokenIdWithResponse request
+	DeleteUsersMeTokensTokenIdWithResponse(ctx context.Context, tokenId int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeTokensTokenIdResponse, error)
+
+	// GetWebhooksWithResponse request
+	GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
+
+	// PostWebhooksWithBodyWithResponse request with any body
+	PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	// DeleteWebhooksWebhookIdWithResponse request
+	DeleteWebhooksWebhookIdWithResponse(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIdResponse, error)
+
+	// PutWebhooksWebhookIdWithBodyWithResponse request with any body
+	PutWebhooksWebhookIdWithBodyWithResponse(ctx context.Context, webhookId WebhookId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWebhooksWebhookIdResponse, error)
+
+	PutWebhooksWebhookIdWithResponse(ctx context.Context, webhookId WebhookId, body PutWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWebhooksWebhookIdResponse, error)
+
+	// GetWebhooksWebhookIdDeliveriesWithResponse request
+	GetWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, webhookId WebhookId, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksWebhookIdDeliveriesResponse, error)
+
+	// PostWebhooksWebhookIdPingWithResponse request
+	PostWebhooksWebhookIdPingWithResponse(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*PostWebhooksWebhookIdPingResponse, error)
+}
+
+type GetActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnalyticsRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthCallbackProviderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthCallbackProviderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthCallbackProviderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAuthLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAuthLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAuthLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingIdentityLink
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogoutResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuthProvider
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarFeedFeedFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarFeedFeedFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarFeedFeedFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Healthz
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostInboundInboundTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InboundCaptureRes
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInboundInboundTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInboundInboundTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMinkanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MinkanGetRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMinkanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMinkanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMinkanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MinkanPutRes
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMinkanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMinkanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMinkanChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MinkanChangesRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMinkanChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMinkanChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMinkanEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMinkanEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMinkanEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMinkanLiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMinkanLiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMinkanLiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMinkanSyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MinkanSyncRes
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMinkanSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMinkanSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationsUnsubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsUnsubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsUnsubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNotificationsUnsubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNotificationsUnsubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNotificationsUnsubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsPjIdNodesNodeIdOccurrencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurrencePreviewRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsPjIdNodesNodeIdOccurrencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsPjIdNodesNodeIdOccurrencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsPjIdProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectProgress
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsPjIdProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsPjIdProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRecurrencePreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurrencePreviewRes
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRecurrencePreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRecurrencePreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUsersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeAppPasswordsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AppPassword
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeAppPasswordsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeAppPasswordsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeAppPasswordsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppPasswordCreated
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeAppPasswordsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeAppPasswordsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeAppPasswordsAppPasswordIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeAppPasswordsAppPasswordIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeAppPasswordsAppPasswordIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeCalendarFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeCalendarFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarFeedStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeCalendarFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CalendarFeedToken
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeIdentitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]UserIdentity
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeIdentitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeIdentitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeIdentitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserIdentityLinkStarted
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeIdentitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeIdentitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeIdentitiesPendingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UserIdentity
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeIdentitiesPendingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeIdentitiesPendingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeIdentitiesIdentityIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeIdentitiesIdentityIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeIdentitiesIdentityIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeInboundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeInboundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeInboundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeInboundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboundStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeInboundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeInboundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeInboundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InboundToken
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeInboundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeInboundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersMeInboundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboundStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsersMeInboundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersMeInboundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersMeNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsersMeNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersMeNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]UserSession
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeSessionsSessionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeSessionsSessionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeSessionsSessionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AccessToken
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AccessTokenCreated
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeTokensTokenIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeTokensTokenIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeTokensTokenIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookCreated
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r PutWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksWebhookIdDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveriesRes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksWebhookIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksWebhookIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksWebhookIdPingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *WebhookDelivery
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksWebhookIdPingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksWebhookIdPingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetActivityWithResponse request returning *GetActivityResponse
+func (c *ClientWithResponses) GetActivityWithResponse(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*GetActivityResponse, error) {
+	rsp, err := c.GetActivity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivityResponse(rsp)
+}
+
+// GetAnalyticsWithResponse request returning *GetAnalyticsResponse
+func (c *ClientWithResponses) GetAnalyticsWithResponse(ctx context.Context, params *GetAnalyticsParams, reqEditors ...RequestEditorFn) (*GetAnalyticsResponse, error) {
+	rsp, err := c.GetAnalytics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsResponse(rsp)
+}
+
+// GetAuthCallbackWithResponse request returning *GetAuthCallbackResponse
+func (c *ClientWithResponses) GetAuthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthCallbackResponse, error) {
+	rsp, err := c.GetAuthCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthCallbackResponse(rsp)
+}
+
+// GetAuthCallbackProviderWithResponse request returning *GetAuthCallbackProviderResponse
+func (c *ClientWithResponses) GetAuthCallbackProviderWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*GetAuthCallbackProviderResponse, error) {
+	rsp, err := c.GetAuthCallbackProvider(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthCallbackProviderResponse(rsp)
+}
+
+// DeleteAuthLinkWithResponse request returning *DeleteAuthLinkResponse
+func (c *ClientWithResponses) DeleteAuthLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAuthLinkResponse, error) {
+	rsp, err := c.DeleteAuthLink(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAuthLinkResponse(rsp)
+}
+
+// GetAuthLinkWithResponse request returning *GetAuthLinkResponse
+func (c *ClientWithResponses) GetAuthLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthLinkResponse, error) {
+	rsp, err := c.GetAuthLink(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthLinkResponse(rsp)
+}
+
+// GetAuthLoginWithResponse request returning *GetAuthLoginResponse
+func (c *ClientWithResponses) GetAuthLoginWithResponse(ctx context.Context, params *GetAuthLoginParams, reqEditors ...RequestEditorFn) (*GetAuthLoginResponse, error) {
+	rsp, err := c.GetAuthLogin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthLoginResponse(rsp)
+}
+
+// PostAuthLogoutWithResponse request returning *PostAuthLogoutResponse
+func (c *ClientWithResponses) PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
+	rsp, err := c.PostAuthLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutResponse(rsp)
+}
+
+// GetAuthProvidersWithResponse request returning *GetAuthProvidersResponse
+func (c *ClientWithResponses) GetAuthProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthProvidersResponse, error) {
+	rsp, err := c.GetAuthProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthProvidersResponse(rsp)
+}
+
+// GetCalendarWithResponse request returning *GetCalendarResponse
+func (c *ClientWithResponses) GetCalendarWithResponse(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*GetCalendarResponse, error) {
+	rsp, err := c.GetCalendar(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarResponse(rsp)
+}
+
+// GetCalendarFeedFeedFileWithResponse request returning *GetCalendarFeedFeedFileResponse
+func (c *ClientWithResponses) GetCalendarFeedFeedFileWithResponse(ctx context.Context, feedFile string, reqEditors ...RequestEditorFn) (*GetCalendarFeedFeedFileResponse, error) {
+	rsp, err := c.GetCalendarFeedFeedFile(ctx, feedFile, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarFeedFeedFileResponse(rsp)
+}
+
+// GetHealthzWithResponse request returning *GetHealthzResponse
+func (c *ClientWithResponses) GetHealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthzResponse, error) {
+	rsp, err := c.GetHealthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthzResponse(rsp)
+}
+
+// PostInboundInboundTokenWithBodyWithResponse request with arbitrary body returning *PostInboundInboundTokenResponse
+func (c *ClientWithResponses) PostInboundInboundTokenWithBodyWithResponse(ctx context.Context, inboundToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInboundInboundTokenResponse, error) {
+	rsp, err := c.PostInboundInboundTokenWithBody(ctx, inboundToken, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInboundInboundTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInboundInboundTokenWithResponse(ctx context.Context, inboundToken string, body PostInboundInboundTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInboundInboundTokenResponse, error) {
+	rsp, err := c.PostInboundInboundToken(ctx, inboundToken, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInboundInboundTokenResponse(rsp)
+}
+
+// GetMinkanWithResponse request returning *GetMinkanResponse
+func (c *ClientWithResponses) GetMinkanWithResponse(ctx context.Context, params *GetMinkanParams, reqEditors ...RequestEditorFn) (*GetMinkanResponse, error) {
+	rsp, err := c.GetMinkan(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMinkanResponse(rsp)
+}
+
+// PutMinkanWithBodyWithResponse request with arbitrary body returning *PutMinkanResponse
+func (c *ClientWithResponses) PutMinkanWithBodyWithResponse(ctx context.Context, params *PutMinkanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error) {
+	rsp, err := c.PutMinkanWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMinkanResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutMinkanWithResponse(ctx context.Context, params *PutMinkanParams, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error) {
+	rsp, err := c.PutMinkan(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMinkanResponse(rsp)
+}
+
+// GetMinkanChangesWithResponse request returning *GetMinkanChangesResponse
+func (c *ClientWithResponses) GetMinkanChangesWithResponse(ctx context.Context, params *GetMinkanChangesParams, reqEditors ...RequestEditorFn) (*GetMinkanChangesResponse, error) {
+	rsp, err := c.GetMinkanChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMinkanChangesResponse(rsp)
+}
+
+// GetMinkanEventsWithResponse request returning *GetMinkanEventsResponse
+func (c *ClientWithResponses) GetMinkanEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinkanEventsResponse, error) {
+	rsp, err := c.GetMinkanEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMinkanEventsResponse(rsp)
+}
+
+// GetMinkanLiveWithResponse request returning *GetMinkanLiveResponse
+func (c *ClientWithResponses) GetMinkanLiveWithResponse(ctx context.Context, params *GetMinkanLiveParams, reqEditors ...RequestEditorFn) (*GetMinkanLiveResponse, error) {
+	rsp, err := c.GetMinkanLive(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMinkanLiveResponse(rsp)
+}
+
+// PostMinkanSyncWithBodyWithResponse request with arbitrary body returning *PostMinkanSyncResponse
+func (c *ClientWithResponses) PostMinkanSyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMinkanSyncResponse, error) {
+	rsp, err := c.PostMinkanSyncWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMinkanSyncResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMinkanSyncWithResponse(ctx context.Context, body PostMinkanSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMinkanSyncResponse, error) {
+	rsp, err := c.PostMinkanSync(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMinkanSyncResponse(rsp)
+}
+
+// GetNotificationsUnsubscribeWithResponse request returning *GetNotificationsUnsubscribeResponse
+func (c *ClientWithResponses) GetNotificationsUnsubscribeWithResponse(ctx context.Context, params *GetNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*GetNotificationsUnsubscribeResponse, error) {
+	rsp, err := c.GetNotificationsUnsubscribe(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsUnsubscribeResponse(rsp)
+}
+
+// PostNotificationsUnsubscribeWithResponse request returning *PostNotificationsUnsubscribeResponse
+func (c *ClientWithResponses) PostNotificationsUnsubscribeWithResponse(ctx context.Context, params *PostNotificationsUnsubscribeParams, reqEditors ...RequestEditorFn) (*PostNotificationsUnsubscribeResponse, error) {
+	rsp, err := c.PostNotificationsUnsubscribe(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNotificationsUnsubscribeResponse(rsp)
+}
+
+// GetProjectsPjIdNodesNodeIdOccurrencesWithResponse request returning *GetProjectsPjIdNodesNodeIdOccurrencesResponse
+func (c *ClientWithResponses) GetProjectsPjIdNodesNodeIdOccurrencesWithResponse(ctx context.Context, pjId string, nodeId string, params *GetProjectsPjIdNodesNodeIdOccurrencesParams, reqEditors ...RequestEditorFn) (*GetProjectsPjIdNodesNodeIdOccurrencesResponse, error) {
+	rsp, err := c.GetProjectsPjIdNodesNodeIdOccurrences(ctx, pjId, nodeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsPjIdNodesNodeIdOccurrencesResponse(rsp)
+}
+
+// GetProjectsPjIdProgressWithResponse request returning *GetProjectsPjIdProgressResponse
+func (c *ClientWithResponses) GetProjectsPjIdProgressWithResponse(ctx context.Context, pjId string, reqEditors ...RequestEditorFn) (*GetProjectsPjIdProgressResponse, error) {
+	rsp, err := c.GetProjectsPjIdProgress(ctx, pjId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsPjIdProgressResponse(rsp)
+}
+
+// PostRecurrencePreviewWithBodyWithResponse request with arbitrary body returning *PostRecurrencePreviewResponse
+func (c *ClientWithResponses) PostRecurrencePreviewWithBodyWithResponse(ctx context.Context, params *PostRecurrencePreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRecurrencePreviewResponse, error) {
+	rsp, err := c.PostRecurrencePreviewWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRecurrencePreviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRecurrencePreviewWithResponse(ctx context.Context, params *PostRecurrencePreviewParams, body PostRecurrencePreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRecurrencePreviewResponse, error) {
+	rsp, err := c.PostRecurrencePreview(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRecurrencePreviewResponse(rsp)
+}
+
+// DeleteUsersMeWithResponse request returning *DeleteUsersMeResponse
+func (c *ClientWithResponses) DeleteUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeResponse, error) {
+	rsp, err := c.DeleteUsersMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeResponse(rsp)
+}
+
+// GetUsersMeWithResponse request returning *GetUsersMeResponse
+func (c *ClientWithResponses) GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error) {
+	rsp, err := c.GetUsersMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeResponse(rsp)
+}
+
+// PatchUsersMeWithBodyWithResponse request with arbitrary body returning *PatchUsersMeResponse
+func (c *ClientWithResponses) PatchUsersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsersMeResponse, error) {
+	rsp, err := c.PatchUsersMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsersMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchUsersMeWithResponse(ctx context.Context, body PatchUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsersMeResponse, error) {
+	rsp, err := c.PatchUsersMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsersMeResponse(rsp)
+}
+
+// GetUsersMeAppPasswordsWithResponse request returning *GetUsersMeAppPasswordsResponse
+func (c *ClientWithResponses) GetUsersMeAppPasswordsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeAppPasswordsResponse, error) {
+	rsp, err := c.GetUsersMeAppPasswords(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeAppPasswordsResponse(rsp)
+}
+
+// PostUsersMeAppPasswordsWithBodyWithResponse request with arbitrary body returning *PostUsersMeAppPasswordsResponse
+func (c *ClientWithResponses) PostUsersMeAppPasswordsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeAppPasswordsResponse, error) {
+	rsp, err := c.PostUsersMeAppPasswordsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeAppPasswordsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersMeAppPasswordsWithResponse(ctx context.Context, body PostUsersMeAppPasswordsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeAppPasswordsResponse, error) {
+	rsp, err := c.PostUsersMeAppPasswords(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeAppPasswordsResponse(rsp)
+}
+
+// DeleteUsersMeAppPasswordsAppPasswordIdWithResponse request returning *DeleteUsersMeAppPasswordsAppPasswordIdResponse
+func (c *ClientWithResponses) DeleteUsersMeAppPasswordsAppPasswordIdWithResponse(ctx context.Context, appPasswordId int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeAppPasswordsAppPasswordIdResponse, error) {
+	rsp, err := c.DeleteUsersMeAppPasswordsAppPasswordId(ctx, appPasswordId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeAppPasswordsAppPasswordIdResponse(rsp)
+}
+
+// DeleteUsersMeCalendarFeedWithResponse request returning *DeleteUsersMeCalendarFeedResponse
+func (c *ClientWithResponses) DeleteUsersMeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeCalendarFeedResponse, error) {
+	rsp, err := c.DeleteUsersMeCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeCalendarFeedResponse(rsp)
+}
+
+// GetUsersMeCalendarFeedWithResponse request returning *GetUsersMeCalendarFeedResponse
+func (c *ClientWithResponses) GetUsersMeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeCalendarFeedResponse, error) {
+	rsp, err := c.GetUsersMeCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeCalendarFeedResponse(rsp)
+}
+
+// PostUsersMeCalendarFeedWithResponse request returning *PostUsersMeCalendarFeedResponse
+func (c *ClientWithResponses) PostUsersMeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostUsersMeCalendarFeedResponse, error) {
+	rsp, err := c.PostUsersMeCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeCalendarFeedResponse(rsp)
+}
+
+// GetUsersMeIdentitiesWithResponse request returning *GetUsersMeIdentitiesResponse
+func (c *ClientWithResponses) GetUsersMeIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeIdentitiesResponse, error) {
+	rsp, err := c.GetUsersMeIdentities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeIdentitiesResponse(rsp)
+}
+
+// PostUsersMeIdentitiesWithBodyWithResponse request with arbitrary body returning *PostUsersMeIdentitiesResponse
+func (c *ClientWithResponses) PostUsersMeIdentitiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeIdentitiesResponse, error) {
+	rsp, err := c.PostUsersMeIdentitiesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeIdentitiesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersMeIdentitiesWithResponse(ctx context.Context, body PostUsersMeIdentitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeIdentitiesResponse, error) {
+	rsp, err := c.PostUsersMeIdentities(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeIdentitiesResponse(rsp)
+}
+
+// PostUsersMeIdentitiesPendingWithResponse request returning *PostUsersMeIdentitiesPendingResponse
+func (c *ClientWithResponses) PostUsersMeIdentitiesPendingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostUsersMeIdentitiesPendingResponse, error) {
+	rsp, err := c.PostUsersMeIdentitiesPending(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeIdentitiesPendingResponse(rsp)
+}
+
+// DeleteUsersMeIdentitiesIdentityIdWithResponse request returning *DeleteUsersMeIdentitiesIdentityIdResponse
+func (c *ClientWithResponses) DeleteUsersMeIdentitiesIdentityIdWithResponse(ctx context.Context, identityId int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeIdentitiesIdentityIdResponse, error) {
+	rsp, err := c.DeleteUsersMeIdentitiesIdentityId(ctx, identityId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeIdentitiesIdentityIdResponse(rsp)
+}
+
+// DeleteUsersMeInboundWithResponse request returning *DeleteUsersMeInboundResponse
+func (c *ClientWithResponses) DeleteUsersMeInboundWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeInboundResponse, error) {
+	rsp, err := c.DeleteUsersMeInbound(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeInboundResponse(rsp)
+}
+
+// GetUsersMeInboundWithResponse request returning *GetUsersMeInboundResponse
+func (c *ClientWithResponses) GetUsersMeInboundWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeInboundResponse, error) {
+	rsp, err := c.GetUsersMeInbound(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeInboundResponse(rsp)
+}
+
+// PostUsersMeInboundWithBodyWithResponse request with arbitrary body returning *PostUsersMeInboundResponse
+func (c *ClientWithResponses) PostUsersMeInboundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeInboundResponse, error) {
+	rsp, err := c.PostUsersMeInboundWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeInboundResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersMeInboundWithResponse(ctx context.Context, body PostUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeInboundResponse, error) {
+	rsp, err := c.PostUsersMeInbound(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeInboundResponse(rsp)
+}
+
+// PutUsersMeInboundWithBodyWithResponse request with arbitrary body returning *PutUsersMeInboundResponse
+func (c *ClientWithResponses) PutUsersMeInboundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersMeInboundResponse, error) {
+	rsp, err := c.PutUsersMeInboundWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersMeInboundResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUsersMeInboundWithResponse(ctx context.Context, body PutUsersMeInboundJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersMeInboundResponse, error) {
+	rsp, err := c.PutUsersMeInbound(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersMeInboundResponse(rsp)
+}
+
+// GetUsersMeNotificationsWithResponse request returning *GetUsersMeNotificationsResponse
+func (c *ClientWithResponses) GetUsersMeNotificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeNotificationsResponse, error) {
+	rsp, err := c.GetUsersMeNotifications(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeNotificationsResponse(rsp)
+}
+
+// PutUsersMeNotificationsWithBodyWithResponse request with arbitrary body returning *PutUsersMeNotificationsResponse
+func (c *ClientWithResponses) PutUsersMeNotificationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersMeNotificationsResponse, error) {
+	rsp, err := c.PutUsersMeNotificationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersMeNotificationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUsersMeNotificationsWithResponse(ctx context.Context, body PutUsersMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersMeNotificationsResponse, error) {
+	rsp, err := c.PutUsersMeNotifications(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersMeNotificationsResponse(rsp)
+}
+
+// DeleteUsersMeSessionsWithResponse request returning *DeleteUsersMeSessionsResponse
+func (c *ClientWithResponses) DeleteUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsResponse, error) {
+	rsp, err := c.DeleteUsersMeSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeSessionsResponse(rsp)
+}
+
+// GetUsersMeSessionsWithResponse request returning *GetUsersMeSessionsResponse
+func (c *ClientWithResponses) GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error) {
+	rsp, err := c.GetUsersMeSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeSessionsResponse(rsp)
+}
+
+// DeleteUsersMeSessionsSessionIdWithResponse request returning *DeleteUsersMeSessionsSessionIdResponse
+func (c *ClientWithResponses) DeleteUsersMeSessionsSessionIdWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIdResponse, error) {
+	rsp, err := c.DeleteUsersMeSessionsSessionId(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeSessionsSessionIdResponse(rsp)
+}
+
+// GetUsersMeTokensWithResponse request returning *GetUsersMeTokensResponse
+func (c *ClientWithResponses) GetUsersMeTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeTokensResponse, error) {
+	rsp, err := c.GetUsersMeTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeTokensResponse(rsp)
+}
+
+// PostUsersMeTokensWithBodyWithResponse request with arbitrary body returning *PostUsersMeTokensResponse
+func (c *ClientWithResponses) PostUsersMeTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error) {
+	rsp, err := c.PostUsersMeTokensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeTokensResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersMeTokensWithResponse(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error) {
+	rsp, err := c.PostUsersMeTokens(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeTokensResponse(rsp)
+}
+
+// DeleteUsersMeTokensTokenIdWithResponse request returning *DeleteUsersMeTokensTokenIdResponse
+func (c *ClientWithResponses) DeleteUsersMeTokensTokenIdWithResponse(ctx context.Context, tokenId int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeTokensTokenIdResponse, error) {
+	rsp, err := c.DeleteUsersMeTokensTokenId(ctx, tokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeTokensTokenIdResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// PostWebhooksWithBodyWithResponse request with arbitrary body returning *PostWebhooksResponse
+func (c *ClientWithResponses) PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+// DeleteWebhooksWebhookIdWithResponse request returning *DeleteWebhooksWebhookIdResponse
+func (c *ClientWithResponses) DeleteWebhooksWebhookIdWithResponse(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIdResponse, error) {
+	rsp, err := c.DeleteWebhooksWebhookId(ctx, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhooksWebhookIdResponse(rsp)
+}
+
+// PutWebhooksWebhookIdWithBodyWithResponse request with arbitrary body returning *PutWebhooksWebhookIdResponse
+func (c *ClientWithResponses) PutWebhooksWebhookIdWithBodyWithResponse(ctx context.Context, webhookId WebhookId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWebhooksWebhookIdResponse, error) {
+	rsp, err := c.PutWebhooksWebhookIdWithBody(ctx, webhookId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWebhooksWebhookIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutWebhooksWebhookIdWithResponse(ctx context.Context, webhookId WebhookId, body PutWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWebhooksWebhookIdResponse, error) {
+	rsp, err := c.PutWebhooksWebhookId(ctx, webhookId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWebhooksWebhookIdResponse(rsp)
+}
+
+// GetWebhooksWebhookIdDeliveriesWithResponse request returning *GetWebhooksWebhookIdDeliveriesResponse
+func (c *ClientWithResponses) GetWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, webhookId WebhookId, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksWebhookIdDeliveriesResponse, error) {
+	rsp, err := c.GetWebhooksWebhookIdDeliveries(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksWebhookIdDeliveriesResponse(rsp)
+}
+
+// PostWebhooksWebhookIdPingWithResponse request returning *PostWebhooksWebhookIdPingResponse
+func (c *ClientWithResponses) PostWebhooksWebhookIdPingWithResponse(ctx context.Context, webhookId WebhookId, reqEditors ...RequestEditorFn) (*PostWebhooksWebhookIdPingResponse, error) {
+	rsp, err := c.PostWebhooksWebhookIdPing(ctx, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksWebhookIdPingResponse(rsp)
+}
+
+// ParseGetActivityResponse parses an HTTP response from a GetActivityWithResponse call
+func ParseGetActivityResponse(rsp *http.Response) (*GetActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAnalyticsResponse parses an HTTP response from a GetAnalyticsWithResponse call
+func ParseGetAnalyticsResponse(rsp *http.Response) (*GetAnalyticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnalyticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnalyticsRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthCallbackResponse parses an HTTP response from a GetAuthCallbackWithResponse call
+func ParseGetAuthCallbackResponse(rsp *http.Response) (*GetAuthCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthCallbackProviderResponse parses an HTTP response from a GetAuthCallbackProviderWithResponse call
+func ParseGetAuthCallbackProviderResponse(rsp *http.Response) (*GetAuthCallbackProviderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthCallbackProviderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAuthLinkResponse parses an HTTP response from a DeleteAuthLinkWithResponse call
+func ParseDeleteAuthLinkResponse(rsp *http.Response) (*DeleteAuthLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAuthLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthLinkResponse parses an HTTP response from a GetAuthLinkWithResponse call
+func ParseGetAuthLinkResponse(rsp *http.Response) (*GetAuthLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingIdentityLink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthLoginResponse parses an HTTP response from a GetAuthLoginWithResponse call
+func ParseGetAuthLoginResponse(rsp *http.Response) (*GetAuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLogoutResponse parses an HTTP response from a PostAuthLogoutWithResponse call
+func ParsePostAuthLogoutResponse(rsp *http.Response) (*PostAuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogoutResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthProvidersResponse parses an HTTP response from a GetAuthProvidersWithResponse call
+func ParseGetAuthProvidersResponse(rsp *http.Response) (*GetAuthProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuthProvider
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarResponse parses an HTTP response from a GetCalendarWithResponse call
+func ParseGetCalendarResponse(rsp *http.Response) (*GetCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarFeedFeedFileResponse parses an HTTP response from a GetCalendarFeedFeedFileWithResponse call
+func ParseGetCalendarFeedFeedFileResponse(rsp *http.Response) (*GetCalendarFeedFeedFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarFeedFeedFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthzResponse parses an HTTP response from a GetHealthzWithResponse call
+func ParseGetHealthzResponse(rsp *http.Response) (*GetHealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Healthz
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostInboundInboundTokenResponse parses an HTTP response from a PostInboundInboundTokenWithResponse call
+func ParsePostInboundInboundTokenResponse(rsp *http.Response) (*PostInboundInboundTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostInboundInboundTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InboundCaptureRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMinkanResponse parses an HTTP response from a GetMinkanWithResponse call
+func ParseGetMinkanResponse(rsp *http.Response) (*GetMinkanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMinkanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MinkanGetRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutMinkanResponse parses an HTTP response from a PutMinkanWithResponse call
+func ParsePutMinkanResponse(rsp *http.Response) (*PutMinkanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutMinkanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MinkanPutRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMinkanChangesResponse parses an HTTP response from a GetMinkanChangesWithResponse call
+func ParseGetMinkanChangesResponse(rsp *http.Response) (*GetMinkanChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMinkanChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MinkanChangesRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMinkanEventsResponse parses an HTTP response from a GetMinkanEventsWithResponse call
+func ParseGetMinkanEventsResponse(rsp *http.Response) (*GetMinkanEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMinkanEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetMinkanLiveResponse parses an HTTP response from a GetMinkanLiveWithResponse call
+func ParseGetMinkanLiveResponse(rsp *http.Response) (*GetMinkanLiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMinkanLiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostMinkanSyncResponse parses an HTTP response from a PostMinkanSyncWithResponse call
+func ParsePostMinkanSyncResponse(rsp *http.Response) (*PostMinkanSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMinkanSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MinkanSyncRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationsUnsubscribeResponse parses an HTTP response from a GetNotificationsUnsubscribeWithResponse call
+func ParseGetNotificationsUnsubscribeResponse(rsp *http.Response) (*GetNotificationsUnsubscribeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsUnsubscribeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostNotificationsUnsubscribeResponse parses an HTTP response from a PostNotificationsUnsubscribeWithResponse call
+func ParsePostNotificationsUnsubscribeResponse(rsp *http.Response) (*PostNotificationsUnsubscribeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostNotificationsUnsubscribeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsPjIdNodesNodeIdOccurrencesResponse parses an HTTP response from a GetProjectsPjIdNodesNodeIdOccurrencesWithResponse call
+func ParseGetProjectsPjIdNodesNodeIdOccurrencesResponse(rsp *http.Response) (*GetProjectsPjIdNodesNodeIdOccurrencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsPjIdNodesNodeIdOccurrencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurrencePreviewRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsPjIdProgressResponse parses an HTTP response from a GetProjectsPjIdProgressWithResponse call
+func ParseGetProjectsPjIdProgressResponse(rsp *http.Response) (*GetProjectsPjIdProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsPjIdProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRecurrencePreviewResponse parses an HTTP response from a PostRecurrencePreviewWithResponse call
+func ParsePostRecurrencePreviewResponse(rsp *http.Response) (*PostRecurrencePreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRecurrencePreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurrencePreviewRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeResponse parses an HTTP response from a DeleteUsersMeWithResponse call
+func ParseDeleteUsersMeResponse(rsp *http.Response) (*DeleteUsersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeResponse parses an HTTP response from a GetUsersMeWithResponse call
+func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchUsersMeResponse parses an HTTP response from a PatchUsersMeWithResponse call
+func ParsePatchUsersMeResponse(rsp *http.Response) (*PatchUsersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchUsersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeAppPasswordsResponse parses an HTTP response from a GetUsersMeAppPasswordsWithResponse call
+func ParseGetUsersMeAppPasswordsResponse(rsp *http.Response) (*GetUsersMeAppPasswordsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeAppPasswordsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AppPassword
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeAppPasswordsResponse parses an HTTP response from a PostUsersMeAppPasswordsWithResponse call
+func ParsePostUsersMeAppPasswordsResponse(rsp *http.Response) (*PostUsersMeAppPasswordsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeAppPasswordsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppPasswordCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeAppPasswordsAppPasswordIdResponse parses an HTTP response from a DeleteUsersMeAppPasswordsAppPasswordIdWithResponse call
+func ParseDeleteUsersMeAppPasswordsAppPasswordIdResponse(rsp *http.Response) (*DeleteUsersMeAppPasswordsAppPasswordIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeAppPasswordsAppPasswordIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeCalendarFeedResponse parses an HTTP response from a DeleteUsersMeCalendarFeedWithResponse call
+func ParseDeleteUsersMeCalendarFeedResponse(rsp *http.Response) (*DeleteUsersMeCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeCalendarFeedResponse parses an HTTP response from a GetUsersMeCalendarFeedWithResponse call
+func ParseGetUsersMeCalendarFeedResponse(rsp *http.Response) (*GetUsersMeCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarFeedStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeCalendarFeedResponse parses an HTTP response from a PostUsersMeCalendarFeedWithResponse call
+func ParsePostUsersMeCalendarFeedResponse(rsp *http.Response) (*PostUsersMeCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CalendarFeedToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeIdentitiesResponse parses an HTTP response from a GetUsersMeIdentitiesWithResponse call
+func ParseGetUsersMeIdentitiesResponse(rsp *http.Response) (*GetUsersMeIdentitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeIdentitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []UserIdentity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeIdentitiesResponse parses an HTTP response from a PostUsersMeIdentitiesWithResponse call
+func ParsePostUsersMeIdentitiesResponse(rsp *http.Response) (*PostUsersMeIdentitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeIdentitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserIdentityLinkStarted
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeIdentitiesPendingResponse parses an HTTP response from a PostUsersMeIdentitiesPendingWithResponse call
+func ParsePostUsersMeIdentitiesPendingResponse(rsp *http.Response) (*PostUsersMeIdentitiesPendingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeIdentitiesPendingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UserIdentity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeIdentitiesIdentityIdResponse parses an HTTP response from a DeleteUsersMeIdentitiesIdentityIdWithResponse call
+func ParseDeleteUsersMeIdentitiesIdentityIdResponse(rsp *http.Response) (*DeleteUsersMeIdentitiesIdentityIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeIdentitiesIdentityIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeInboundResponse parses an HTTP response from a DeleteUsersMeInboundWithResponse call
+func ParseDeleteUsersMeInboundResponse(rsp *http.Response) (*DeleteUsersMeInboundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeInboundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeInboundResponse parses an HTTP response from a GetUsersMeInboundWithResponse call
+func ParseGetUsersMeInboundResponse(rsp *http.Response) (*GetUsersMeInboundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeInboundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InboundStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeInboundResponse parses an HTTP response from a PostUsersMeInboundWithResponse call
+func ParsePostUsersMeInboundResponse(rsp *http.Response) (*PostUsersMeInboundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeInboundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InboundToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersMeInboundResponse parses an HTTP response from a PutUsersMeInboundWithResponse call
+func ParsePutUsersMeInboundResponse(rsp *http.Response) (*PutUsersMeInboundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersMeInboundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InboundStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeNotificationsResponse parses an HTTP response from a GetUsersMeNotificationsWithResponse call
+func ParseGetUsersMeNotificationsResponse(rsp *http.Response) (*GetUsersMeNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersMeNotificationsResponse parses an HTTP response from a PutUsersMeNotificationsWithResponse call
+func ParsePutUsersMeNotificationsResponse(rsp *http.Response) (*PutUsersMeNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersMeNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeSessionsResponse parses an HTTP response from a DeleteUsersMeSessionsWithResponse call
+func ParseDeleteUsersMeSessionsResponse(rsp *http.Response) (*DeleteUsersMeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeSessionsResponse parses an HTTP response from a GetUsersMeSessionsWithResponse call
+func ParseGetUsersMeSessionsResponse(rsp *http.Response) (*GetUsersMeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []UserSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeSessionsSessionIdResponse parses an HTTP response from a DeleteUsersMeSessionsSessionIdWithResponse call
+func ParseDeleteUsersMeSessionsSessionIdResponse(rsp *http.Response) (*DeleteUsersMeSessionsSessionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeSessionsSessionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeTokensResponse parses an HTTP response from a GetUsersMeTokensWithResponse call
+func ParseGetUsersMeTokensResponse(rsp *http.Response) (*GetUsersMeTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AccessToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeTokensResponse parses an HTTP response from a PostUsersMeTokensWithResponse call
+func ParsePostUsersMeTokensResponse(rsp *http.Response) (*PostUsersMeTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccessTokenCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeTokensTokenIdResponse parses an HTTP response from a DeleteUsersMeTokensTokenIdWithResponse call
+func ParseDeleteUsersMeTokensTokenIdResponse(rsp *http.Response) (*DeleteUsersMeTokensTokenIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeTokensTokenIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksResponse parses an HTTP response from a PostWebhooksWithResponse call
+func ParsePostWebhooksResponse(rsp *http.Response) (*PostWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhooksWebhookIdResponse parses an HTTP response from a DeleteWebhooksWebhookIdWithResponse call
+func ParseDeleteWebhooksWebhookIdResponse(rsp *http.Response) (*DeleteWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutWebhooksWebhookIdResponse parses an HTTP response from a PutWebhooksWebhookIdWithResponse call
+func ParsePutWebhooksWebhookIdResponse(rsp *http.Response) (*PutWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksWebhookIdDeliveriesResponse parses an HTTP response from a GetWebhooksWebhookIdDeliveriesWithResponse call
+func ParseGetWebhooksWebhookIdDeliveriesResponse(rsp *http.Response) (*GetWebhooksWebhookIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksWebhookIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveriesRes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksWebhookIdPingResponse parses an HTTP response from a PostWebhooksWebhookIdPingWithResponse call
+func ParsePostWebhooksWebhookIdPingResponse(rsp *http.Response) (*PostWebhooksWebhookIdPingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksWebhookIdPingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// 操作履歴（新しい順）
+	// (GET /activity)
+	GetActivity(w http.ResponseWriter, r *http.Request, params GetActivityParams)
+	// カンバンのフロー分析
+	// (GET /analytics)
+	GetAnalytics(w http.ResponseWriter, r *http.Request, params GetAnalyticsParams)
+	// OIDC callback (default provider)
+	// (GET /auth/callback)
+	GetAuthCallback(w http.ResponseWriter, r *http.Request)
+	// IdP callback
+	// (GET /auth/callback/{provider})
+	GetAuthCallbackProvider(w http.ResponseWriter, r *http.Request, provider string)
+	// 保留中のアカウント連携を破棄
+	// (DELETE /auth/link)
+	DeleteAuthLink(w http.ResponseWriter, r *http.Request)
+	// 保留中のアカウント連携
+	// (GET /auth/link)
+	GetAuthLink(w http.ResponseWriter, r *http.Request)
+	// Redirect to IdP
+	// (GET /auth/login)
+	GetAuthLogin(w http.ResponseWriter, r *http.Request, params GetAuthLoginParams)
+	// Logout
+	// (POST /auth/logout)
+	PostAuthLogout(w http.ResponseWriter, r *http.Request)
+	// ログインに使えるIdPの一覧
+	// (GET /auth/providers)
+	GetAuthProviders(w http.ResponseWriter, r *http.Request)
+	// 期限・開始日時を持つタスクの一覧
+	// (GET /calendar)
+	GetCalendar(w http.ResponseWriter, r *http.Request, params GetCalendarParams)
+	// iCalendarフィード（ログイン不要）
+	// (GET /calendar/feed/{feedFile})
+	GetCalendarFeedFeedFile(w http.ResponseWriter, r *http.Request, feedFile string)
+	// ヘルスチェック用
+	// (GET /healthz)
+	GetHealthz(w http.ResponseWriter, r *http.Request)
+	// タスクの取り込み（URLのトークンで認証）
+	// (POST /inbound/{inboundToken})
+	PostInboundInboundToken(w http.ResponseWriter, r *http.Request, inboundToken string)
+	// mindmap,kanban,作業中プロジェクトIDの取得
+	// (GET /minkan)
+	GetMinkan(w http.ResponseWriter, r *http.Request, params GetMinkanParams)
+	// mindmap,kanban,作業中プロジェクトIDの更新
+	// (PUT /minkan)
+	PutMinkan(w http.ResponseWriter, r *http.Request, params PutMinkanParams)
+	// 指定versionからの変更差分
+	// (GET /minkan/changes)
+	GetMinkanChanges(w http.ResponseWriter, r *http.Request, params GetMinkanChangesParams)
+	// minkan更新通知のストリーム(Server-Sent Events)
+	// (GET /minkan/events)
+	GetMinkanEvents(w http.ResponseWriter, r *http.Request)
+	// ライブ同期用WebSocket
+	// (GET /minkan/live)
+	GetMinkanLive(w http.ResponseWriter, r *http.Request, params GetMinkanLiveParams)
+	// オフライン編集の同期(CRDT)
+	// (POST /minkan/sync)
+	PostMinkanSync(w http.ResponseWriter, r *http.Request)
+	// メール配信停止の確認ページ（ログイン不要）
+	// (GET /notifications/unsubscribe)
+	GetNotificationsUnsubscribe(w http.ResponseWriter, r *http.Request, params GetNotificationsUnsubscribeParams)
+	// メール配信停止（ログイン不要）
+	// (POST /notifications/unsubscribe)
+	PostNotificationsUnsubscribe(w http.ResponseWriter, r *http.Request, params PostNotificationsUnsubscribeParams)
+	// ノードの繰り返しの発生予定
+	// (GET /projects/{pjId}/nodes/{nodeId}/occurrences)
+	GetProjectsPjIdNodesNodeIdOccurrences(w http.ResponseWriter, r *http.Request, pjId string, nodeId string, params GetProjectsPjIdNodesNodeIdOccurrencesParams)
+	// プロジェクトの進捗
+	// (GET /projects/{pjId}/progress)
+	GetProjectsPjIdProgress(w http.ResponseWriter, r *http.Request, pjId string)
+	// 繰り返しルールの発生予定（保存前の確認用）
+	// (POST /recurrence/preview)
+	PostRecurrencePreview(w http.ResponseWriter, r *http.Request, params PostRecurrencePreviewParams)
+	// ユーザーの退会処理
+	// (DELETE /users/me)
+	DeleteUsersMe(w http.ResponseWriter, r *http.Request)
+	// ログインユーザー情報を取得（初回は自動登録）
+	// (GET /users/me)
+	GetUsersMe(w http.ResponseWriter, r *http.Request)
+	// ユーザー設定の更新
+	// (PATCH /users/me)
+	PatchUsersMe(w http.ResponseWriter, r *http.Request)
+	// アプリパスワードの一覧
+	// (GET /users/me/app-passwords)
+	GetUsersMeAppPasswords(w http.ResponseWriter, r *http.Request)
+	// アプリパスワードの発行
+	// (POST /users/me/app-passwords)
+	PostUsersMeAppPasswords(w http.ResponseWriter, r *http.Request)
+	// アプリパスワードの無効化
+	// (DELETE /users/me/app-passwords/{appPasswordId})
+	DeleteUsersMeAppPasswordsAppPasswordId(w http.ResponseWriter, r *http.Request, appPasswordId int64)
+	// iCalendarフィードのURLを無効化
+	// (DELETE /users/me/calendar-feed)
+	DeleteUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request)
+	// iCalendarフィードの発行状況
+	// (GET /users/me/calendar-feed)
+	GetUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request)
+	// iCalendarフィードのURLを発行（再発行）
+	// (POST /users/me/calendar-feed)
+	PostUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request)
+	// アカウントに連携したログイン方法（IdP）の一覧
+	// (GET /users/me/identities)
+	GetUsersMeIdentities(w http.ResponseWriter, r *http.Request)
+	// ログイン方法（IdP）の連携を開始
+	// (POST /users/me/identities)
+	PostUsersMeIdentities(w http.ResponseWriter, r *http.Request)
+	// 保留中の連携を確定
+	// (POST /users/me/identities/pending)
+	PostUsersMeIdentitiesPending(w http.ResponseWriter, r *http.Request)
+	// ログイン方法（IdP）の連携を解除
+	// (DELETE /users/me/identities/{identityId})
+	DeleteUsersMeIdentitiesIdentityId(w http.ResponseWriter, r *http.Request, identityId int64)
+	// タスク取り込み用URL・メールアドレスの無効化
+	// (DELETE /users/me/inbound)
+	DeleteUsersMeInbound(w http.ResponseWriter, r *http.Request)
+	// タスク取り込み用URLの発行状況
+	// (GET /users/me/inbound)
+	GetUsersMeInbound(w http.ResponseWriter, r *http.Request)
+	// タスク取り込み用URL・メールアドレスの発行（再発行）
+	// (POST /users/me/inbound)
+	PostUsersMeInbound(w http.ResponseWriter, r *http.Request)
+	// 取り込み先プロジェクトの変更（URLは変わらない）
+	// (PUT /users/me/inbound)
+	PutUsersMeInbound(w http.ResponseWriter, r *http.Request)
+	// 通知設定の取得
+	// (GET /users/me/notifications)
+	GetUsersMeNotifications(w http.ResponseWriter, r *http.Request)
+	// 通知設定の更新
+	// (PUT /users/me/notifications)
+	PutUsersMeNotifications(w http.ResponseWriter, r *http.Request)
+	// 現在のセッション以外を全てログアウト
+	// (DELETE /users/me/sessions)
+	DeleteUsersMeSessions(w http.ResponseWriter, r *http.Request)
+	// ログイン中のセッション（端末）の一覧
+	// (GET /users/me/sessions)
+	GetUsersMeSessions(w http.ResponseWriter, r *http.Request)
+	// セッションを指定してログアウト
+	// (DELETE /users/me/sessions/{sessionId})
+	DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request, sessionId string)
+	// パーソナルアクセストークンの一覧
+	// (GET /users/me/tokens)
+	GetUsersMeTokens(w http.ResponseWriter, r *http.Request)
+	// パーソナルアクセストークンの発行
+	// (POST /users/me/tokens)
+	PostUsersMeTokens(w http.ResponseWriter, r *http.Request)
+	// パーソナルアクセストークンの無効化
+	// (DELETE /users/me/tokens/{tokenId})
+	DeleteUsersMeTokensTokenId(w http.ResponseWriter, r *http.Request, tokenId int64)
+	// Webhookの一覧
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request)
+	// Webhookの登録
+	// (POST /webhooks)
+	PostWebhooks(w http.ResponseWriter, r *http.Request)
+	// Webhookの削除（配信記録も削除する）
+	// (DELETE /webhooks/{webhookId})
+	DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+	// Webhookの更新
+	// (PUT /webhooks/{webhookId})
+	PutWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+	// Webhookの配信記録（新しい順）
+	// (GET /webhooks/{webhookId}/deliveries)
+	GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params GetWebhooksWebhookIdDeliveriesParams)
+	// 疎通確認
+	// (POST /webhooks/{webhookId}/ping)
+	PostWebhooksWebhookIdPing(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivityParams
+
+	// ------------- Optional query parameter "pjId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pjId", r.URL.Query(), &params.PjId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pjId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nodeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nodeId", r.URL.Query(), &params.NodeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActivity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalytics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsParams
+
+	// ------------- Optional query parameter "pjId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pjId", r.URL.Query(), &params.PjId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pjId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnalytics(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthCallback(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthCallback(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthCallbackProvider operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthCallbackProvider(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", r.PathValue("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthCallbackProvider(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAuthLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAuthLink(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAuthLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthLink operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthLink(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthLogin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthLoginParams
+
+	// ------------- Optional query parameter "provider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthLogin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthProviders operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthProviders(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthProviders(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendar(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendar(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendarFeedFeedFile operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeedFeedFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "feedFile" -------------
+	var feedFile string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "feedFile", r.PathValue("feedFile"), &feedFile, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedFile", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarFeedFeedFile(w, r, feedFile)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthz operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthz(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostInboundInboundToken operation middleware
+func (siw *ServerInterfaceWrapper) PostInboundInboundToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "inboundToken" -------------
+	var inboundToken string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inboundToken", r.PathValue("inboundToken"), &inboundToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inboundToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostInboundInboundToken(w, r, inboundToken)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMinkan operation middleware
+func (siw *ServerInterfaceWrapper) GetMinkan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMinkanParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMinkan(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutMinkan operation middleware
+func (siw *ServerInterfaceWrapper) PutMinkan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutMinkanParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Minkan-Origin" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Minkan-Origin")]; found {
+		var XMinkanOrigin MinkanOrigin
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Minkan-Origin", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Minkan-Origin", valueList[0], &XMinkanOrigin, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Minkan-Origin", Err: err})
+			return
+		}
+
+		params.XMinkanOrigin = &XMinkanOrigin
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutMinkan(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMinkanChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetMinkanChanges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMinkanChangesParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMinkanChanges(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMinkanEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetMinkanEvents(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMinkanEvents(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMinkanLive operation middleware
+func (siw *ServerInterfaceWrapper) GetMinkanLive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMinkanLiveParams
+
+	// ------------- Required query parameter "csrfToken" -------------
+
+	if paramValue := r.URL.Query().Get("csrfToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "csrfToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "csrfToken", r.URL.Query(), &params.CsrfToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "csrfToken", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deviceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deviceId", r.URL.Query(), &params.DeviceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deviceName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deviceName", r.URL.Query(), &params.DeviceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMinkanLive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostMinkanSync operation middleware
+func (siw *ServerInterfaceWrapper) PostMinkanSync(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMinkanSync(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationsUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationsUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationsUnsubscribe(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostNotificationsUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostNotificationsUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostNotificationsUnsubscribe(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsPjIdNodesNodeIdOccurrences operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsPjIdNodesNodeIdOccurrences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pjId" -------------
+	var pjId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pjId", r.PathValue("pjId"), &pjId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pjId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsPjIdNodesNodeIdOccurrencesParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsPjIdNodesNodeIdOccurrences(w, r, pjId, nodeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsPjIdProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsPjIdProgress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pjId" -------------
+	var pjId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pjId", r.PathValue("pjId"), &pjId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pjId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsPjIdProgress(w, r, pjId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRecurrencePreview operation middleware
+func (siw *ServerInterfaceWrapper) PostRecurrencePreview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRecurrencePreviewParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRecurrencePreview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersMeAppPasswords operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeAppPasswords(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeAppPasswords(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMeAppPasswords operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeAppPasswords(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeAppPasswords(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersMeAppPasswordsAppPasswordId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeAppPasswordsAppPasswordId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appPasswordId" -------------
+	var appPasswordId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appPasswordId", r.PathValue("appPasswordId"), &appPasswordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appPasswordId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeAppPasswordsAppPasswordId(w, r, appPasswordId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersMeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeCalendarFeed(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersMeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeCalendarFeed(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeCalendarFeed(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersMeIdentities operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeIdentities(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeIdentities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMeIdentities operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeIdentities(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeIdentities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMeIdentitiesPending operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeIdentitiesPending(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeIdentitiesPending(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersMeIdentitiesIdentityId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeIdentitiesIdentityId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identityId" -------------
+	var identityId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identityId", r.PathValue("identityId"), &identityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeIdentitiesIdentityId(w, r, identityId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersMeInbound operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeInbound(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeInbound(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type PostAuthLogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	handler.ServeHTTP(w, r)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAuthLogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// GetUsersMeInbound operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeInbound(w http.ResponseWriter, r *http.Request) {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthLogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	ctx := r.Context()
 
-type GetHealthzResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Healthz
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-// Status returns HTTPResponse.Status
-func (r GetHealthzResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthzResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	r = r.WithContext(ctx)
 
-type GetMinkanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MinkanGetRes
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeInbound(w, r)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r GetMinkanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMinkanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	handler.ServeHTTP(w, r)
 }
 
-type PutMinkanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MinkanPutRes
-}
+// PostUsersMeInbound operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeInbound(w http.ResponseWriter, r *http.Request) {
 
-// Status returns HTTPResponse.Status
-func (r PutMinkanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	ctx := r.Context()
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutMinkanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-type DeleteUsersMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
 
-// Status returns HTTPResponse.Status
-func (r DeleteUsersMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	r = r.WithContext(ctx)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUsersMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeInbound(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type GetUsersMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	handler.ServeHTTP(w, r)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// PutUsersMeInbound operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersMeInbound(w http.ResponseWriter, r *http.Request) {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	ctx := r.Context()
 
-// GetAuthCallbackWithResponse request returning *GetAuthCallbackResponse
-func (c *ClientWithResponses) GetAuthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthCallbackResponse, error) {
-	rsp, err := c.GetAuthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAuthCallbackResponse(rsp)
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-// GetAuthLoginWithResponse request returning *GetAuthLoginResponse
-func (c *ClientWithResponses) GetAuthLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthLoginResponse, error) {
-	rsp, err := c.GetAuthLogin(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAuthLoginResponse(rsp)
-}
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
 
-// PostAuthLogoutWithResponse request returning *PostAuthLogoutResponse
-func (c *ClientWithResponses) PostAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
-	rsp, err := c.PostAuthLogout(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAuthLogoutResponse(rsp)
-}
+	r = r.WithContext(ctx)
 
-// GetHealthzWithResponse request returning *GetHealthzResponse
-func (c *ClientWithResponses) GetHealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthzResponse, error) {
-	rsp, err := c.GetHealthz(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetHealthzResponse(rsp)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUsersMeInbound(w, r)
+	}))
 
-// GetMinkanWithResponse request returning *GetMinkanResponse
-func (c *ClientWithResponses) GetMinkanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinkanResponse, error) {
-	rsp, err := c.GetMinkan(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseGetMinkanResponse(rsp)
-}
 
-// PutMinkanWithBodyWithResponse request with arbitrary body returning *PutMinkanResponse
-func (c *ClientWithResponses) PutMinkanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error) {
-	rsp, err := c.PutMinkanWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutMinkanResponse(rsp)
+	handler.ServeHTTP(w, r)
 }
 
-func (c *ClientWithResponses) PutMinkanWithResponse(ctx context.Context, body PutMinkanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMinkanResponse, error) {
-	rsp, err := c.PutMinkan(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutMinkanResponse(rsp)
-}
+// GetUsersMeNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeNotifications(w http.ResponseWriter, r *http.Request) {
 
-// DeleteUsersMeWithResponse request returning *DeleteUsersMeResponse
-func (c *ClientWithResponses) DeleteUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeResponse, error) {
-	rsp, err := c.DeleteUsersMe(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteUsersMeResponse(rsp)
-}
+	ctx := r.Context()
 
-// GetUsersMeWithResponse request returning *GetUsersMeResponse
-func (c *ClientWithResponses) GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error) {
-	rsp, err := c.GetUsersMe(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetUsersMeResponse(rsp)
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-// ParseGetAuthCallbackResponse parses an HTTP response from a GetAuthCallbackWithResponse call
-func ParseGetAuthCallbackResponse(rsp *http.Response) (*GetAuthCallbackResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	response := &GetAuthCallbackResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeNotifications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseGetAuthLoginResponse parses an HTTP response from a GetAuthLoginWithResponse call
-func ParseGetAuthLoginResponse(rsp *http.Response) (*GetAuthLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PutUsersMeNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersMeNotifications(w http.ResponseWriter, r *http.Request) {
 
-	response := &GetAuthLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUsersMeNotifications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParsePostAuthLogoutResponse parses an HTTP response from a PostAuthLogoutWithResponse call
-func ParsePostAuthLogoutResponse(rsp *http.Response) (*PostAuthLogoutResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// DeleteUsersMeSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeSessions(w http.ResponseWriter, r *http.Request) {
 
-	response := &PostAuthLogoutResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	return response, nil
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-// ParseGetHealthzResponse parses an HTTP response from a GetHealthzWithResponse call
-func ParseGetHealthzResponse(rsp *http.Response) (*GetHealthzResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
 
-	response := &GetHealthzResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	r = r.WithContext(ctx)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Healthz
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeSessions(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseGetMinkanResponse parses an HTTP response from a GetMinkanWithResponse call
-func ParseGetMinkanResponse(rsp *http.Response) (*GetMinkanResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetUsersMeSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
 
-	response := &GetMinkanResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MinkanGetRes
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParsePutMinkanResponse parses an HTTP response from a PutMinkanWithResponse call
-func ParsePutMinkanResponse(rsp *http.Response) (*PutMinkanResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteUsersMeSessionsSessionId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", r.PathValue("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
 	}
 
-	response := &PutMinkanResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MinkanPutRes
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-	}
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
 
-	return response, nil
-}
+	r = r.WithContext(ctx)
 
-// ParseDeleteUsersMeResponse parses an HTTP response from a DeleteUsersMeWithResponse call
-func ParseDeleteUsersMeResponse(rsp *http.Response) (*DeleteUsersMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeSessionsSessionId(w, r, sessionId)
+	}))
 
-	response := &DeleteUsersMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseGetUsersMeResponse parses an HTTP response from a GetUsersMeWithResponse call
-func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetUsersMeTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeTokens(w http.ResponseWriter, r *http.Request) {
 
-	response := &GetUsersMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeTokens(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// OIDC callback
-	// (GET /auth/callback)
-	GetAuthCallback(w http.ResponseWriter, r *http.Request)
-	// Redirect to IdP (Google)
-	// (GET /auth/login)
-	GetAuthLogin(w http.ResponseWriter, r *http.Request)
-	// Logout
-	// (POST /auth/logout)
-	PostAuthLogout(w http.ResponseWriter, r *http.Request)
-	// ヘルスチェック用
-	// (GET /healthz)
-	GetHealthz(w http.ResponseWriter, r *http.Request)
-	// mindmap,kanban,作業中プロジェクトIDの取得
-	// (GET /minkan)
-	GetMinkan(w http.ResponseWriter, r *http.Request)
-	// mindmap,kanban,作業中プロジェクトIDの更新
-	// (PUT /minkan)
-	PutMinkan(w http.ResponseWriter, r *http.Request)
-	// ユーザーの退会処理
-	// (DELETE /users/me)
-	DeleteUsersMe(w http.ResponseWriter, r *http.Request)
-	// ログインユーザー情報を取得（初回は自動登録）
-	// (GET /users/me)
-	GetUsersMe(w http.ResponseWriter, r *http.Request)
-}
+// PostUsersMeTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeTokens(w http.ResponseWriter, r *http.Request) {
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	ctx := r.Context()
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-// GetAuthCallback operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthCallback(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthCallback(w, r)
+		siw.Handler.PostUsersMeTokens(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1042,11 +8965,30 @@ func (siw *ServerInterfaceWrapper) GetAuthCallback(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthLogin(w http.ResponseWriter, r *http.Request) {
+// DeleteUsersMeTokensTokenId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeTokensTokenId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", r.PathValue("tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthLogin(w, r)
+		siw.Handler.DeleteUsersMeTokensTokenId(w, r, tokenId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1056,19 +8998,19 @@ func (siw *ServerInterfaceWrapper) GetAuthLogin(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PostAuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthLogout(w, r)
+		siw.Handler.GetWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1078,11 +9020,19 @@ func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealthz operation middleware
-func (siw *ServerInterfaceWrapper) GetHealthz(w http.ResponseWriter, r *http.Request) {
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealthz(w, r)
+		siw.Handler.PostWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1092,17 +9042,30 @@ func (siw *ServerInterfaceWrapper) GetHealthz(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetMinkan operation middleware
-func (siw *ServerInterfaceWrapper) GetMinkan(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMinkan(w, r)
+		siw.Handler.DeleteWebhooksWebhookId(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1112,8 +9075,19 @@ func (siw *ServerInterfaceWrapper) GetMinkan(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PutMinkan operation middleware
-func (siw *ServerInterfaceWrapper) PutMinkan(w http.ResponseWriter, r *http.Request) {
+// PutWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) PutWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1124,7 +9098,7 @@ func (siw *ServerInterfaceWrapper) PutMinkan(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutMinkan(w, r)
+		siw.Handler.PutWebhooksWebhookId(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1134,19 +9108,49 @@ func (siw *ServerInterfaceWrapper) PutMinkan(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteUsersMe operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUsersMe(w http.ResponseWriter, r *http.Request) {
+// GetWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksWebhookIdDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUsersMe(w, r)
+		siw.Handler.GetWebhooksWebhookIdDeliveries(w, r, webhookId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1156,17 +9160,30 @@ func (siw *ServerInterfaceWrapper) DeleteUsersMe(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersMe operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+// PostWebhooksWebhookIdPing operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksWebhookIdPing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, CsrfTokenScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersMe(w, r)
+		siw.Handler.PostWebhooksWebhookIdPing(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1296,14 +9313,61 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/activity", wrapper.GetActivity)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics", wrapper.GetAnalytics)
 	m.HandleFunc("GET "+options.BaseURL+"/auth/callback", wrapper.GetAuthCallback)
+	m.HandleFunc("GET "+options.BaseURL+"/auth/callback/{provider}", wrapper.GetAuthCallbackProvider)
+	m.HandleFunc("DELETE "+options.BaseURL+"/auth/link", wrapper.DeleteAuthLink)
+	m.HandleFunc("GET "+options.BaseURL+"/auth/link", wrapper.GetAuthLink)
 	m.HandleFunc("GET "+options.BaseURL+"/auth/login", wrapper.GetAuthLogin)
 	m.HandleFunc("POST "+options.BaseURL+"/auth/logout", wrapper.PostAuthLogout)
+	m.HandleFunc("GET "+options.BaseURL+"/auth/providers", wrapper.GetAuthProviders)
+	m.HandleFunc("GET "+options.BaseURL+"/calendar", wrapper.GetCalendar)
+	m.HandleFunc("GET "+options.BaseURL+"/calendar/feed/{feedFile}", wrapper.GetCalendarFeedFeedFile)
 	m.HandleFunc("GET "+options.BaseURL+"/healthz", wrapper.GetHealthz)
+	m.HandleFunc("POST "+options.BaseURL+"/inbound/{inboundToken}", wrapper.PostInboundInboundToken)
 	m.HandleFunc("GET "+options.BaseURL+"/minkan", wrapper.GetMinkan)
 	m.HandleFunc("PUT "+options.BaseURL+"/minkan", wrapper.PutMinkan)
+	m.HandleFunc("GET "+options.BaseURL+"/minkan/changes", wrapper.GetMinkanChanges)
+	m.HandleFunc("GET "+options.BaseURL+"/minkan/events", wrapper.GetMinkanEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/minkan/live", wrapper.GetMinkanLive)
+	m.HandleFunc("POST "+options.BaseURL+"/minkan/sync", wrapper.PostMinkanSync)
+	m.HandleFunc("GET "+options.BaseURL+"/notifications/unsubscribe", wrapper.GetNotificationsUnsubscribe)
+	m.HandleFunc("POST "+options.BaseURL+"/notifications/unsubscribe", wrapper.PostNotificationsUnsubscribe)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{pjId}/nodes/{nodeId}/occurrences", wrapper.GetProjectsPjIdNodesNodeIdOccurrences)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{pjId}/progress", wrapper.GetProjectsPjIdProgress)
+	m.HandleFunc("POST "+options.BaseURL+"/recurrence/preview", wrapper.PostRecurrencePreview)
 	m.HandleFunc("DELETE "+options.BaseURL+"/users/me", wrapper.DeleteUsersMe)
 	m.HandleFunc("GET "+options.BaseURL+"/users/me", wrapper.GetUsersMe)
+	m.HandleFunc("PATCH "+options.BaseURL+"/users/me", wrapper.PatchUsersMe)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/app-passwords", wrapper.GetUsersMeAppPasswords)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/app-passwords", wrapper.PostUsersMeAppPasswords)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/app-passwords/{appPasswordId}", wrapper.DeleteUsersMeAppPasswordsAppPasswordId)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/calendar-feed", wrapper.DeleteUsersMeCalendarFeed)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/calendar-feed", wrapper.GetUsersMeCalendarFeed)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/calendar-feed", wrapper.PostUsersMeCalendarFeed)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/identities", wrapper.GetUsersMeIdentities)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/identities", wrapper.PostUsersMeIdentities)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/identities/pending", wrapper.PostUsersMeIdentitiesPending)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/identities/{identityId}", wrapper.DeleteUsersMeIdentitiesIdentityId)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/inbound", wrapper.DeleteUsersMeInbound)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/inbound", wrapper.GetUsersMeInbound)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/inbound", wrapper.PostUsersMeInbound)
+	m.HandleFunc("PUT "+options.BaseURL+"/users/me/inbound", wrapper.PutUsersMeInbound)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/notifications", wrapper.GetUsersMeNotifications)
+	m.HandleFunc("PUT "+options.BaseURL+"/users/me/notifications", wrapper.PutUsersMeNotifications)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/sessions", wrapper.DeleteUsersMeSessions)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/sessions", wrapper.GetUsersMeSessions)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/sessions/{sessionId}", wrapper.DeleteUsersMeSessionsSessionId)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/tokens", wrapper.GetUsersMeTokens)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/tokens", wrapper.PostUsersMeTokens)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/tokens/{tokenId}", wrapper.DeleteUsersMeTokensTokenId)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	m.HandleFunc("DELETE "+options.BaseURL+"/webhooks/{webhookId}", wrapper.DeleteWebhooksWebhookId)
+	m.HandleFunc("PUT "+options.BaseURL+"/webhooks/{webhookId}", wrapper.PutWebhooksWebhookId)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks/{webhookId}/deliveries", wrapper.GetWebhooksWebhookIdDeliveries)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks/{webhookId}/ping", wrapper.PostWebhooksWebhookIdPing)
 
 	return m
 }
@@ -1311,32 +9375,231 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXW1MURxT+K1OdPGhlYFcxVWbfCCZkY9At1KpUESrVzDa7I7PTw0yPhlBbxUwnsAgG",
-	"ikLwgndExAAackFd9b+kmV144i+kumf2xgwq1upTXpam5/Tpc77z9denh4GCcwbWkU4skBgGlpJFOSiG",
-	"3yCokezPfGiY2EAmUZH4kEOWBTOID8mQgUACWMRU9QzI52VgokFbNVEaJHqqhr1yxRD3nUcKAXkZdKn6",
-	"ANQ7Een2naaRpZiqQVSsg0TwVfpMuoBMS8X6oc6vzsZyYpI5ayayGF1gdIy59w8DeW94woyPYDqtcodQ",
-	"S9VZENNG8p79uuFF6dszp09JfRruk3C/FARgEUgQ2Bu+DH5qyeCWYPK8hfXWbnixK8g2L4Mg6nBepQcv",
-	"t5c2GF1llDJ3vTy7XDGVQT82c5CABFB10na0tqmqE5RBZhhdP8/abvvDnLJJNxp8B5hT5xpgHvwf5gPD",
-	"bDUX5o+S45tSO2chM6wBadUyNDh0CuaEDui2psE+DVXKvkcXZIByUNXewXJPYP4yuWG7cJB5GVhIsU2V",
-	"DJ3h+uWHqGA8oKJ2m2T5fyrHzJ8CMtBF3EFtf7SQFQAXOIaGehIN8bgVy+w/iweQXvWRRTCNzJqP71s6",
-	"znR/3eIbhTzw2FS9H4frl1P1dA4ajL44CfU+qEuHfJocltpTyVapE+OMhqTTyRMdEnNnvMKj8uwyc+aZ",
-	"c3t75fL2cpFPjl8uTU0zR8yPOIwuMVpk7t/815kV82uM3mLuIqMbjI7zMaWMzu8WJ5j7WExOi98xsfA1",
-	"c2fKa3fL06OtP4hcVMILBTqx5CPV0p5KSmdRztD881olJzjSGm+Nc8CwgXRoqCAB2lrjrW2cz5BkRUFi",
-	"0CbZmAI1rQ8qA3wmg0gYmO2Vy97UOnM3eEx03Jua37lz9d/RGUYLIsp1Rje8qTnv1TyfdF8I/v/D6ENG",
-	"N8rXnm/fnWQjrrc2ufV81Hs1yegVcUY2+HJnsy3OjwNnMuTbJdM8PUQ4SzoqgXEKWgbWLZ9HfEkoyG6U",
-	"Vk2kEIlgqd/EOmlBeprn/3k8HrZm7l8imWnmLjP6iNFiA2lBoqdXBpady0FzCCSAKLpSC4fAjMVPg+By",
-	"L1/pQ6nhjKq/DUe6wiFzl5n7jEPgzpRnb5cK0z5lfJb5uHBLOiLI8rtAubAfUt+JfQ8Kk79XszCq95xM",
-	"p6RDvvvDb4YL2wIoA1vib2N2KWxV0uN2e/I7Ghk0XWXuE+beY+4So4VSYdq7dHu3WOgQQuMtPvUuPStt",
-	"Fpjzerc4zlM/Fj8SWSlxoKt5c7u2sB0XmtLiQqNtI0aNstfTm5eH6zWspzffgGI11QjMsrX+L+BXiAyV",
-	"FjEaKgXrBOliJTQMTVXE2hi/yGutJh99aqJ+kACfxGq9aCxoRGOVLYSSNsJRnvttZ+S6j3mzaMXoVUYf",
-	"i7PiMPdh9W6tg6gSkY9SrQ2KPIQVgnAF5mrkPPalizlXmDvJ3ImoM9ZVaTk+GKoN3XcEtKdPHoysx6Iy",
-	"r1wqzmRpYaV87cXO5B8HLlO1MMF1KQ+Iy1LeerlQerC6tbnK6LzAeJNXS6hW8gRz1nyU66oWYNqbl4Fh",
-	"Rx1+ux72QRtZ5EucHmoy4kEjHoF46cafpbkn/I6v4AbqWyHeLOU/OCGCBrYJhHhX9TooeY7Fv3hrK9zg",
-	"/j1F4YA6ekB2+sWOYieXFNtCphXzG+s00hBBUQDV9Xo1sJaZc5M5a8Hboq6t88Yv7VxbDInNCeGet/hW",
-	"FwoLTkRlSqv3vc1NrmO+xw/Eio9TtsaOeW1nZGSreN0bWypPj9YVR8AjlGO/e3BfAJt3QMUzLOJg1qdQ",
-	"or96d542QbkjuTW5vTTBnEXmTDB3nDkrzPnl/eW8/l4Mp8AJKwR8t1jwCje9G7eYs749tuJNXPGlgHdT",
-	"4QK9jQy89sjkjxbx1TY1/p4jxEjEYhpWoJbFFkkcjx+Pxy4cAdw82GG48tirdiPy3oz5uyeZ7sC6jhTi",
-	"I70zd29n5H7tpSjiCK+sT99f0p5K1lb5yYWXdQXvR2fZfz9WKxXhI5CXfG/+vwAAAP//UG0IPPATAAA=",
+	"H4sIAAAAAAAC/+x9fVMTZ9/oV8nknD/gnGjQ1k5vZzpnqNCWp1Y5gO3TUzu912SFrSGb7i4qt8NMdiMY",
+	"FApFBVGs74JQklq1RYP6XZ5lE/KXX+HM73rZvXb32mQDibW9n5mOJcm+XNfv+r2/nosm5OGMnBbTmho9",
+	"eC6aERRhWNREBX36QkqfEtJHFWlQSsPnpKgmFCmjSXI6ejBavvG0PP+rNZ4zjaKZe2Qa903jrpl7Yuby",
+	"babx2szNm7lSZa1YXlprN/XC9vo1K//AWp81swa+tZq9Xrn1wNQLMnqDqa+Y+oKpPzT1m6ZeMPVXpv6q",
+	"Oj699fqOqV81jSnTuBSNRWEp0SFRSIpKNBZNC8Ni9GD0P/fgte4hi41F1cSQOCzAqoeFs4fF9KA2FD24",
+	"b/+Hsag2moFbVE2R0oPRsbFYtFcRT0vimUPySFrz73P79RVTX9wq/V6++uubzXx54a5VuH7AzOrlpax1",
+	"f/lAx5vNSbquH0ZEZdRZVgI90bMYaXhkOHrwQEcsOiyl8Yd99qqktCYOigpa1rG0OnICVnJCHJBPiZwj",
+	"wNCx9KXy+l0zlzdzm+gsnrzZzJu5O/Axt2ZNjAM0c6twNEbR1Nes2TUALgKomTUqiy+270yZ+iXTmPxH",
+	"R3nhgWnMVfUfTf1H+F1fqZy/Y118HrxJDa0tFlXEH0YkRUxGD2rKiMhu2g/xr8QTQ7J8qicJP6OHZgRt",
+	"yHnmGfv3Ws89KSvDgoah9sH7UQ4Qx+jlCJ87EwlRVW1YZhQ5IyqaJKIfE4ooaGKyU3M9OSlo4h5NGhaj",
+	"PsSJRcWzGUkR1U4O0pSXJq2Lz8tLt6qLs2828+mRVMrUC9btp9Zs3tSLlfN36G8AVu7r4B7hREqk+/a9",
+	"XkqGAkIsmhJU7Zhac291X4YP5pz/BzUhZ9Av/1MRT0YPRv9H3GEpcQL8OAP5fnT92Bh7sN/AXsg76BNZ",
+	"8MaY03Ft51t7pfKJ78WEBgti3nUI3dUn/uA/7vBnV1nSK1cfmLlSSw6RwtW9hMqVlWr2iqlPWfkJRJmX",
+	"rNlpa3L6zWZ+69WlyPGomZs1c4jzAs/NmbmFypWVhCKnj0fxahiu98H7iNXYTDDWqkN0nV+oo0k2nw5b",
+	"TEvNpoMY4Z9+FCBsecHUb3l5u34ZsfRfTOO5mbuJGPtzU1+GL/XXWF5hLPBz3p0SHV5lnUPtpzBwb0UR",
+	"hWTE1Iufdg+YudJn3Z1deKVmVj+jSJoIv2GVwDQMa6YIy0iDXPwG3RqNRdFl0W99G4K3a9JpSRvtPi2m",
+	"taZgU1LQkHQRkkkJNiCkeplHYvzxHNVKoXrnZ1O/glSYwvajJ5Wnv5pZQ9UETTT1AtmbXoxkvj8iDItm",
+	"rpQSTogpM1fKKOLpw/B3W1pOinsVEY4j2W7mSicVeTiuyW0JQUnuHZZPw7dZPWLm1k3jV1C2QNMq0Y93",
+	"TeMhYIlejEgZM1caUUWlc1BMa8fTUc6ZhUd3OSliKV2XhOR6eiJhXgkhlRROR+IRKX1CHkknDw5pWiYS",
+	"jwCNJEdSonJQERMjiiKmEyKoJ1dK1Zt3sXrCwtKvdZp6oaeLi/exaOb7kLvAX3j3IIxoQ3tT8qCUjsQj",
+	"9IM8otFPUlJMa5I2+l1KSp8Sk76vR9LODwmkFe5NiikRnv4dUKSoamISHS9ovbdMvUg0JFNfsWanTP0a",
+	"OvFFe6MY5d5s5hF6JOW0CFrb4n1Tn3mzOckeurO106Kiou0EnNCrKVMvDCM92tQL5Oo3m3k3Gm+VHlj3",
+	"5029CKD0SD4prb23P5jdssqtjw+ha8hB2XhnY5WzekKgLHficyXMF/pE1c8VxNPU4JE0cVitz7VZHmMj",
+	"SVRQFGEUPqfFs9rH4klZ4Yny36+b+rRpzFkz89arBVNfBGlOdIjCCXQXaBn4Mn2qcv6OqZ+3lQw+lBG1",
+	"NghlsmnXarmQSwupUU1KqJ2DUnqwRxOH/QAUBsXP5BFsJQYsIz0yfIKqnyfEFFdmOtwlkGB9P6iaoNgc",
+	"3Q3qpCylB8HAGX9g6vdM/VZ54UF50Xizmd9euVad+q06f8lavmRNTmNuQi97aOrnmTPhgbwBXcIDdS8+",
+	"Y2Cw24g50Kx5HJ+k5DNdwqj/ME4IiVMpeZCBFsPAYeU+2ccVewC8gGfIaZH/iyYnZd4vHiCQd9KFkvvo",
+	"O8kLam6+V1QSwEtTouo/d9DC5y8jQ3fNKkxtvZggapOxhmxgJDcms9sP9fKiUZ2/DFY8+sOavrb1ctrM",
+	"6lbx1fbjO7Woz6NWUFeBHySZAx2hqCLz4YFw1/0jzHUegFPHAywGvwo/qCaMuawyMTI8khI06bQI+MeB",
+	"/cIDW/GpPDNMY8Y0LpYXjYrxHOR96SK6oFiZeWUtrdDv4UCs2fPofB6ZudugDtKzKl/9NRoLyZe9pMFh",
+	"zYnRREockIbF0A9jUW0sFgU9LBQBpUQhuZsXaUOKPDI4lBnhcLZq9jEg7VK+fGPJWr6E3DcXERwJ6DHa",
+	"7wR2X4niKR7ggNv9P0L4viPfKl0zc6Vq9jG8euqFlb9gGhdNfW3r5WvMecEBCBrLbdN4hc71CQ9kmhwK",
+	"smdk5RTIoc5BMZjpm7oB/iqKRiBSn01V9R8xpZt6oXr1D1M/X709QVxZDUHJkYU+UHlIz4YbQR20S9fp",
+	"MqjC4qd7nzEv5dUkXXSIftoN5lJnRPFUP4igEAfg2aBza4y8gbu0TKZXUNUzstIcG/9dcHbVMJ8b8U85",
+	"kKnhn9qhX0jqHZLTYgS5e3+mVmLWzG3uxC/Ec++E2hHvyJHVd0xJ+Td1SEh1dX7Js+fWtlfWrcJ1rDOb",
+	"xjNE2rPH+g7zMKSVSBXo9ckwOF7Lf/MTctUUbd7UChcOi4P2umIM5LlnN6IN9SryaSkpKv5Tkzgb60n2",
+	"suGcN5v5T7sHInEweOPIRP4/GfK8jyKmvlaeumAfYYBxzsf17TsrlfsvrNnpBoDA2+EhISWmk4LSndaU",
+	"UR6TTI0Mc4xiJEmeID/rE6qfgK4Ch+f6aS1QZ6ROrLp6b12GlBwRG8Jrtcutsp+Q5ZQopHdsjcmnRSU5",
+	"wtMFllapxn3J1O9jfzhSB6aiMc7bA8067Bnj/6RIsiJpo3xJhgyp8MDhm2fk7Rw7jYAyRvHEgUUtXPtE",
+	"FJP9mqCNqHWEnxuax/oOgxqNOAe2XXccVxDT8DOHfM3cVdO4Z9tF6JVT5JUbefDK0hAHPJl7iCDgsNeX",
+	"vw1EH7/Yogcx9AUQScjshrApuJrWqEMExXb1W7vasOdY6e79gplZd70DbF6gcERJ1T2IyvI1qzhxrO9w",
+	"E6UDvLeeo4zumO8oS2sK+TOUvuzmtRyrIrQ1FWx+wCPMXEmTQQot36teyLfI8Ain2FMQcWGrJLUeDg0e",
+	"FoYzsqIxa30OfwPBLICdd3l66+US8mWbWaMtEYsoKIPi1c/lGxvV2xOmvmyNr1RvT1gvZvyuCT49Qnjg",
+	"iWm85jkS/UxV4aHrL4SKjbWerrrQSkThKUFAOZrhqIF9XQN452bWOCWlk9S4XbNej28/1E19lSGZNUQ1",
+	"U5Wrv8L3xiUfHISTmqjUxVd8QGMxRg0ILbU5LrQgoSslw68Ets6uI6PIALq9mIyjMfsLHCxivkAhBSrF",
+	"nOvRJ4gg0b8zsooiW/SzfR8SentVUSO7JH+iGIOUVkXF/kRu4UFhZ+5eQRHTWqO+YHsjPsZ1lrne8aWN",
+	"cr714O3ZKFzGw1tNUAZFLew58lRUdLJkO7w3fCYKKW3oX/7dDIuqKgyGMEnphbyn9+Bw2yEho40ofKPz",
+	"hJwc5VH+j7aUMo0nKLMI7LM3m/l9HR0dHeX5C9b6gmnMbf8+bup5MErBOi1ir9D2o19NQw9W/jVJS4l1",
+	"XgqK96KZW3uzmd+/u/eNhQAMRw7uJFARGG6zZuZhna82Tf01P+jGi6nVcbF74wz0STUwoV/UNCk9qHJR",
+	"gW6rxtLH8+hsFlAMesM0lpEJn6+VMrP1cqn8YH1rYz3gTo6Hor7iF7i/MAp4k7XrnarTxwJUaVZZJpA3",
+	"Lpv67V1aB8OClOoOthBI6iDWONkzN/UppGnl7XgZvACZxMQ5Qz02a6aR9xIhxw7cEXrtBKt2aj8Qugrw",
+	"77khWYPWmph0CG+sDbrKlRUECXqMYH9NYmuCzQytdbz6Kt+lURezQidacG0i/y5aZxZhQHJOmHeM/6HK",
+	"6V5BSwzxNNf/6D96JIJ+bev75FDkg3907Ad9fZ+tzdrUAbzhyq1yfpa4NGHhxYiQhMQQRQQdDf2RSQkJ",
+	"MYK35VNs5QyrHQpJnCNL9DtyL1cxQym2AYuXQa4odPn72rn5I0KK5wKiq7cXbWXvv9nM4yWh0ylieQBH",
+	"4z0KORMlC+NB/TBKsukT1ZGUxjNOk/2iClKO61ZGfkqUNWQYvjQpgD5GKnTZlFV8Zb1eovnn53eK/z42",
+	"wq6Qt0Octn5oSEgPigEBWPxbaBMcP7FPPC3Be3k2uCKmZIHDfWFHLFu1/iiAYmXMIcq6gWGCTGzdzOrI",
+	"6YsVlwg2SLdeXmbSXBYqN56a+iKX+weqRmSz8JjxFVN/WNUfITYATnSsKjlpSXgb5UXDDi+zP0/uQIdy",
+	"tC8CopgN/eCzsxMP3TvBkGELHdr6+7tNvQDZS9XcSrufrhuvs2D98G2eGoh2M2tsbUyXr/1o6qtOzvKj",
+	"F1h95rqpMslGpVGonLKda7XOnc7a7Jyw4DP5VNT6eIki+NfI/46Q57Z92j0Qt5VvRVTN3JKZu2Aa9/zH",
+	"gy9rLDG0TzgTQfz1REo+EZFPRsgCUEadLy8zFj27Z1DeQ778XpXTe/uEM18Qkw45wuVBRVTV4EXUZg29",
+	"2E/QSx8z5l2vlE6kRpLiR/RFDDfA+boGCMvIf03MRfx6FoTYs7+Vpxca3xhe4JdBuGSfEMAS+crWkTj9",
+	"GWkDs/C3sWHmlnF29H5TXz4iJ8UuQRNMfQ2FTuIkShCnsQTE1V5aF2+79AXGAxaM2A9ebj98AntH+feV",
+	"Kys7R2+CU14A1LbeMA71jmjEbquD4r3HXCj+w7uN4m8X7CHBrDYXzG9lj/W3ZqsIPpVjl5KoccGTAd05",
+	"tIbD6uIc9aaZUgxbHGh5qCouQBvZlWTDm2cynZ0NBB9e/2g6wfXbyBkOtlqT09aNn1HS3VR56dZW6UF1",
+	"cRoi16g609SXUaYCyphFJguJcdOYQWM5U8S/z1U8MykpIfAsf7ySNjbI0I7y+c2sIWdAEEnJvYqpr2xt",
+	"ZLcvPIWgoX4Du/19p6lK6YRYFwigs84smPpPYHOivaviD28281b+Jrqs2BGQdl3nYJ1N0pXE0KHUO0ue",
+	"6p+SE6d44RzHmtx++KS88QtGSVyPyh4clE+QIy7YMaeizWBMYw4Q3JjaKj3Y2oD8vWr2N+w8DRcmSsqJ",
+	"kWGi/oYXGRDoAYcESPEHtjcZGxBIr1dFDan1oHO45HNYKcIlAgqJKchZmFlAZcWFrdI8i3OQKEwpQBV/",
+	"8CM+zxQPRwwqptZG0Qlugw3xQxI+AoNfeD49yrqWUZEBisQz9B68Q1FRZIXr3g4f0uKFQvBzw+1JFbV6",
+	"xmpWp6dbpDhp6suVi7+Xxy+ZxlzlZcHUp8szN7DnkmuTqjxtqvzLHQ/PQCTtSWuysvfD0UtwSAA9ng0G",
+	"fEerbnYuYjDqYCYSYwQOUAeDKxTEvMM4ImvSSSkhwDJpuICDJOBJOxqcIuTkBCEbAVX9Uyck9yzQA/vE",
+	"YSmdJK0IuI+cnKaV7a5Ux1rP9oDI86KYeys8iPSK6SSkApPKrsNS+lQAQHhZ3PfKsy8wzuAsuiAfLTQZ",
+	"WL8GFxh3HYaeyzMCEBc4AJcMKnljsvpq5jizGYBIfA6me9JfSTxnYfC61lyFiZjPoF2GTrX2LKNmkrW9",
+	"N8eNyyybe27EpEVNHrgoBdoQysh4jtokFMqQWrBiZnXaj2LC1O+4y0uwjCrgtHs3CtSqmdGEVIiiGXxd",
+	"jeIYrznvQ8PgNUCYcDduBBaUY7Gg0G1PF3YVYPjRb01jrnz7OYAWEUI1t2LlJ8rIe1e9MbG9kucVrAZG",
+	"WW1oetfgdU8QB6Ve4J40iovg0oE8u1K7pLIxfo43gtGkecFd5wEu3KDHycORPruUljQ54RoNdnqpP/iY",
+	"v4lARnshPP8V4jPgD14Ak+/ZH7ieJygQ6TsuZYQX7O/rO3a4u27YBt1Lc2FDbpantCXoVW6nergN+NQT",
+	"7obK6/e2H85YU/MYBQL2V7PI5sYSVE7lSqTaRi9Y+fs4stpQrhsfhpqTy8aCgwfUYyovMTwpqZmUMEpz",
+	"dusHzKlErHtl3bojb9bfRE/nkU4/KKzZaVQytUoj0k5ggzGgCuCkgayyR4j884FJKnhRPWqXeFIg0Siv",
+	"VeZdATY16OuXa7zVNOboobqKUQNSBnhKTDTmOhPXEftXH3TQVK1pZaQaa0HYwKuhBTW9A85hqE3YTdIH",
+	"q1DVLImopY0d4dY6kAfQigdUsYzRBhUq3yd8BF1WZEovQhaIMOqSax2O9uTNb6DAqocooP/y84cCgeXX",
+	"gvm1JPQJKsSUI1IyzG7tt4ZZdz8uwfavHVWxBMeUNxDKOtoum5yA8n82qvofleVSjZoXz7LtFwYtG7k7",
+	"uXAOZpe12CIUi3WqkhAfkE+NyjjP2PbQmvoyw7fWyvmSK45bLz06aA8kEt4U1oJFlhbksEXOnKJprKBE",
+	"ERS9MkrI5/UHDhMh/8cjpNLzvQH1WwoFMCOvUPC81oXrnl97uoimTOwKyJzAQfcAqSRleIqvg5iYx5Z/",
+	"fFD5/bo1nuvp9fBXTuquqvWLYrqh2gbaaSbMWgAP9uCrQzEues5or+y7/ByLrJs9Oh4mkr4qfiwUoMlH",
+	"QPr2ToRfYy1GyLJQMsHAKGkM5VE1Q0u7HURASDZWiCMhyVO0mQgBm/s8akcwyF4DS0X/RiehigmF572s",
+	"vPzNmgXvVXX62W4S296ps7a3W+PQu8SUdFpUpIBkp6T9c6PnRR48+pdtz8NsvW6LHu+e/SSkaeJwBmO9",
+	"Hyt3SkQ93GRht6ilYW8UsYNw2sR0FVxoy9DaDXWyQumBk4EvQTTH8/SclNKSOrS7vG2ejLbzpig4I3bT",
+	"rdCOfRBA3TRUUncVSFyh5PRDclIMTvouVLM6DlN9NjDQiwA8gZJdXhMv8dIquSJXIlL+/uPy1QXW1q2b",
+	"usjsArCuE2MO1x30yx0UIsRrAjqx+ytlsFfcky+0m76YGWGUZimGjyyyawtCzB1kJ9l1BHZJFt5uNBZV",
+	"RxIJUUyiMMpJQXJngddirZSgWKy3XxVzCNh7Kj7sYZHPzZkZgnHgWYOddLPk5wYs25wQujVyq3Pg9O9P",
+	"liHtc9nM6kmcf4W/orEacmmb3fewHVjv7JppZM2sEcFkR3v8LRNve65E/qDZ6uXfkW/VMLaf/YHY8i0b",
+	"3yNoqSAnUqKGGu6RtcsZMQ2LN6DhceXKLYIlWSPidFhEWwuo+68sl6xLV8EyR3lj0P5m8T5Y5nT92IqK",
+	"2A354GFk3U6fow2U0g5PwkByvRu4DtKT1zxLRP38+PWASV/9X9JXIph0lwQm3XV/9kfvxRSGzu8YhrQG",
+	"EC2bfggsiiTIxTVeHU3PU+IrpFRXKjIl7AVqD5ESo/KigQtQ+FUmTVD9hqV0D753n1+xCKneVRZL1anf",
+	"yMmidZMcIo5DcpmtD/AVRO0/cMDds+WDsJUVGILWONTPQMPNOPyj+l/Q8f6HIcsnMGz9/ATDZQSyLPsB",
+	"sKS0UBQUUYEgH0+H+AmJtZdm7iLxAgLnLlHO7XS+jYMFqMaHxTjqRwuuoWVcbIWp71B/3yfsLVActDG9",
+	"/VCnOZYIQdBSHIQBSOASYPmUJNIloq7k+CunLzmNzRNXhoMRGelzEfcbU5WTAd3a4afv0Lojh9Bzca0E",
+	"qg/xOQLWKk9nTf2Bqc/YGfeuCKtxnnNTwZqYxuCIAKNand5e2Sznxq3bj+22EmauBDkvesFODPLURlTW",
+	"J3GDNniv/gRzcNTWDTgRXXixXPzRuvHz9uo6VOqgTH9kxKwgLk5XQbi+b6G5ku+gDAPa8FH+h1RGFOLw",
+	"e3IQON7rqCzPob5eRbsgDfWbq7U1UowCq72D+7bgxvaIvQbMFYCF7hkgLe49pw2oLqVPytyM5eSwAI14",
+	"PxfSJ4R0pA3rmO2Rzt6evZFPZXkwJUaO9nQdioD8yDt5hfjQ4MvJ6fLMLBmKAIf/EAHrd/iXdn1jEh8m",
+	"4W/UCPzN5iWXDMtdoDrjXKVwpzI7sRftl9TdRj+VIxir93T29kQGxOFMCmfu2sHN6L69HXs7cF6VmBYy",
+	"UvRg9L29HXvfI6U7iL7jAmmSCh8GxTreIF7nYhRq92eV50qOspErsS0dibJB6lMWAfMgUAplp6gKFgLN",
+	"JEvHzW1JPLrmq+zH48x35iXHcQ6NqKCsGLCJop+KGu0RG425hmh8c447M4EEdGuMSPBCD+1MX9l6+QyV",
+	"4jxk848CBjPYtcANvAZ7IrBeT9NEC9bjB+V1B9ABryNNMTjjGWq246m1AlRDetGaDL8ITW7CEvgTPzrs",
+	"kR/7O2rM/EhJw1LAzI/9HXWHfgQmrfqcQwXHR2B7kHq6CMRm7oOTwg2x4CVjL0e0sdEa36K8sYycVrGA",
+	"39+B+p4m5LRGM0IzKBMWdhIHswq+c94QpuEy+IgQl3UD5ejnwIzexy+s6Y6wXt61NmfgI+jfm/i2ff7b",
+	"KNdlrjvAfbytqDmXgr4zMjwsgB8mivMpMeQBdcCMWWDbTmoCZNB9Y/enjn4LD4gLtJNjMPtkOJ/HKIGw",
+	"AmpwTOodszrqzEmFBEAC37uAJEQeMdpV8jQ7QgTM9Rn8DcxwzfleL1D9rESyiuH7NehWHtB7E7WCZXpv",
+	"ZnVXl9inxcqjaQh/51AZD8u/md4+RbfEK/Di6wWaEkAmB9HEAKw7OzYCaAmkE+0r7Fbdt7+afUzWRjgp",
+	"SVnDzYRBw0G0/t4HH5QXHhDbjpqhRdxwnWvV2fLaB2CvbQl3EWOSnlvACRQJoNn3wB10PWRTBNw0sypY",
+	"VtnI5hNWHo9PQ+KTaeJrS03aWHmlpqQKIw4bFznRWNjnaHJDT2kp62O7Mf9ZvO/9jvc51z36zYIEulVT",
+	"X9g5g/S6VygbgBzAn2dZBmkjKeGQkBOQEFIp6MsUyCWxrHYyS6BdDe4WReblgEv61eXyzQc4WI8Je3t1",
+	"2pop0osnrZmF6u1r/zUxx1ooOD4AX7rNGWJWZQ1MjOC4JXvCibEb73Xs5xLhiDZ0iG7Hg1Bwiz9JTkxK",
+	"ipjQIpoMfDKt7RHTyYYOAi59Lyj5xMrfhBT0qfk3m/mkpCagzeEosgSnnCaPWaNP1JTRPZ3QXCtSWZ4j",
+	"zUkmprcfPQQwUCrHhhTaDHOHmwz807pY30H04DffsoiDjCV6/pG2JM5kitCMj3YWdcCE52BN/By9eiwQ",
+	"gRAwbMnpxZ5GcaW89MvWixeVuy+2V6dbgzq9TGoPx+5wj1Vj8oDCT2v7dlfIyWUl5aVVPPkP8sMbxeD9",
+	"tVo9MDoDcXw4kT279uX8X5wUepK9NiXUQPsUqU0gvdoOnvNgUhf6Hu5CVQw+ocY5uMrtp+V757FCAO6W",
+	"1zcrVxe9UVHDsB3i0VrbwHeTtj3uegKSI2bM4Rf6NxkLYP9Lq+RWIgGW3WUJoJlharSbM3GTD+Gkw5Vg",
+	"2AVIEVvX990ADYoAOfXr9r4o5FC/ETfRR+DcPiIhJnAPIQ33J1N/+F7Hfidq4b6riA1Aa2LcKjwHlRql",
+	"EuINB+/ElcZWnn9efgIBDqeSAuG8F4SVG3Trr6Zg371H+wcijmOWzDySRDVu70Ffrtx94TTyzhp0+8V9",
+	"Hah327K7SGgN93QMVmADcbZ5ihiv0Iejj7FIjE83kOv5L8XKFIrkMttnAkoN61k7JbVabEQmxeBciiPi",
+	"0OOmNeZoGGOBsbBICiuOXNMvnSAPq7shzAP5h+yoLEK+X7CdEYgQMi6frmnRNJ5wyrVXHDnaRLlJhCFX",
+	"t9+VxPzLCjovdGoiqYwHp2RkVaufiAn+9YuouGfBG1lhCiwwlBTxpCKqQxEUuoE77z+2Lj63S9ADe0jR",
+	"PCJsYxxPkywvz0r0ZZy6gYmzv78bwlzYIx/HMTa4P1f6SjzRLydOiRrzO+SrwK+GYeUvlOfXGbsfLynS",
+	"17unJy1pEoR9I7idFjTlD1oqBNBdTasijnuGhqGOp12ix1gh8QfqGXCnQMMb6nbiAqPMVoH1YqSvu6un",
+	"r/vQwHfH+g5/1/nJQHffd4ePfnr02ECE5COj0ChfPPTKKmUHMhq20jIJ4epOxhENnq2W87PWRdCacAwN",
+	"YxFWQwinD2+ecygaAlXl+0vua920dc4V4vzmW3CHMPHKb74dc1GfDcEgorMZZqiYDx3kWIBUHNTtGisp",
+	"EPrKGtZ4vnp7nehdjhTI03ewEsNTaoqrgDC35kqGXnudu8SGZhSy+tAEMxnQKR8uv9nMdx4b+Oy73r6j",
+	"X/Z0dff1gygivuNajJLHwPJ2fQV+Nv8gE6Rje+ARQiM2jq+POCypt3QKeW6zS5rctrVxB9VXEi2m3dRX",
+	"qhemiUbHFoHiEE+uZJ1ftcbz1ouHqLX5Wku8wTB0PoSfN1DjpI3tw4X3iF8yxOTyHfgpd/7UVvot2TEC",
+	"75Tb0ol/I6UG5+LsIsCDNHXI/kLDJGmccq48pZv6fbaq2Ed1Ng65KS9+UhST8XPw7ydSShyrHQEKHKux",
+	"/WQTZWMAtu+VEiqqL7xDYzUGfTyI1+PR4yMdHe8lWJcV+kaEG49HgfbsDBWfvoISLowZU79uZnU8scSd",
+	"coNc//oyOSDbYK3ceLr9+qd/dCAyW9pnPX+KW/xSQmS4hA1FYw7xGHvGzLIL6voUDTzhgpni1ou8Vbje",
+	"9mX3l91HBvC4ZP0m7m+DZ9baT277cuBo19F2h1dQpoPf5HgHnAMt9g90DhzrP3jo6Be9h7sHurvQzTXt",
+	"VHaayCfkAEI56k46F+/cUecnb008q7k4fvDDfBQs0c2gJrEHDrx/oL0GzbH4MAXdwNbvmbkSzrixpuYx",
+	"fJtl3dpLY0eqoIp+RyriNDB3DNZDkENO+31Cf74DpR36W8hI6Ss4R1CZ/7GavY71yGbBzsxdQ76v52ZO",
+	"B/FOO88xYKIrwlAiU7vj5ySms/VYLcvrOUpNwp0VcM0zTqzFHAV9b6zhyHRlfdKJhbp0mhvAb/Q1ljXQ",
+	"2CllMCijCVix7YjjZilndRhzAKwlYK6BHcP1tiPnhTwjx1GL77PHoxH2edAGz+Et7nV6H6sXzaxOyy3x",
+	"uDjvi1AfjdptztEl5aUsGqF/H+2e16ETqVjlxy9wmy8HbPpU5dEL1huDgKQX9m3fmarcKNAK9Ylgo4t0",
+	"Onc1PA/D6yT3DQ3yOzQ7/WMyt6IpBOifkzE2NuZd15iPA+xr2QK4ChVFKowvBPF2qGO92cxjJMiV8KlP",
+	"oeLYO9hirzx6gXNCm8Hu3+/4x87a/ttBhqYxPkZVYxfwZjPPU2qIOuMWIDipkzBGp3tqXVOYM//MuOQj",
+	"qk9FjbygjlPTbtcbkPAYYMKRdr1t9PZ2KKiolVxH2gO7vJ5MVQJ6CK/8pZV2h6vhcw3D492xIEhucOwU",
+	"ygyOOVzdd0Q4pRthCQ/pYlEyK9rDi0cC0YYHSOcSAkvcPjzaKvbqal/MOTBc/ANOIQr2aH3m29GK5TUF",
+	"n8J66hrFPS4j9TQQ3nk+4459hg0iNz7s2hw1zkxA4HJW0pKxgOoTaZctrEbSuQA0QQ69DluV+8gHmuzB",
+	"H+LhNQ8j1ux52g6YvAmrVLTBJh7rAbns9kIKeOw9M+fJiW9G2GauW69vIjcC45XHIxhQ0cL265+wV8Eq",
+	"XMe5ybapjTI+r2DNkN7irVSO4JkGHwEBcbz6kZDzHOwqD77F65pnUU9u8dpIc4DAaaDGk0602W4I/1hg",
+	"27XWSypm1Mff2k2G9BAXLdolFRhD6xC9U7oXopjE5Rp290+domROtCzrxUNTX4v8Ez3/ICl9+WfEpvQI",
+	"M9WjDXgCFKi66oqzBg7YVW48xXqcd/iIaczt2yr97tQsZvUIraUo7j9QWZ4rF39Etqxjc27fmWr758FI",
+	"RkoP/pN9IywJvw5i6r+vI9KomdzLrF+NhvMIIVjsUTVFFIYbxWf0Im70C85mkQYJcSEfTom+3fo0fP/0",
+	"FZoI76yirV9UTovKnn4xrUUwvNrrIGWKVKtyUdIO0EKetnEXF2MBkuZ+wdYZiTVD9EGvPP0VqEI/jwsG",
+	"2lCBLZ6kZI/rpH/jutxI3ClUJhhCu1gv+mVJET8XLAKsaSBM2np901q/hq0xQh8bTlN7VH+3tZH10BOu",
+	"qSNIqG9Ux6ddL8U/V55Nbf8BybW9x2wxAmV+eLR/rmRnWbnGjeVKroaStkWWK+GkIgJ2dTSdoGWJjBjX",
+	"ixFg4B8RsovQZK4cclmjmSD6MmcODQIexgksrWl9JUccFRmxCFJxYprtEwL7Z6P3tPBwpTr/2/bDZa7e",
+	"A2xwacXaWMf5iVAJr4rpBJyp4YWtmZtHK3oIh6EXGAwrIr9dDqXGQILY1saPbIKjvSdOQSxVDFcBOht3",
+	"iF6DdKRbWPd3nq1PHTra17+98hilvK4iGbSBHsMmwblmZZUvzVmzD0MxqcO4m0xNHSGgaJbfPYspmnXV",
+	"DU9iYIaqMm5TE3JG/OiMImliu52d4e4LU2SIygH3VqlUPj+D1weHYeRxqTp+oCIKSbiToAtkTblKTz3a",
+	"jKNjN+ISiwU1vXelPFGUs+PvwZlOSfG0lPBWETL14vv2fxhrZBVMh8OGV0FaFoZfh1eb28eTOSzbxhNk",
+	"yzcgeh6oiDE3FMzcDMl+Mf5AFH7fa3bt2lI0cyVMlRyTkZvaZUsBS18qr9/d2lj3lmBgJpebx13nK1dW",
+	"7C3VkX/AiGt5+tGAbvL0J4glMiNGSIXagj11gOpwc460zK27RCXTMdV1i75Rfgz5pJXr51Hn8Z8pu3cY",
+	"p7+VVpE7AMIZ94CsSFw++Qq1E2AL0/BUEAwwqL1nFmfp0PDEvb4pa2m5slHwTIDFVpgqasQIA51zBQUv",
+	"14LmYbgWYW+08my2/POSqRfduu4a8v1Pl6/dJuqud0ofqhljs+GNS8H+fGc4SbSVniA6ySaUk72jJS//",
+	"M02xVvmH3o67x0PylT9Wqjcm7HEZbTDpJVCnTjOzJdT4SFodOQFrPSHWMPqoEjkxDu5rpC1hLoe0+ifI",
+	"mw9IXp2/ZOozONPfzF0n7CFr2KlnoBhfRMkJtNcXTlsEvZPowmgApX9gBbhE8CsZbmO/vLD14pI1OY37",
+	"YpA5thDTXC6fn7FukDgmvR/RKFYgQFeYwPSKmRWJBxpz2GZ2VI0glYod1aEeY6DZqBeYuRefetjEgiFt",
+	"ONVgUoHnhGpQHCfCVCeuTc7LhSV6wfPGEFkC0LNUxe52rtgLQpQ23mSSEpvpj29o52KUjW2cSWtmrkjw",
+	"LbeKPb3sJpH78sOOAx/GIoclVdvDnOce4Ovtdi6u9dOmqf+K/SLBcuAviVgYFLR/2A7Rq1kZFhxMbADv",
+	"gFeSfl5q/ByUSo/F0cCH+Dnc4WMs7hlpwGWerO3vGsehF1gjHPOdNsUepdBuJ2TYfi6Mw21oEEO73SUE",
+	"9yyzE+IctcU3yiBMMucyHXdQ044kI1DU3u97kjDzUz2CIHKUgUeoukhcf96QncV5jN1wpaEH1aEaMsoC",
+	"DVuJttRBzR2f8Y45qWv37CExD+Rle4X6V7GdCtf8eL7zrAKGvPmEZFMETkoMTjvz0jY75ZdLyRlBQb0j",
+	"ESWuoCF+tyBhw7iIXHjnw0za8QzBgQKM9VmaxnqLqBr0ObgfmD0PKeIfiNQmqV1yWmzH5h07GInlBLbZ",
+	"4pltRmNwa8gEuke9Og8aIH57CFJLyL2VVOcbyvz2chjcdNK8jBuGNmoOieZZBY7giWcwFwp2OKCxOqa+",
+	"QnKyccTXI4VW8pXCgieIS9Cv6FetI7QwChCPPL4Y+aSv+/+2dXX2HP46/lV39+eHv45/cfTIwGeHv45/",
+	"3d3Zd/jr9lik58hAd9+XnYdjkY+/7ur8Gv6HrkF/Hzp67MhALHLsyEDPYeSlBrjNE/9lLs9HcFC9fBy5",
+	"YZ2LIz2ab8hzh0y9ZXu+5cILyt1pYhs4kAiiFBCWsAlyzbf8344t7xJepGHUmkeK4Zp/a/0a7smGjSnb",
+	"dRsg4GhpuLsXgRf2jEbo8KoVLGpoPMnpZIirN6MxbksDpDl/IYbqaFBev2dtbEA2Hnri2yzPc7HfgGgE",
+	"DnjhBpFv0a3j1s+r2ezW5nXrwsPK7ATXMA5Klw88iObRPbyCX3zp7QTSBBnKxdGp7YeXoPSHJDms7lJi",
+	"8vMaPM1M2DnV2xdWrUtXsUQP9FzQAev1Zpa1wdQga3a6HcnOddYCC6h8Ou8tS3Lq8Iq04plj3nmK8yLO",
+	"9CEniZXkUnmHESE9t+Y0Nd/76mSvA3RYXG2+iHQNcXrLojGIRNjQeBC57FRMouH//oNorZx821TbFP5q",
+	"jyf05UKyDiC7vYqQyezJCKp6RlaSaq1SJYLPnZlMr335Wyl/dl4Ypvr56OfIFfYTQqYitje3L6yiUa1F",
+	"qCDWX+HTaRBvdtqdjtZUulfEK+es5xfGuS9tUG+XFE7H2yEOkNWhjZmvpw6TuMHz934sqFKC7JEOvfQu",
+	"0Jgj3cQYH7KDZKgbfbEyvoyikPWzFlCoxAOBYpgpRehGf6fqNdo4d6v0e7C5E4SyzWfHzCvwGKo/oczH",
+	"t4YkN0JCThXX+biO5B2KT+5EleVmsOPt4tzmrY2LyKxfq+pX2HzgtxneDGQHeKENcev4OcE58p7kWP3e",
+	"bByC6GQfEcrbJXjuCJcfHbbpM69JHC37wlj7VqX9rpqUtgRPKDDqoQoty94Dtd+hUYOtMI/u4HBwLy7K",
+	"ZAp2Bz/arOYv7tPgVoaTHkXGHO9sGN9FLCgLwfuoNpbNtYOgxVwMjbJwSUc71RRE6WWSloQvJoE5Zy6p",
+	"Z/IAzwMeCgma3+MD3oMnUO3eW70zPS3oXDEsKxd/Lz/Rgw6Vr62F7ethzLFJupXla1ZxgmCTW/9yHyvT",
+	"5Y5Wp8Nd+lrlZcHUp8szN0wdukhCpwrkWkO/0tkmwM5mnJoh/FtIday2rlUbdfa1BHVI8L+eruOlsn8v",
+	"F1wdzkVmHuXtUTuhPLBMc87A6CLtTAmHAKVtWcOThc6aLra71M7NhxJuMs6GZBjWYFw9znLehmnqmnAf",
+	"yjbdNc61BKlceoeroesae3rc5q60t2bj9ix9dAFzSOQgpCm9WT1oMjob/w3uG8gfnQ68jnTxdT8Xd57F",
+	"eXucprbejkVTJOmL2Nm32BmVDsAgsM3rxAv/iGiUKRquicdJ4J9ERZEV6Jy0/wNFFFQ5/dHevXtx6jC/",
+	"aS8BHXI6oda3wO8hmXGNdjiCK63X49sPdae90a5JsK4Y8BBia/yfbCvdP8kNyhv/H9SSj4PModuz/juF",
+	"imoxGbvfNUb8ejaQv3d0cPDf1+q7aZ286WOLtEs1KR11NQsG+ieFRcHPr9M6m4y6wpUDvh7ZkbdH9L32",
+	"PNuWKYBu2esnOVZwtYqCQhAOS2hW/oH/UK3CDZzti5Gj2c2++W0dFu6ChOAthxH5OJv8rfvIOBs15jAq",
+	"N0Du58jfoz6/WMBobKiwhuDn8j3UxZnxoDdfYXV5XByq6bGXHK7PFXt5y51wFDCtpKfGPb4hPHZ8CqDH",
+	"7hc375yow5Cvi/u4yVdoRx9pCtZMH5+H8fxVfXy2b4YtLa9cWQF7gik8dysFNf2ydRNaAg+jo9lN4P5c",
+	"N1st0AY62+rZkbbPDLoFoDJ/fktJRFYgkCvPztvFVP1fDPSyLXPKS5OoF/+qM56Oe+D+8KizJeOyqd9m",
+	"G73yZ9uRlLh85cbTrY1LAa0fYeqgu+8OtEDKz2IewesKiQtdXUPRYK5PSobBitb4A7s8J1LXo+jmvTVd",
+	"i7mSCzwBnkbulU3xO7IU1LIWjv2ipknpQfXP6+EYytVZg1H9xUO877QAqONCZdhYQJe9dxqV36IkIl2d",
+	"kH4G0mD80l+hctpWid4eoobpJ4yBSZuQFuns/UknCaqOYukqqw6RIOYq72ylRsO+iKLzn6XY4MpbO/XO",
+	"12MzNPH7odd8FhAMuLfHBMIeHptTygL5HeMIb4fePVgWLsFTxbOX1NrVEp4hSrjqrs5gKXaIlH9kVEud",
+	"Jv10U2HMV+7u/r1UGrvU2nOoZJCFMUeaDrghVcuU9flTKs8Mds90pEaBHVnf0uBvME60KPRLXvj3iPy6",
+	"mges+zEFDFHU76hOtJfLe+LnyF91vLFBaBrUiNyOeXi4Pu8J/ElxuFXdzTBz9FrK7o6nwzG8fgrHUC5i",
+	"lbl6N9XJfzWm+k6lctbA5BAM10VPqG9hrSa2reKtA/jFb6XeI5EQVZU4OkLXezi4wC32sMvN6ETgAukp",
+	"0Eyvdas5dKh2kzvJxKFdUleRBekMzYkAjsuK9C90oAcjH4uCIirQzbua1XHZXGdvD2gPebZZOcpJ9cy7",
+	"4JWTcMZh0EQjDzcvsnxhDh5jzNgHy7bDdL8UdVs1c6XPuju7gkLannU0sSil1eF2hiZbUNDiUOGfV9Di",
+	"XUOIghbnLP+7mqX18cuwHClccQuWbvFz6P91NMWWWpeYsgbwMkIpWpp97d+hGuavrnKFx8s6xTRnxBND",
+	"snyqptvzK3rN21COyMtCK0aqmFBQe++3XvlKVspTR2yIBWsk9ogO2pod1JHy/SXrAs1FZqYBoN7da86o",
+	"PDJmXuf6w3H4lHR/p8o426uTbcLpUkLWIE33P/fg7kJ7UIP/mPO5S4Rm/soo89WANCyqmjCciZA+V+Q0",
+	"jLnq9DMat71lT0/130dmqOKfodVo7oJp3MPfQpBYL3z2ReehPf2fde4/8IFpzDmv7pcG04I2oogHI+qQ",
+	"sP/ABx/hhwyJZ/HtzoAK2uzd2Td5D2z3nJSMoab4sUgCi+BOLRYhbbZikaSgCWMRPDAG/kb8wjkYOIyJ",
+	"cavwPALtub/vSZq5UuZ7aLtt5kq4uZ2ZK6WEE2LKzOrMbIJkxO6mhEPM9rTFCDRxOkzuQPML/hdcW1ku",
+	"WZeuWuM5GAFA/sZegDV06200+zmuySTFcv/Zs3SGa8F6vVRZvwJdeJC9j7OyIQw+dQEkbW4Wt8TErWnf",
+	"bObf66gsz5Hex9lpMzuORsqC5revvGhU5y/Df9evYOlDfuhArTVeYc3Z1ZLfxonKkl65+sDr4HBSE5ah",
+	"DVV+lg592K2Wuq926bSLqzVfvSSP/xOUSvLmmgplyW4szvDbv58uCdt8V3RJR1zgdfHFBSuT4+fIX6Eq",
+	"n+lDvqL3NNz5zLkznJ6G+z/925QsOwdoN77ChY2kqMcwKEAWUer7ZKBCMMIbyVaTS3r67vkZ2ojW/PP/",
+	"85liR7PfXC8A+3fhhu8g0fhiuSG4XjyJdc6a1ZcsDerF92BafnX+Mh2Ktxjg9vWRS5fzql0Qjm+QC9ZU",
+	"8PRBSCteuGsVru/vcDSqjo7gKS4paVjSvANcpGGYH7uvoyMGRgT5xLG1Y35+PY2UNL9WVUiLZ7WPxZOy",
+	"IoKpgVSvni4y2gONxqY8jpQmBi/5BHpKdLdugaZTvnO871yP5l1RJofSWIoAlGPC04FSKZACMzVLytA0",
+	"MbgEN2ll59fRqn8yowX6MFG9E8vH/PaTTTT1wdHGPKZVZaVQvfMzKG3zd2lO13XkmL9ErBxsa9hdkt28",
+	"YJkWsS7WdDb7OEEvLuxqovK0v0XYzK8LC4T7v4miVpn/EfKI0OEHYHv9559AkSD7G3gBGn6IkWFESUUP",
+	"Roc0LXMwHk/JCSE1JKvawQ87PuyIn96HUIC89hxljJ+JQkob+hdHRMgZ8KwektNpMaHhY6jO361m7zlc",
+	"Fa3Dfydr/eJbOnt7nLuwo89/2xd45LCpr3yOhg7bTf44zyCtpv0P4bf/gI7keUTJJMO4fH+p8vSu8zy7",
+	"zwJHPt2fr+ZWKDpcRpJpw8NXcIKY8zj7UDkLRFWi1uMH5fWnDCQTmnQadzPwI6KrpoCWtm9CC/ifZ5lH",
+	"pIXUqCYl1OjYt2P/fwCHZo4MEAoBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
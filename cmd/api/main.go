@@ -65,8 +65,8 @@ func realMain() error {
 		"name", cfg.DBName,
 	)
 
-	// set time zone
-	time.Local, err = time.LoadLocation("Asia/Tokyo")
+	// set time zone（ユーザーごとのタイムゾーンが未設定の場合のデフォルトと共通）
+	time.Local, err = time.LoadLocation(cfg.DefaultTimeZone)
 	if err != nil {
 		return err
 	}
@@ -95,14 +95,31 @@ func realMain() error {
 	// ミドルウェア適用
 	handlerWithMW := middleware.RequireLogin(mux, middleware.RequireLoginOptions{
 		SessionManager: s.SessionManager,
+		Cookies:        s.Cookies,
 		// 本番EC2では/v1/authにするとr.URL.pathの部分一致の不具合になるのでフルパス記載
-		SkipPaths:        []string{"/v1/healthz", "/v1/auth/login", "/v1/auth/callback"},
+		// 配信停止はメール内のリンクから開くためログイン不要（署名付きトークンで本人確認）
+		// iCalendarフィードはカレンダーアプリから取得するため、URLのトークンのみで認証する
+		// タスク取り込みもスマートフォンのショートカット等から送るため、URLのトークンのみで認証する
+		SkipPaths:        []string{"/v1/healthz", "/v1/auth/providers", "/v1/auth/link", "/v1/auth/login", "/v1/auth/callback", "/v1/notifications/unsubscribe", "/v1/calendar/feed/", "/v1/inbound/"},
 		RequireCSRFToken: true,
-		OnUnauthorized:   nil, // デフォルトを利用
+		Bearer:           s.AccessTokenAuth, // スクリプト等からのパーソナルアクセストークン
+		OnUnauthorized:   nil,               // デフォルトを利用
 	})
 
 	handlerWithMW = middleware.ApplyCORS(handlerWithMW, cfg)
-	handlerWithMW = middleware.AccessLog(handlerWithMW)
+
+	// CalDAVはアプリパスワードのBasic認証のみを使うため、Cookieセッション・CORSのミドルウェアを通さない
+	// （CORSミドルウェアはOPTIONSに応答してしまい、CalDAVのDAVヘッダを返せない）
+	root := http.NewServeMux()
+	root.Handle(handler.CalDAVPrefix+"/", s.CalDAV)
+	root.Handle("/.well-known/caldav", http.RedirectHandler(handler.CalDAVPrefix+"/", http.StatusMovedPermanently))
+	// 開発用のOpenID Provider（APP_ENV=development で AUTH_PROVIDERS に dev を含む場合のみ）
+	if s.DevOIDC != nil {
+		root.Handle(configs.DevOIDCPath+"/", http.StripPrefix(configs.DevOIDCPath, s.DevOIDC))
+	}
+	root.Handle("/", handlerWithMW)
+
+	handlerWithMW = middleware.AccessLog(root)
 
 	// handlerWithMW := middleware.AccessLog(
 	// 	middleware.CORS(
@@ -119,11 +136,126 @@ func realMain() error {
 		IdleTimeout:       60 * time.Second,
 	}
 
+	// Shutdown時にSSE・WebSocket等の長時間接続を終了させる（Shutdownを塞がないように）
+	// - ハイジャック済みのWebSocketはShutdownの待機対象外なので明示的に閉じる
+	server.RegisterOnShutdown(func() {
+		if err := s.EventHub.Close(); err != nil {
+			slog.Error("failed to close event hub", "err", err)
+		}
+		if err := s.LiveHub.Close(); err != nil {
+			slog.Error("failed to close live hub", "err", err)
+		}
+	})
+
 	// graceful shutdown処理
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	// ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill, syscall.SIGTERM)
 	defer stop()
 
+	// IdPの初期化（discovery）。IdPに到達できない場合も起動は止めず、成功するまで再試行する
+	authProvidersDone := make(chan struct{})
+	go func() {
+		defer close(authProvidersDone)
+		s.AuthProviders.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-authProvidersDone
+	}()
+
+	// 繰り返しタスク等のスケジューラ（ctx終了で停止し、DBクローズ前に終了を待つ）
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		s.Scheduler.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-schedulerDone
+	}()
+
+	// Webhookの配信（スケジューラと同様にDBクローズ前に終了を待つ）
+	webhookDone := make(chan struct{})
+	go func() {
+		defer close(webhookDone)
+		s.WebhookDispatcher.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-webhookDone
+	}()
+
+	// 期限切れのセッションの削除（スケジューラと同様にDBクローズ前に終了を待つ）
+	sweeperDone := make(chan struct{})
+	go func() {
+		defer close(sweeperDone)
+		s.SessionSweeper.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-sweeperDone
+	}()
+
+	// IdPでのセッションの定期的な確認（スケジューラと同様にDBクローズ前に終了を待つ）
+	revalidatorDone := make(chan struct{})
+	go func() {
+		defer close(revalidatorDone)
+		s.SessionRevalidator.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-revalidatorDone
+	}()
+
+	// メールからのタスク取り込み（INBOUND_SMTP_ADDR設定時のみ）
+	// 起動に失敗してもAPIは止めない（ポート競合等はログで検知する）
+	if s.InboundSMTP != nil {
+		inboundDone := make(chan struct{})
+		go func() {
+			defer close(inboundDone)
+			if err := s.InboundSMTP.ListenAndServe(ctx); err != nil {
+				slog.Error("inbound SMTP server error", "err", err)
+			}
+		}()
+		defer func() {
+			stop()
+			<-inboundDone
+		}()
+	}
+
+	// メトリクスはAPIとは別のアドレスで待ち受ける（METRICS_ADDR設定時のみ）
+	// ログイン不要のため、公開するAPIのポートには載せず、監視用のネットワークからのみ到達できるアドレスにする
+	// 起動に失敗してもAPIは止めない（ポート競合等はログで検知する）
+	if cfg.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", s.Metrics)
+		metricsServer := &http.Server{
+			Addr:              cfg.MetricsAddr,
+			Handler:           metricsMux,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       60 * time.Second,
+		}
+
+		metricsDone := make(chan struct{})
+		go func() {
+			defer close(metricsDone)
+			slog.Info("metrics server starting on", "addr", metricsServer.Addr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("metrics server error", "err", err)
+			}
+		}()
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+				slog.Error("failed to shut down metrics server", "err", err)
+			}
+			<-metricsDone
+		}()
+	}
+
 	serverErrCh := make(chan error, 1)
 
 	go func() {
@@ -0,0 +1,179 @@
+package configs
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ログインに使うIdPの種類
+const (
+	AuthProviderTypeOIDC   = "oidc"   // OpenID Connect（IDトークンで本人確認）
+	AuthProviderTypeGitHub = "github" // GitHubのOAuth2（ユーザー情報APIで本人確認）
+)
+
+// 組み込みの開発用OpenID Provider（APP_ENV=development の場合のみ AUTH_PROVIDERS に指定できる）
+const (
+	DevAuthProviderID = "dev"
+	DevOIDCPath       = "/dev/oidc" // マウント先（issuer は PUBLIC_BASE_URL + DevOIDCPath）
+)
+
+// AuthProviderConfig はログインに使うIdP 1件の設定
+// AUTH_PROVIDERS に並べた識別子ごとに AUTH_<識別子>_* の環境変数から読み込む
+type AuthProviderConfig struct {
+	ID           string // URL（/auth/login?provider=, /auth/callback/{provider}）に使う識別子
+	Type         string // oidc / github
+	DisplayName  string // ログイン画面の表示名
+	Issuer       string // oidc の場合のみ
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	EnablePKCE   bool
+	RedirectURL  string            // IdPに登録するコールバックURL
+	AuthParams   map[string]string // 認可リクエストに追加するパラメータ
+}
+
+// よく使うIdPの既定値（AUTH_<識別子>_* で上書きできる）
+var authProviderPresets = map[string]AuthProviderConfig{
+	"google": {
+		Type:        AuthProviderTypeOIDC,
+		DisplayName: "Google",
+		Issuer:      "https://accounts.google.com",
+		Scopes:      []string{"openid", "email", "profile"},
+		EnablePKCE:  true,
+		// Googleは offline_access スコープではなくパラメータでrefresh tokenを要求する
+		// （refresh tokenは初回の同意時のみ発行されるため、2回目以降のログインのセッションはIdPで確認しない）
+		AuthParams: map[string]string{"access_type": "offline"},
+	},
+	// 個人のMicrosoftアカウント用のissuer（職場・学校アカウントはテナントのissuerを AUTH_MICROSOFT_ISSUER に指定する）
+	"microsoft": {
+		Type:        AuthProviderTypeOIDC,
+		DisplayName: "Microsoft",
+		Issuer:      "https://login.microsoftonline.com/9188040d-6c67-4c5b-b112-36a304b66dad/v2.0",
+		Scopes:      []string{"openid", "email", "profile", "offline_access"},
+		EnablePKCE:  true,
+	},
+	"github": {
+		Type:        AuthProviderTypeGitHub,
+		DisplayName: "GitHub",
+		Scopes:      []string{"read:user", "user:email"},
+	},
+}
+
+var authProviderIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// AUTH_PROVIDERS（カンマ区切り。先頭が既定のIdP）からIdPの設定を読み込む
+func loadAuthProviders(env, publicBaseURL string) ([]AuthProviderConfig, error) {
+	var providers []AuthProviderConfig
+	seen := make(map[string]bool)
+
+	for _, id := range strings.Split(GetEnvDefault("AUTH_PROVIDERS", "google"), ",") {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id == "" {
+			continue
+		}
+		if !authProviderIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid auth provider id: %q", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate auth provider: %q", id)
+		}
+		seen[id] = true
+
+		// 開発用のIdPは任意のユーザーでログインできるため、開発環境以外では使えない
+		if id == DevAuthProviderID && env != "development" {
+			return nil, fmt.Errorf("auth provider %q is only available when APP_ENV=development", id)
+		}
+
+		p, err := loadAuthProvider(id, publicBaseURL)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, p)
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("AUTH_PROVIDERS is empty")
+	}
+	return providers, nil
+}
+
+func loadAuthProvider(id, publicBaseURL string) (AuthProviderConfig, error) {
+	preset, ok := authProviderPresets[id]
+	if id == DevAuthProviderID {
+		preset = AuthProviderConfig{
+			Type:         AuthProviderTypeOIDC,
+			DisplayName:  "Development",
+			Issuer:       publicBaseURL + DevOIDCPath,
+			ClientID:     "minkan-dev",
+			ClientSecret: "minkan-dev-secret",
+			Scopes:       []string{"openid", "email", "profile", "offline_access"},
+			EnablePKCE:   true,
+		}
+		ok = true
+	}
+	if !ok {
+		preset = AuthProviderConfig{Type: AuthProviderTypeOIDC, DisplayName: id, Scopes: []string{"openid", "email", "profile"}}
+	}
+	prefix := "AUTH_" + strings.ToUpper(strings.ReplaceAll(id, "-", "_")) + "_"
+
+	// Googleは従来の OIDC_GOOGLE_* / OIDC_REDIRECT_URL も既定値として使う
+	redirectURL := publicBaseURL + "/v1/auth/callback/" + id
+	if id == "google" {
+		preset.Issuer = GetEnvDefault("OIDC_GOOGLE_ISSUER", preset.Issuer)
+		preset.ClientID = GetEnvDefault("OIDC_GOOGLE_CLIENT_ID", "")
+		preset.ClientSecret = GetEnvDefault("OIDC_GOOGLE_CLIENT_SECRET", "")
+		pkce, err := strconv.ParseBool(GetEnvDefault("OIDC_GOOGLE_ENABLE_PKCE", "true"))
+		if err != nil {
+			return AuthProviderConfig{}, fmt.Errorf("OIDC_GOOGLE_ENABLE_PKCE: %w", err)
+		}
+		preset.EnablePKCE = pkce
+		redirectURL = GetEnvDefault("OIDC_REDIRECT_URL", redirectURL)
+	}
+
+	enablePKCE, err := strconv.ParseBool(GetEnvDefault(prefix+"PKCE", strconv.FormatBool(preset.EnablePKCE)))
+	if err != nil {
+		return AuthProviderConfig{}, fmt.Errorf("%sPKCE: %w", prefix, err)
+	}
+
+	p := AuthProviderConfig{
+		ID:           id,
+		Type:         GetEnvDefault(prefix+"TYPE", preset.Type),
+		DisplayName:  GetEnvDefault(prefix+"NAME", preset.DisplayName),
+		Issuer:       GetEnvDefault(prefix+"ISSUER", preset.Issuer),
+		ClientID:     GetEnvDefault(prefix+"CLIENT_ID", preset.ClientID),
+		ClientSecret: GetEnvDefault(prefix+"CLIENT_SECRET", preset.ClientSecret),
+		Scopes:       strings.Fields(GetEnvDefault(prefix+"SCOPES", strings.Join(preset.Scopes, " "))),
+		EnablePKCE:   enablePKCE,
+		RedirectURL:  GetEnvDefault(prefix+"REDIRECT_URL", redirectURL),
+		AuthParams:   preset.AuthParams,
+	}
+
+	// 開発用のIdPはこのサーバにマウントするため、種類とissuerは変更できない
+	if id == DevAuthProviderID {
+		p.Type = preset.Type
+		p.Issuer = preset.Issuer
+	}
+
+	switch p.Type {
+	case AuthProviderTypeOIDC:
+		if p.Issuer == "" {
+			return AuthProviderConfig{}, fmt.Errorf("%sISSUER is required for oidc provider %q", prefix, id)
+		}
+	case AuthProviderTypeGitHub:
+	default:
+		return AuthProviderConfig{}, fmt.Errorf("%sTYPE: unknown provider type %q (want oidc or github)", prefix, p.Type)
+	}
+	return p, nil
+}
+
+// DevAuthProvider は AUTH_PROVIDERS に含まれる開発用のIdPの設定を返す（含まれない場合はfalse）
+func (c *ConfigList) DevAuthProvider() (AuthProviderConfig, bool) {
+	for _, p := range c.AuthProviders {
+		if p.ID == DevAuthProviderID {
+			return p, true
+		}
+	}
+	return AuthProviderConfig{}, false
+}
@@ -1,8 +1,11 @@
 package configs
 
 import (
+	"fmt"
+	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,19 +24,66 @@ type ConfigList struct {
 	Env              string
 	APIPort          string // HTTPサーバのポート
 	CorsAllowOrigins string // CORSで許諾するURL（フロントエンド）
+	DefaultTimeZone  string // タイムゾーン未設定ユーザーの日付の解釈に使うIANAタイムゾーン名
 
-	// Open Id Connect
-	OIDCGoogleIssuer       string
-	OIDCGoogleClientID     string
-	OIDCGoogleClientSecret string
-	OIDCGoogleEnablePKCE   bool
-	OIDCCookieKey          string
-	OIDCRedirectURL        string
+	// Open Id Connect（ログインに使うIdP）
+	AuthProviders []AuthProviderConfig // AUTH_PROVIDERS の順（先頭が既定のIdP）
+	OIDCCookieKey string               // state・PKCEのCookieの暗号化鍵（base64）
 
 	// ログイン
 	RedirectURLAfterLogin  string
 	RedirectURLAfterLogout string
-	SessionTTL             time.Duration
+	SessionTTL             time.Duration // 操作が無い場合のセッションの有効期間（アクセスのたびに延長）
+
+	// オフライン同期(CRDT)
+	CRDTCompactEvery int64 // 操作ログをスナップショットへ圧縮する間隔（件数）
+
+	// 変更フィード
+	MinkanRevisionKeep int32 // ユーザーごとに保持する更新差分の件数
+
+	// スケジューラ（繰り返しタスク等）
+	SchedulerInterval time.Duration // 発火予定を確認する間隔
+
+	// メール（リマインダー等）
+	SMTPHost       string // 空の場合は送信せずログ出力のみ
+	SMTPPort       string
+	SMTPUsername   string // 空の場合は認証しない
+	SMTPPassword   string
+	SMTPFrom       string
+	SMTPTLS        string // none / starttls / tls
+	PublicBaseURL  string // APIの公開URL（メール内の配信停止リンク等）
+	MailLinkSecret string // 配信停止リンクの署名鍵
+
+	// Webhook
+	WebhookInterval             time.Duration // 配信キューを確認する間隔
+	WebhookAllowPrivateNetworks bool          // プライベートネットワーク等への送信を許可する（開発環境のローカル受信用）
+
+	// タスク取り込み（メール）
+	InboundSMTPAddr   string // 組み込みSMTPサーバの待ち受けアドレス（空の場合は起動しない）
+	InboundMailDomain string // 取り込み用メールアドレスのドメイン（空の場合はアドレスを表示しない）
+
+	// メトリクス
+	MetricsAddr string // /metrics 専用の待ち受けアドレス（空の場合は公開しない。APIのポートでは公開しない）
+
+	// Cookie（セッション・CSRFトークン・OIDCのstate）
+	// 開発環境(http://localhost)と本番(HTTPS・フロントエンドとAPIが別サブドメイン)で既定値が異なる
+	CookieSessionName string
+	CookieCSRFName    string
+	CookieDomain      string // CSRFトークンのCookieのドメイン（フロントエンドから読むため、別ホストの場合は共通の親ドメイン。空の場合はAPIのホストのみ）
+	CookiePath        string
+	CookieSecure      bool
+	CookieSameSite    http.SameSite
+
+	// セッション
+	SessionMaxLifetime        time.Duration // ログインからの最大の有効期間（延長しない）
+	SessionSweepInterval      time.Duration // 期限切れのセッションの削除・集計の間隔
+	SessionStore              string        // memory / mysql / redis
+	CSRFSecret                string        // CSRFトークン（セッションIDのHMAC）の署名鍵
+	SessionTokenKey           string        // セッションに保存するIdPのトークンの暗号化鍵
+	SessionRevalidateInterval time.Duration // IdPでアカウントが有効か確認する間隔（0の場合は確認しない）
+	RedisAddr                 string        // SessionStore が redis の場合の接続先（host:port）
+	RedisPassword             string        // 空の場合は認証しない
+	RedisDB                   int
 
 	// DB
 	DBHost     string
@@ -56,37 +106,143 @@ func LoadEnv() (*ConfigList, error) {
 	// 	return nil, err
 	// }
 
-	// string ⇒ boolに変換
-	oidcGoogleEnablePKCE, err := strconv.ParseBool(GetEnvDefault("OIDC_GOOGLE_ENABLE_PKCE", "true"))
+	// string ⇒ time.Durationに変換
+	sessionTTL, err := time.ParseDuration(GetEnvDefault("SESSION_TTL", "12h"))
 	if err != nil {
 		return nil, err
 	}
 
-	// string ⇒ time.Durationに変換
-	sessionTTL, err := time.ParseDuration(GetEnvDefault("SESSION_TTL", "12h"))
+	// string ⇒ int64に変換
+	crdtCompactEvery, err := strconv.ParseInt(GetEnvDefault("CRDT_COMPACT_EVERY", "200"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// string ⇒ int32に変換
+	minkanRevisionKeep, err := strconv.ParseInt(GetEnvDefault("MINKAN_REVISION_KEEP", "100"), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulerInterval, err := time.ParseDuration(GetEnvDefault("SCHEDULER_INTERVAL", "1m"))
+	if err != nil {
+		return nil, err
+	}
+
+	webhookInterval, err := time.ParseDuration(GetEnvDefault("WEBHOOK_INTERVAL", "5s"))
+	if err != nil {
+		return nil, err
+	}
+
+	webhookAllowPrivateNetworks, err := strconv.ParseBool(GetEnvDefault("WEBHOOK_ALLOW_PRIVATE_NETWORKS", "false"))
+	if err != nil {
+		return nil, err
+	}
+
+	sessionMaxLifetime, err := time.ParseDuration(GetEnvDefault("SESSION_MAX_LIFETIME", "720h"))
+	if err != nil {
+		return nil, err
+	}
+
+	sessionSweepInterval, err := time.ParseDuration(GetEnvDefault("SESSION_SWEEP_INTERVAL", "10m"))
+	if err != nil {
+		return nil, err
+	}
+
+	sessionRevalidateInterval, err := time.ParseDuration(GetEnvDefault("SESSION_IDP_REVALIDATE_INTERVAL", "15m"))
+	if err != nil {
+		return nil, err
+	}
+
+	// string ⇒ intに変換
+	redisDB, err := strconv.Atoi(GetEnvDefault("REDIS_DB", "0"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Cookieの既定値は環境ごとに切り替える
+	// - development: http://localhost で動かすため Secure なし・SameSite=Lax（ポート違いは同一サイト）
+	// - それ以外: HTTPSのフロントエンドから別サブドメインのAPIを呼ぶため Secure・SameSite=None
+	env := GetEnvDefault("APP_ENV", "development")
+	cookieSecureDefault, cookieSameSiteDefault, cookieDomainDefault := "true", "none", "mindmap-kanban.com"
+	if env == "development" {
+		cookieSecureDefault, cookieSameSiteDefault, cookieDomainDefault = "false", "lax", ""
+	}
+
+	cookieSecure, err := strconv.ParseBool(GetEnvDefault("COOKIE_SECURE", cookieSecureDefault))
+	if err != nil {
+		return nil, err
+	}
+
+	cookieSameSite, err := parseSameSite(GetEnvDefault("COOKIE_SAMESITE", cookieSameSiteDefault))
 	if err != nil {
 		return nil, err
 	}
 
 	cfg := &ConfigList{
 		// バックエンド
-		Env:              GetEnvDefault("APP_ENV", "development"),
+		Env:              env,
 		APIPort:          GetEnvDefault("APP_PORT", "8080"),
 		CorsAllowOrigins: GetEnvDefault("CORS_ALLOW_ORIGINS", "http://localhost:5173"),
+		DefaultTimeZone:  GetEnvDefault("DEFAULT_TIME_ZONE", "Asia/Tokyo"),
 
 		// Open ID Connect
-		OIDCGoogleIssuer:       GetEnvDefault("OIDC_GOOGLE_ISSUER", "https://accounts.google.com"),
-		OIDCGoogleClientID:     GetEnvDefault("OIDC_GOOGLE_CLIENT_ID", ""),
-		OIDCGoogleClientSecret: GetEnvDefault("OIDC_GOOGLE_CLIENT_SECRET", ""),
-		OIDCGoogleEnablePKCE:   oidcGoogleEnablePKCE,
-		OIDCCookieKey:          GetEnvDefault("OIDC_COOKIE_KEY", "dummykey"),
-		OIDCRedirectURL:        GetEnvDefault("OIDC_REDIRECT_URL", "http://localhost:8080/v1/auth/callback"),
+		OIDCCookieKey: GetEnvDefault("OIDC_COOKIE_KEY", "dummykey"),
 
 		// login
 		RedirectURLAfterLogin:  GetEnvDefault("REDIRECT_URL_AFTER_LOGIN", "http://localhost:5173/app/mindmap"),
 		RedirectURLAfterLogout: GetEnvDefault("REDIRECT_URL_AFTER_LOGOUT", "http://localhost:5173/login"),
 		SessionTTL:             sessionTTL,
 
+		// オフライン同期(CRDT)
+		CRDTCompactEvery: crdtCompactEvery,
+
+		// 変更フィード
+		MinkanRevisionKeep: int32(minkanRevisionKeep),
+
+		// スケジューラ
+		SchedulerInterval: schedulerInterval,
+
+		// メール（開発環境はMailpit等のローカルSMTPを想定）
+		SMTPHost:       GetEnvDefault("SMTP_HOST", ""),
+		SMTPPort:       GetEnvDefault("SMTP_PORT", "1025"),
+		SMTPUsername:   GetEnvDefault("SMTP_USERNAME", ""),
+		SMTPPassword:   GetEnvDefault("SMTP_PASSWORD", ""),
+		SMTPFrom:       GetEnvDefault("SMTP_FROM", "Minkan <noreply@localhost>"),
+		SMTPTLS:        GetEnvDefault("SMTP_TLS", "none"),
+		PublicBaseURL:  GetEnvDefault("PUBLIC_BASE_URL", "http://localhost:8080"),
+		MailLinkSecret: GetEnvDefault("MAIL_LINK_SECRET", devSecret),
+
+		// Webhook
+		WebhookInterval:             webhookInterval,
+		WebhookAllowPrivateNetworks: webhookAllowPrivateNetworks,
+
+		// タスク取り込み（メール）
+		InboundSMTPAddr:   GetEnvDefault("INBOUND_SMTP_ADDR", ""),
+		InboundMailDomain: GetEnvDefault("INBOUND_MAIL_DOMAIN", ""),
+
+		// メトリクス
+		MetricsAddr: GetEnvDefault("METRICS_ADDR", ""),
+
+		// Cookie
+		CookieSessionName: GetEnvDefault("COOKIE_SESSION_NAME", "session_id"),
+		CookieCSRFName:    GetEnvDefault("COOKIE_CSRF_NAME", "csrf_token"),
+		CookieDomain:      GetEnvDefault("COOKIE_DOMAIN", cookieDomainDefault),
+		CookiePath:        GetEnvDefault("COOKIE_PATH", "/"),
+		CookieSecure:      cookieSecure,
+		CookieSameSite:    cookieSameSite,
+
+		// セッション
+		SessionMaxLifetime:        sessionMaxLifetime,
+		SessionSweepInterval:      sessionSweepInterval,
+		SessionStore:              GetEnvDefault("SESSION_STORE", "mysql"),
+		CSRFSecret:                GetEnvDefault("CSRF_SECRET", devSecret),
+		SessionTokenKey:           GetEnvDefault("SESSION_TOKEN_KEY", devSecret),
+		SessionRevalidateInterval: sessionRevalidateInterval,
+		RedisAddr:                 GetEnvDefault("REDIS_ADDR", "localhost:6379"),
+		RedisPassword:             GetEnvDefault("REDIS_PASSWORD", ""),
+		RedisDB:                   redisDB,
+
 		// DB
 		DBDriver:   GetEnvDefault("DB_DRIVER", "mysql"),
 		DBHost:     GetEnvDefault("DB_HOST", "127.0.0.1"),
@@ -96,5 +252,92 @@ func LoadEnv() (*ConfigList, error) {
 		DBPassword: GetEnvDefault("DB_PASSWORD", "password"),
 	}
 
+	// IdPのコールバックURLの既定値に PUBLIC_BASE_URL を使うため、最後に読み込む
+	cfg.AuthProviders, err = loadAuthProviders(cfg.Env, cfg.PublicBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.validateCookies(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.validateSecrets(); err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
+
+// COOKIE_SAMESITE（lax / strict / none）を変換
+func parseSameSite(v string) (http.SameSite, error) {
+	switch strings.ToLower(v) {
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	}
+	return 0, fmt.Errorf("invalid COOKIE_SAMESITE: %q (want lax, strict or none)", v)
+}
+
+// 署名鍵・暗号化鍵の既定値（開発環境用）
+const devSecret = "dummykey"
+
+// 開発環境以外では、署名鍵・暗号化鍵が未設定・既定値のまま起動しない
+// （既定値のままではトークンを第三者が作れてしまう）
+func (c *ConfigList) validateSecrets() error {
+	if c.IsDevelopment() {
+		return nil
+	}
+
+	secrets := []struct {
+		name  string
+		value string
+	}{
+		{"MAIL_LINK_SECRET", c.MailLinkSecret},
+		{"CSRF_SECRET", c.CSRFSecret},
+		{"SESSION_TOKEN_KEY", c.SessionTokenKey},
+	}
+	for _, s := range secrets {
+		if s.value == "" || s.value == devSecret {
+			return fmt.Errorf("%s must be set to a non-default value when APP_ENV=%s", s.name, c.Env)
+		}
+	}
+	return nil
+}
+
+// ブラウザに拒否される・意図せず動かない組み合わせを起動時に検出する
+func (c *ConfigList) validateCookies() error {
+	for _, name := range []string{c.CookieSessionName, c.CookieCSRFName} {
+		if err := (&http.Cookie{Name: name, Value: "x"}).Valid(); err != nil {
+			return fmt.Errorf("invalid cookie name %q: %w", name, err)
+		}
+	}
+	if c.CookieSessionName == c.CookieCSRFName {
+		return fmt.Errorf("COOKIE_SESSION_NAME and COOKIE_CSRF_NAME must differ")
+	}
+	if !strings.HasPrefix(c.CookiePath, "/") {
+		return fmt.Errorf("COOKIE_PATH must start with /: %q", c.CookiePath)
+	}
+
+	// SameSite=None は Secure が必須（ブラウザがCookieを保存しない）
+	if c.CookieSameSite == http.SameSiteNoneMode && !c.CookieSecure {
+		return fmt.Errorf("COOKIE_SAMESITE=none requires COOKIE_SECURE=true")
+	}
+
+	// 接頭辞付きの名前はブラウザが属性を強制する
+	for _, name := range []string{c.CookieSessionName, c.CookieCSRFName} {
+		if (strings.HasPrefix(name, "__Secure-") || strings.HasPrefix(name, "__Host-")) && !c.CookieSecure {
+			return fmt.Errorf("cookie %q requires COOKIE_SECURE=true", name)
+		}
+		if strings.HasPrefix(name, "__Host-") && c.CookiePath != "/" {
+			return fmt.Errorf("cookie %q requires COOKIE_PATH=/", name)
+		}
+	}
+	if strings.HasPrefix(c.CookieCSRFName, "__Host-") && c.CookieDomain != "" {
+		return fmt.Errorf("cookie %q requires empty COOKIE_DOMAIN", c.CookieCSRFName)
+	}
+	return nil
+}
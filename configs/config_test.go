@@ -0,0 +1,160 @@
+package configs
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+// 開発環境以外で使える署名鍵・暗号化鍵を全て設定した設定
+func productionConfig() *ConfigList {
+	return &ConfigList{
+		Env:             "production",
+		MailLinkSecret:  "mail-link-secret",
+		CSRFSecret:      "csrf-secret",
+		SessionTokenKey: "session-token-key",
+	}
+}
+
+func TestValidateSecrets(t *testing.T) {
+	if err := productionConfig().validateSecrets(); err != nil {
+		t.Fatalf("configured secrets rejected: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		set  func(c *ConfigList, v string)
+	}{
+		{"MAIL_LINK_SECRET", func(c *ConfigList, v string) { c.MailLinkSecret = v }},
+		{"CSRF_SECRET", func(c *ConfigList, v string) { c.CSRFSecret = v }},
+		{"SESSION_TOKEN_KEY", func(c *ConfigList, v string) { c.SessionTokenKey = v }},
+	}
+
+	for _, tt := range tests {
+		for _, v := range []string{"", devSecret} {
+			c := productionConfig()
+			tt.set(c, v)
+			err := c.validateSecrets()
+			if err == nil || !strings.Contains(err.Error(), tt.name) {
+				t.Errorf("%s=%q: err = %v, want error naming %s", tt.name, v, err, tt.name)
+			}
+
+			// 開発環境は既定値のまま起動できる
+			c.Env = "development"
+			if err := c.validateSecrets(); err != nil {
+				t.Errorf("%s=%q in development: %v", tt.name, v, err)
+			}
+		}
+	}
+}
+
+// テスト中のみ環境変数を未設定にする（終了時に元の値へ戻す）
+func unsetenv(t *testing.T, key string) {
+	t.Helper()
+	t.Setenv(key, "")
+	if err := os.Unsetenv(key); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Cookieの属性の既定値は APP_ENV で切り替わり、個別の環境変数で上書きできる
+func TestLoadEnvCookieDefaults(t *testing.T) {
+	for _, key := range []string{"COOKIE_SECURE", "COOKIE_SAMESITE", "COOKIE_DOMAIN", "COOKIE_PATH", "COOKIE_SESSION_NAME", "COOKIE_CSRF_NAME", "AUTH_PROVIDERS"} {
+		unsetenv(t, key)
+	}
+	t.Setenv("MAIL_LINK_SECRET", "mail-link-secret")
+	t.Setenv("CSRF_SECRET", "csrf-secret")
+	t.Setenv("SESSION_TOKEN_KEY", "session-token-key")
+
+	tests := []struct {
+		env      string
+		secure   bool
+		sameSite http.SameSite
+		domain   string
+	}{
+		{"development", false, http.SameSiteLaxMode, ""},
+		{"staging", true, http.SameSiteNoneMode, "mindmap-kanban.com"},
+		{"production", true, http.SameSiteNoneMode, "mindmap-kanban.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("APP_ENV", tt.env)
+			c, err := LoadEnv()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.CookieSecure != tt.secure || c.CookieSameSite != tt.sameSite || c.CookieDomain != tt.domain {
+				t.Errorf("cookie = secure %v, samesite %v, domain %q; want %v, %v, %q",
+					c.CookieSecure, c.CookieSameSite, c.CookieDomain, tt.secure, tt.sameSite, tt.domain)
+			}
+		})
+	}
+
+	t.Run("override", func(t *testing.T) {
+		t.Setenv("APP_ENV", "production")
+		t.Setenv("COOKIE_SAMESITE", "Strict")
+		t.Setenv("COOKIE_DOMAIN", "")
+		c, err := LoadEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !c.CookieSecure || c.CookieSameSite != http.SameSiteStrictMode || c.CookieDomain != "" {
+			t.Errorf("cookie = secure %v, samesite %v, domain %q", c.CookieSecure, c.CookieSameSite, c.CookieDomain)
+		}
+	})
+
+	// 本番の既定のSameSite=NoneのままSecureだけ外すと起動しない
+	t.Run("none without secure", func(t *testing.T) {
+		t.Setenv("APP_ENV", "production")
+		t.Setenv("COOKIE_SECURE", "false")
+		if _, err := LoadEnv(); err == nil || !strings.Contains(err.Error(), "COOKIE_SECURE") {
+			t.Errorf("err = %v, want COOKIE_SECURE error", err)
+		}
+	})
+
+	t.Run("invalid samesite", func(t *testing.T) {
+		t.Setenv("APP_ENV", "development")
+		t.Setenv("COOKIE_SAMESITE", "always")
+		if _, err := LoadEnv(); err == nil || !strings.Contains(err.Error(), "COOKIE_SAMESITE") {
+			t.Errorf("err = %v, want COOKIE_SAMESITE error", err)
+		}
+	})
+}
+
+func TestValidateCookies(t *testing.T) {
+	base := func() *ConfigList {
+		return &ConfigList{CookieSessionName: "session_id", CookieCSRFName: "csrf_token", CookiePath: "/"}
+	}
+
+	tests := []struct {
+		name    string
+		set     func(c *ConfigList)
+		wantErr string // 空の場合はエラーにならない
+	}{
+		{"lax without secure", func(c *ConfigList) { c.CookieSameSite = http.SameSiteLaxMode }, ""},
+		{"none with secure", func(c *ConfigList) { c.CookieSameSite, c.CookieSecure = http.SameSiteNoneMode, true }, ""},
+		{"none without secure", func(c *ConfigList) { c.CookieSameSite = http.SameSiteNoneMode }, "COOKIE_SECURE"},
+		{"same names", func(c *ConfigList) { c.CookieCSRFName = c.CookieSessionName }, "must differ"},
+		{"invalid name", func(c *ConfigList) { c.CookieSessionName = "session id" }, "invalid cookie name"},
+		{"relative path", func(c *ConfigList) { c.CookiePath = "v1" }, "COOKIE_PATH"},
+		{"__Secure- without secure", func(c *ConfigList) { c.CookieSessionName = "__Secure-session" }, "COOKIE_SECURE"},
+		{"__Host- with path", func(c *ConfigList) {
+			c.CookieSessionName, c.CookieSecure, c.CookiePath = "__Host-session", true, "/v1"
+		}, "COOKIE_PATH"},
+		{"__Host- csrf with domain", func(c *ConfigList) {
+			c.CookieCSRFName, c.CookieSecure, c.CookieDomain = "__Host-csrf", true, "example.com"
+		}, "COOKIE_DOMAIN"},
+	}
+	for _, tt := range tests {
+		c := base()
+		tt.set(c)
+		err := c.validateCookies()
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("%s: err = %v, want error containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package accesstoken
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// パーソナルアクセストークンの接頭辞
+	TokenPrefix = "mkpat"
+
+	// 最終利用日時を更新する間隔
+	touchInterval = time.Minute
+)
+
+// トークンの権限
+const (
+	ScopeRead  = "read"  // GET・HEADのみ
+	ScopeWrite = "write" // 更新も可
+)
+
+func IsScope(s string) bool {
+	return s == ScopeRead || s == ScopeWrite
+}
+
+// TokenRepository は照合に使うaccess_tokensの読み書き（*repository.AccessTokenRepository）
+type TokenRepository interface {
+	FindAccessTokenByHash(ctx context.Context, tokenHash []byte) (*repository.AccessToken, error)
+	TouchAccessToken(ctx context.Context, id int64, at time.Time, interval time.Duration) error
+}
+
+// Authenticator は Authorization: Bearer で送られたパーソナルアクセストークンを照合する
+// middleware.BearerAuthenticator を実装する
+type Authenticator struct {
+	Repo TokenRepository
+}
+
+func NewAuthenticator(repo TokenRepository) *Authenticator {
+	return &Authenticator{Repo: repo}
+}
+
+// トークンを照合し、ユーザーIDと参照のみの権限かを返す（不正・期限切れの場合はok=false）
+func (a *Authenticator) AuthenticateBearer(ctx context.Context, tok string) (int64, bool, bool, error) {
+	if !strings.HasPrefix(tok, TokenPrefix+"_") {
+		return 0, false, false, nil
+	}
+
+	t, err := a.Repo.FindAccessTokenByHash(ctx, token.Hash(tok))
+	if err != nil {
+		return 0, false, false, err
+	}
+	now := time.Now()
+	if t == nil || (t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)) {
+		return 0, false, false, nil
+	}
+
+	if err := a.Repo.TouchAccessToken(ctx, t.ID, now, touchInterval); err != nil {
+		slog.Default().With("module", "accesstoken", "userID", t.UserID).Warn("failed to update access token last used time", "err", err)
+	}
+	return t.UserID, t.Scope != ScopeWrite, true, nil
+}
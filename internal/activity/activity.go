@@ -0,0 +1,139 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/internal/events"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// stateの更新以外の操作の種類（stateの更新はevents.Typesの種類で記録する）
+const (
+	TypeLogin                    = "auth.login"                 // ログイン
+	TypeLogout                   = "auth.logout"                // ログアウト
+	TypeIdentityLinked           = "auth.identity_linked"       // IdPのログインの連携
+	TypeIdentityUnlinked         = "auth.identity_unlinked"     // IdPのログインの連携解除
+	TypeAccountDeletionRequested = "account.deletion_requested" // 退会申請
+)
+
+// User-Agentの最大長（超えた分は切り詰める）
+const maxUserAgentLen = 255
+
+// EventRepository は activity_events の読み書き（*repository.ActivityRepository）
+type EventRepository interface {
+	InsertActivityEvents(ctx context.Context, evs []repository.ActivityEvent) error
+	ListActivityEvents(ctx context.Context, userID int64, f repository.ActivityFilter, limit int) ([]repository.ActivityEvent, error)
+	DeleteActivityEventsExcept(ctx context.Context, userID int64, keepTypes ...string) error
+}
+
+// Recorder はユーザーの操作を activity_events に記録する
+// 記録に失敗しても元の操作は成功扱いとし、ログのみ出力する
+type Recorder struct {
+	Repo EventRepository
+}
+
+func NewRecorder(repo EventRepository) *Recorder {
+	return &Recorder{Repo: repo}
+}
+
+// stateの更新イベントの詳細
+type changeData struct {
+	PjName    string  `json:"pjName"`
+	Label     string  `json:"label,omitempty"`
+	PrevLabel string  `json:"prevLabel,omitempty"`
+	From      *string `json:"from,omitempty"`
+	To        *string `json:"to,omitempty"`
+}
+
+// ログイン・ログアウト等の詳細
+type requestData struct {
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+}
+
+// events.Hooksで更新フックとして登録する
+// 更新前後のstateから検出したプロジェクト・ノード・カードの変更を記録する
+func (rc *Recorder) Record(ctx context.Context, c *minkan.Change, d *events.Changes) {
+	lg := slog.Default().With("module", "activity", "userID", c.UserID, "version", c.Version)
+
+	version := c.Version
+	evs := []repository.ActivityEvent{}
+	for _, ev := range d.Events {
+		// card.done は同時に記録する card.moved（移動先がdone）と重複するため記録しない
+		if ev.Type == events.CardDone {
+			continue
+		}
+
+		data, err := json.Marshal(changeData{
+			PjName:    ev.PjName,
+			Label:     ev.Label,
+			PrevLabel: ev.PrevLabel,
+			From:      ev.From,
+			To:        ev.To,
+		})
+		if err != nil {
+			lg.Error("marshal activity data failed", "err", err)
+			return
+		}
+
+		pjID := ev.PjID
+		e := repository.ActivityEvent{
+			UserID:  c.UserID,
+			Type:    ev.Type,
+			PjID:    &pjID,
+			Origin:  c.Origin,
+			Version: &version,
+			Data:    data,
+		}
+		if ev.NodeID != "" {
+			nodeID := ev.NodeID
+			e.NodeID = &nodeID
+		}
+		evs = append(evs, e)
+	}
+
+	if err := rc.Repo.InsertActivityEvents(ctx, evs); err != nil {
+		lg.Error("insert activity events failed", "err", err, "count", len(evs))
+	}
+}
+
+// Log はログイン等、stateの更新以外の操作を記録する（接続元のIPアドレス・User-Agentも記録する）
+func (rc *Recorder) Log(ctx context.Context, userID int64, typ string, r *http.Request) {
+	lg := slog.Default().With("module", "activity", "userID", userID, "type", typ)
+
+	d := requestData{}
+	if r != nil {
+		d.IP = clientIP(r)
+		d.UserAgent = r.UserAgent()
+		if len(d.UserAgent) > maxUserAgentLen {
+			d.UserAgent = d.UserAgent[:maxUserAgentLen]
+		}
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		lg.Error("marshal activity data failed", "err", err)
+		return
+	}
+
+	if err := rc.Repo.InsertActivityEvents(ctx, []repository.ActivityEvent{{
+		UserID: userID,
+		Type:   typ,
+		Data:   data,
+	}}); err != nil {
+		lg.Error("insert activity event failed", "err", err)
+	}
+}
+
+// 接続元のIPアドレス（プロキシのヘッダは偽装できるため使わない）
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,42 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yopi416/mind-kanban-backend/internal/events"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// Recorder はstateの更新からカードのカラム移動を検出し、card_transitions に記録する
+type Recorder struct {
+	Repo *repository.CardTransitionRepository
+}
+
+func NewRecorder(repo *repository.CardTransitionRepository) *Recorder {
+	return &Recorder{Repo: repo}
+}
+
+// events.Hooksで更新フックとして登録する
+// プロジェクトの削除で消えたカードもカンバンからの除外として記録する（累積フローに残り続けないように）
+func (rc *Recorder) Record(ctx context.Context, c *minkan.Change, d *events.Changes) {
+	lg := slog.Default().With("module", "analytics", "userID", c.UserID, "version", c.Version)
+
+	ts := []repository.CardTransition{}
+	for _, mv := range d.CardMoves {
+		ts = append(ts, repository.CardTransition{
+			UserID:         c.UserID,
+			PjID:           mv.Ref.PjId,
+			NodeID:         mv.Ref.NodeId,
+			FromColumn:     mv.From,
+			ToColumn:       mv.To,
+			Version:        c.Version,
+			TransitionedAt: c.UpdatedAt,
+		})
+	}
+
+	if err := rc.Repo.InsertCardTransitions(ctx, ts); err != nil {
+		lg.Error("insert card transitions failed", "err", err, "count", len(ts))
+	}
+}
@@ -0,0 +1,234 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/events"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// 日付の書式（API の format: date）
+const dateLayout = "2006-01-02"
+
+// Report はフロー分析の結果
+type Report struct {
+	TimeZone       string       `json:"timeZone"` // 日付・週の区切りに使ったタイムゾーン
+	From           string       `json:"from"`
+	To             string       `json:"to"`
+	Throughput     []WeekCount  `json:"throughput"`
+	LeadTime       Percentiles  `json:"leadTime"`
+	CycleTime      Percentiles  `json:"cycleTime"`
+	WorkItemAge    []AgingItem  `json:"workItemAge"`
+	CumulativeFlow []FlowCounts `json:"cumulativeFlow"`
+}
+
+// WeekCount は1週間（月曜始まり）に完了したカードの数
+type WeekCount struct {
+	WeekStart string `json:"weekStart"`
+	Count     int    `json:"count"`
+}
+
+// Percentiles は所要時間（時間単位）の分布（対象が無い場合はnull）
+type Percentiles struct {
+	Count int      `json:"count"`
+	P50   *float64 `json:"p50"`
+	P85   *float64 `json:"p85"`
+	P95   *float64 `json:"p95"`
+}
+
+// AgingItem はdoingにあるカード1枚の経過時間
+type AgingItem struct {
+	PjID      string     `json:"pjId"`
+	NodeID    string     `json:"nodeId"`
+	Label     string     `json:"label"`
+	StartedAt *time.Time `json:"startedAt"` // doingに入った日時（記録開始前から入っている場合はnull）
+	AgeHours  *float64   `json:"ageHours"`
+}
+
+// FlowCounts は1日の終わり時点の各カラムのカード数
+type FlowCounts struct {
+	Date    string `json:"date"`
+	Backlog int    `json:"backlog"`
+	Todo    int    `json:"todo"`
+	Doing   int    `json:"doing"`
+	Done    int    `json:"done"`
+}
+
+// カード1枚の状態（カラム移動をたどりながら更新する）
+type cardState struct {
+	column    string
+	enteredAt *time.Time // カンバンに追加された日時（リードタイムの起点）
+	startedAt *time.Time // doingに初めて入った日時（サイクルタイムの起点）
+}
+
+// Compute は [from, to) の期間のフロー指標を計算する
+// - tsはtoより前の全てのカラム移動（古い順）。期間の開始時点の状態を求めるため、from以前の移動も含める
+// - mは現在のstate（doingのカードの抽出と、移動の記録が無いカードのカラムに使う）
+// - from・toはユーザーのタイムゾーンの0時とし、日・週の区切りもそのタイムゾーンで行う
+// - 完了はdoneカラムへの移動とし、リードタイムはカンバンへの追加から、サイクルタイムはdoingへの移動から完了までとする
+func Compute(ts []repository.CardTransition, m *repository.Minkan, pjID *string, from, to, now time.Time) *Report {
+	loc := from.Location()
+
+	cards := initialCards(ts, m, pjID)
+	counts := map[string]int{}
+	for _, c := range cards {
+		counts[c.column]++
+	}
+
+	weeks := map[string]int{}
+	lead, cycle := []float64{}, []float64{}
+	flow := []FlowCounts{}
+
+	day := from
+	i := 0
+	for ; i <= len(ts); i++ {
+		// この移動より前に終わった日の集計を確定する
+		for !day.After(now) && day.Before(to) && (i == len(ts) || !ts[i].TransitionedAt.Before(day.AddDate(0, 0, 1))) {
+			flow = append(flow, flowCounts(day, counts))
+			day = day.AddDate(0, 0, 1)
+		}
+		if i == len(ts) {
+			break
+		}
+
+		t := &ts[i]
+		at := t.TransitionedAt
+		c := cards[repository.KanbanCardRef{PjId: t.PjID, NodeId: t.NodeID}]
+		counts[c.column]--
+		counts[t.ToColumn]++
+
+		switch {
+		case t.ToColumn == minkan.ColumnNone:
+			c.enteredAt, c.startedAt = nil, nil
+		case t.FromColumn == minkan.ColumnNone:
+			c.enteredAt, c.startedAt = &at, nil
+		}
+		if t.ToColumn == minkan.ColumnDoing && c.startedAt == nil {
+			c.startedAt = &at
+		}
+
+		if t.ToColumn == minkan.ColumnDone && !at.Before(from) && at.Before(to) {
+			weeks[weekStart(at.In(loc)).Format(dateLayout)]++
+			if c.enteredAt != nil {
+				lead = append(lead, at.Sub(*c.enteredAt).Hours())
+			}
+			if c.startedAt != nil {
+				cycle = append(cycle, at.Sub(*c.startedAt).Hours())
+			}
+		}
+		c.column = t.ToColumn
+	}
+
+	return &Report{
+		TimeZone:       loc.String(),
+		From:           from.Format(dateLayout),
+		To:             to.AddDate(0, 0, -1).Format(dateLayout),
+		Throughput:     throughput(weeks, from, to),
+		LeadTime:       percentiles(lead),
+		CycleTime:      percentiles(cycle),
+		WorkItemAge:    workItemAge(m, pjID, cards, now),
+		CumulativeFlow: flow,
+	}
+}
+
+// 各カードの最初の移動より前のカラム
+// 移動の記録が無いカードは、記録開始前から現在のカラムにあったものとして扱う
+func initialCards(ts []repository.CardTransition, m *repository.Minkan, pjID *string) map[repository.KanbanCardRef]*cardState {
+	cards := map[repository.KanbanCardRef]*cardState{}
+	for _, t := range ts {
+		k := repository.KanbanCardRef{PjId: t.PjID, NodeId: t.NodeID}
+		if _, ok := cards[k]; !ok {
+			cards[k] = &cardState{column: t.FromColumn}
+		}
+	}
+	for k, col := range events.CardColumns(m) {
+		if pjID != nil && k.PjId != *pjID {
+			continue
+		}
+		if _, ok := cards[k]; !ok {
+			cards[k] = &cardState{column: col}
+		}
+	}
+	return cards
+}
+
+func flowCounts(day time.Time, counts map[string]int) FlowCounts {
+	return FlowCounts{
+		Date:    day.Format(dateLayout),
+		Backlog: counts[minkan.ColumnBacklog],
+		Todo:    counts[minkan.ColumnTodo],
+		Doing:   counts[minkan.ColumnDoing],
+		Done:    counts[minkan.ColumnDone],
+	}
+}
+
+// 期間に含まれる全ての週（完了が無い週は0件）
+func throughput(weeks map[string]int, from, to time.Time) []WeekCount {
+	res := []WeekCount{}
+	for w := weekStart(from); w.Before(to); w = w.AddDate(0, 0, 7) {
+		key := w.Format(dateLayout)
+		res = append(res, WeekCount{WeekStart: key, Count: weeks[key]})
+	}
+	return res
+}
+
+// tを含む週の月曜0時
+func weekStart(t time.Time) time.Time {
+	y, mo, d := t.Date()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(y, mo, d-offset, 0, 0, 0, 0, t.Location())
+}
+
+// 最近傍順位法によるパーセンタイル
+func percentiles(hours []float64) Percentiles {
+	p := Percentiles{Count: len(hours)}
+	if len(hours) == 0 {
+		return p
+	}
+	sort.Float64s(hours)
+	rank := func(pct float64) *float64 {
+		idx := int(math.Ceil(pct/100*float64(len(hours)))) - 1
+		v := roundHours(hours[max(idx, 0)])
+		return &v
+	}
+	p.P50, p.P85, p.P95 = rank(50), rank(85), rank(95)
+	return p
+}
+
+// doingにあるカードを経過時間の長い順に返す（経過時間が不明なものは最後）
+func workItemAge(m *repository.Minkan, pjID *string, cards map[repository.KanbanCardRef]*cardState, now time.Time) []AgingItem {
+	items := []AgingItem{}
+	for _, ref := range *minkan.ColumnCards(&m.KanbanColumns, minkan.ColumnDoing) {
+		if pjID != nil && ref.PjId != *pjID {
+			continue
+		}
+		item := AgingItem{PjID: ref.PjId, NodeID: ref.NodeId}
+		if pj, ok := m.Projects[ref.PjId]; ok {
+			if idx := minkan.FindNode(&pj, ref.NodeId); idx >= 0 {
+				item.Label = pj.Nodes[idx].Data.Label
+			}
+		}
+		if c, ok := cards[ref]; ok && c.startedAt != nil {
+			age := roundHours(now.Sub(*c.startedAt).Hours())
+			item.StartedAt, item.AgeHours = c.startedAt, &age
+		}
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].AgeHours, items[j].AgeHours
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a > *b
+	})
+	return items
+}
+
+// 時間を小数第1位に丸める
+func roundHours(h float64) float64 {
+	return math.Round(h*10) / 10
+}
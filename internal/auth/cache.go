@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// キャッシュする文書の最大サイズ（discovery・JWKSは数KB程度）
+const maxCachedDocument = 1 << 20
+
+// docCache はIdPのdiscovery・JWKSの直近の取得結果を保持する http.RoundTripper
+// 通常は毎回IdPから取得し、IdPに到達できない・5xxの場合のみ保持している内容を返す（stale-if-error）
+// JWKSは鍵のローテーション時のみ再取得されるため、その時点でIdPが不調でも既知の鍵で検証を続けられる
+type docCache struct {
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	allowed map[string]bool // キャッシュするURL
+	docs    map[string]*cachedDoc
+}
+
+type cachedDoc struct {
+	header    http.Header
+	body      []byte
+	fetchedAt time.Time
+}
+
+func newDocCache(base http.RoundTripper) *docCache {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &docCache{
+		base:    base,
+		allowed: make(map[string]bool),
+		docs:    make(map[string]*cachedDoc),
+	}
+}
+
+// URLをキャッシュの対象にする
+func (c *docCache) allow(url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.allowed[url] = true
+}
+
+// URLをキャッシュの対象にして取得しておく（IdPの初期化時に使う）
+func (c *docCache) prefetch(ctx context.Context, client *http.Client, url string) error {
+	c.allow(url)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %d", url, res.StatusCode)
+	}
+	_, err = io.Copy(io.Discard, res.Body)
+	return err
+}
+
+func (c *docCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	url := req.URL.String()
+
+	c.mu.Lock()
+	allowed := req.Method == http.MethodGet && c.allowed[url]
+	c.mu.Unlock()
+
+	if !allowed {
+		return c.base.RoundTrip(req)
+	}
+
+	res, err := c.base.RoundTrip(req)
+	if err == nil && res.StatusCode < http.StatusInternalServerError {
+		if res.StatusCode == http.StatusOK {
+			return c.store(url, res)
+		}
+		return res, nil
+	}
+
+	// IdPが不調の場合は直近の取得結果を返す
+	if doc := c.lookup(url); doc != nil {
+		if res != nil {
+			_ = res.Body.Close()
+		}
+		slog.Warn("identity provider unreachable, serving cached document", "url", url, "fetchedAt", doc.fetchedAt, "err", err)
+		return doc.response(req), nil
+	}
+	return res, err
+}
+
+// 成功したレスポンスを保存し、読み直せるレスポンスを返す
+func (c *docCache) store(url string, res *http.Response) (*http.Response, error) {
+	defer func() { _ = res.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxCachedDocument+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxCachedDocument {
+		return nil, fmt.Errorf("GET %s: document too large", url)
+	}
+
+	c.mu.Lock()
+	c.docs[url] = &cachedDoc{header: res.Header.Clone(), body: body, fetchedAt: time.Now()}
+	c.mu.Unlock()
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+func (c *docCache) lookup(url string) *cachedDoc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.docs[url]
+}
+
+func (d *cachedDoc) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        d.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(d.body)),
+		ContentLength: int64(len(d.body)),
+		Request:       req,
+	}
+}
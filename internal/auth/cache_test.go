@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func get(t *testing.T, client *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	res, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, string(body)
+}
+
+// 最初の取得の後にIdPが不調になっても、キャッシュの対象のURLは直近の取得結果を返す
+func TestDocCacheServesStaleOnError(t *testing.T) {
+	var failing atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/keys", "/other":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"keys": []}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cache := newDocCache(nil)
+	client := &http.Client{Transport: cache}
+	cache.allow(srv.URL + "/keys")
+	cache.allow(srv.URL + "/missing")
+
+	if res, body := get(t, client, srv.URL+"/keys"); res.StatusCode != http.StatusOK || body != `{"keys": []}` {
+		t.Fatalf("first fetch = %d %q", res.StatusCode, body)
+	}
+	// 4xxはIdPの応答としてそのまま返し、保存しない
+	if res, _ := get(t, client, srv.URL+"/missing"); res.StatusCode != http.StatusNotFound {
+		t.Errorf("missing = %d", res.StatusCode)
+	}
+
+	failing.Store(true)
+	res, body := get(t, client, srv.URL+"/keys")
+	if res.StatusCode != http.StatusOK || body != `{"keys": []}` || res.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("keys while idp returns 5xx = %d %q %v", res.StatusCode, body, res.Header)
+	}
+	// キャッシュの対象外・取得できていないURLはIdPの応答のまま
+	for _, path := range []string{"/other", "/missing"} {
+		if res, _ := get(t, client, srv.URL+path); res.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("%s while idp returns 5xx = %d", path, res.StatusCode)
+		}
+	}
+
+	// IdPに到達できない場合も同じ
+	srv.Close()
+	if res, body := get(t, client, srv.URL+"/keys"); res.StatusCode != http.StatusOK || body != `{"keys": []}` {
+		t.Errorf("keys while idp unreachable = %d %q", res.StatusCode, body)
+	}
+	if _, err := client.Get(srv.URL + "/other"); err == nil {
+		t.Error("uncached url succeeded while idp unreachable")
+	}
+}
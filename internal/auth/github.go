@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"golang.org/x/oauth2"
+)
+
+// GitHubのユーザーに付けるissuer（users.oidc_iss に保存する値）
+const githubIssuer = "https://github.com"
+
+// githubAdapter はIDトークンの無いGitHubのOAuth2で、ユーザー情報APIから本人確認する
+type githubAdapter struct {
+	httpClient *http.Client
+	endpoint   oauth2.Endpoint
+	apiBaseURL string
+}
+
+func newGitHubAdapter(httpClient *http.Client) *githubAdapter {
+	return &githubAdapter{
+		httpClient: httpClient,
+		endpoint: oauth2.Endpoint{
+			AuthURL:  "https://github.com/login/oauth/authorize",
+			TokenURL: "https://github.com/login/oauth/access_token",
+		},
+		apiBaseURL: "https://api.github.com",
+	}
+}
+
+type githubUser struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+	Email string `json:"email"` // 公開設定のメールアドレス（未設定は空）
+}
+
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// アクセストークンで /user と /user/emails を取得してユーザーを特定する
+func (gh *githubAdapter) identify(providerID string) identifyFunc {
+	return func(ctx context.Context, tokens *oidc.Tokens[*oidc.IDTokenClaims]) (*Identity, error) {
+		if tokens == nil || tokens.Token == nil || tokens.AccessToken == "" {
+			return nil, fmt.Errorf("github: access token missing")
+		}
+
+		var user githubUser
+		if err := gh.get(ctx, tokens.AccessToken, "/user", &user); err != nil {
+			return nil, err
+		}
+		if user.ID == 0 {
+			return nil, fmt.Errorf("github: user id missing")
+		}
+
+		id := &Identity{
+			Provider: providerID,
+			Issuer:   githubIssuer,
+			Subject:  strconv.FormatInt(user.ID, 10), // loginは変更できるため数値IDを使う
+			Email:    user.Email,
+			Name:     user.Name,
+		}
+		if id.Name == "" {
+			id.Name = user.Login
+		}
+
+		// 確認済みのメールアドレスは user:email スコープが必要（取得できない場合は未確認扱い）
+		var emails []githubEmail
+		if err := gh.get(ctx, tokens.AccessToken, "/user/emails", &emails); err == nil {
+			for _, e := range emails {
+				if e.Primary && e.Verified {
+					id.Email = e.Email
+					id.EmailVerified = true
+					break
+				}
+			}
+		}
+
+		return id, nil
+	}
+}
+
+func (gh *githubAdapter) get(ctx context.Context, accessToken, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gh.apiBaseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	res, err := gh.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("github: GET %s: %w", path, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("github: GET %s: unexpected status %d", path, res.StatusCode)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("github: GET %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"golang.org/x/oauth2"
+)
+
+// GitHubのユーザー情報APIの代わり（nilのレスポンスは404）
+func newGitHubAPI(t *testing.T, user, emails any, emailsStatus int) *githubAdapter {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gho_test" {
+			http.Error(w, "bad credentials", http.StatusUnauthorized)
+			return
+		}
+		var body any
+		status := http.StatusOK
+		switch r.URL.Path {
+		case "/user":
+			body = user
+		case "/user/emails":
+			body, status = emails, emailsStatus
+		}
+		if body == nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	gh := newGitHubAdapter(srv.Client())
+	gh.apiBaseURL = srv.URL
+	return gh
+}
+
+func githubTokens(accessToken string) *oidc.Tokens[*oidc.IDTokenClaims] {
+	return &oidc.Tokens[*oidc.IDTokenClaims]{Token: &oauth2.Token{AccessToken: accessToken}}
+}
+
+func TestGitHubIdentify(t *testing.T) {
+	user := githubUser{ID: 42, Login: "octocat", Email: "public@example.com"}
+	tests := []struct {
+		name         string
+		emails       []githubEmail
+		emailsStatus int
+		wantEmail    string
+		wantVerified bool
+	}{
+		{
+			name: "verified primary",
+			emails: []githubEmail{
+				{Email: "other@example.com", Verified: true},
+				{Email: "primary@example.com", Primary: true, Verified: true},
+			},
+			emailsStatus: http.StatusOK,
+			wantEmail:    "primary@example.com",
+			wantVerified: true,
+		},
+		{
+			// 未確認のprimaryや、primaryでない確認済みのアドレスは使わない
+			name: "unverified primary",
+			emails: []githubEmail{
+				{Email: "primary@example.com", Primary: true},
+				{Email: "other@example.com", Verified: true},
+			},
+			emailsStatus: http.StatusOK,
+			wantEmail:    "public@example.com",
+		},
+		{
+			// user:email スコープが無い場合
+			name:         "emails forbidden",
+			emails:       []githubEmail{},
+			emailsStatus: http.StatusForbidden,
+			wantEmail:    "public@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gh := newGitHubAPI(t, user, tt.emails, tt.emailsStatus)
+			id, err := gh.identify("github")(context.Background(), githubTokens("gho_test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Provider != "github" || id.Issuer != githubIssuer || id.Subject != "42" || id.Name != "octocat" {
+				t.Errorf("identity = %+v", id)
+			}
+			if id.Email != tt.wantEmail || id.EmailVerified != tt.wantVerified {
+				t.Errorf("email = %q (verified %v), want %q (verified %v)", id.Email, id.EmailVerified, tt.wantEmail, tt.wantVerified)
+			}
+		})
+	}
+}
+
+func TestGitHubIdentifyErrors(t *testing.T) {
+	ctx := context.Background()
+	emails := []githubEmail{{Email: "primary@example.com", Primary: true, Verified: true}}
+
+	gh := newGitHubAPI(t, githubUser{ID: 42, Login: "octocat"}, emails, http.StatusOK)
+	for _, tokens := range []*oidc.Tokens[*oidc.IDTokenClaims]{nil, {}, githubTokens("")} {
+		if id, err := gh.identify("github")(ctx, tokens); err == nil {
+			t.Errorf("identify without access token = %+v", id)
+		}
+	}
+	if id, err := gh.identify("github")(ctx, githubTokens("gho_revoked")); err == nil {
+		t.Errorf("identify with rejected token = %+v", id)
+	}
+
+	// ユーザーIDが無い・ユーザー情報が取得できない・JSONでない場合は本人確認できない
+	if id, err := newGitHubAPI(t, githubUser{Login: "octocat"}, emails, http.StatusOK).identify("github")(ctx, githubTokens("gho_test")); err == nil {
+		t.Errorf("identify without user id = %+v", id)
+	}
+	if id, err := newGitHubAPI(t, nil, emails, http.StatusOK).identify("github")(ctx, githubTokens("gho_test")); err == nil {
+		t.Errorf("identify without user = %+v", id)
+	}
+	if id, err := newGitHubAPI(t, "not an object", emails, http.StatusOK).identify("github")(ctx, githubTokens("gho_test")); err == nil {
+		t.Errorf("identify with invalid user = %+v", id)
+	}
+}
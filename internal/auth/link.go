@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// アカウント連携の途中状態を保持するCookie（IdPとの往復の間だけ使う）
+const (
+	linkIntentCookie  = "link_intent"  // ログイン中のユーザーが別のIdPの連携を開始した
+	linkPendingCookie = "link_pending" // 未連携のIdPのメールアドレスが既存のアカウントと一致した
+	linkTTL           = 10 * time.Minute
+)
+
+// LinkIntent はログイン中のユーザーが開始したIdPの連携
+// コールバックで同じユーザーのセッションが確認できた場合のみ連携する
+type LinkIntent struct {
+	UserID    int64     `json:"userId"`
+	Provider  string    `json:"provider"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// PendingLink は既存のアカウントへの連携を保留しているIdentity
+// 既存のアカウントでログインし直し、確認した場合のみ連携する（メールアドレスの一致だけでは連携しない）
+type PendingLink struct {
+	Identity  Identity  `json:"identity"`
+	UserID    int64     `json:"userId"` // 連携先のユーザー（メールアドレスが一致したユーザー）
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ログイン中のユーザーのIdP連携の開始を記録する
+func (reg *Registry) SetLinkIntent(w http.ResponseWriter, userID int64, provider string) error {
+	return reg.setLinkCookie(w, linkIntentCookie, &LinkIntent{
+		UserID:    userID,
+		Provider:  provider,
+		ExpiresAt: time.Now().Add(linkTTL),
+	})
+}
+
+// IdP連携の開始の記録を取り出して削除する（無い・期限切れの場合はnil）
+func (reg *Registry) TakeLinkIntent(w http.ResponseWriter, r *http.Request) *LinkIntent {
+	var li LinkIntent
+	if !reg.linkCookie(r, linkIntentCookie, &li) {
+		return nil
+	}
+	reg.cookies.DeleteCookie(w, linkIntentCookie)
+
+	if !time.Now().Before(li.ExpiresAt) {
+		return nil
+	}
+	return &li
+}
+
+// 既存のアカウントへの連携を保留する
+func (reg *Registry) SetPendingLink(w http.ResponseWriter, userID int64, id *Identity) error {
+	return reg.setLinkCookie(w, linkPendingCookie, &PendingLink{
+		Identity:  *id,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(linkTTL),
+	})
+}
+
+// 保留中の連携を取得する（無い・期限切れの場合はnil）
+func (reg *Registry) PendingLink(r *http.Request) *PendingLink {
+	var pl PendingLink
+	if !reg.linkCookie(r, linkPendingCookie, &pl) {
+		return nil
+	}
+	if !time.Now().Before(pl.ExpiresAt) {
+		return nil
+	}
+	return &pl
+}
+
+// 保留中の連携を破棄する
+func (reg *Registry) ClearPendingLink(w http.ResponseWriter) {
+	reg.cookies.DeleteCookie(w, linkPendingCookie)
+}
+
+func (reg *Registry) setLinkCookie(w http.ResponseWriter, name string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return reg.cookies.SetCookie(w, name, string(b))
+}
+
+// 暗号化Cookieを復号する（無い・改ざんされている場合はfalse）
+func (reg *Registry) linkCookie(r *http.Request, name string, v any) bool {
+	value, err := reg.cookies.CheckCookie(r, name)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal([]byte(value), v) == nil
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+// ログアウト時のトークンの失効の上限（IdPが不調でもログアウトの応答を遅らせない）
+const revokeTimeout = 5 * time.Second
+
+// Refresh はrefresh tokenでIdPにトークンを再発行させ、アカウントが有効か確認する（session.IdPChecker）
+// IdPが invalid_grant を返した場合（アカウントの無効化・同意の取り消し・失効済み等）は revoked=true
+func (reg *Registry) Refresh(ctx context.Context, provider string, tokens session.IdPTokens) (session.IdPTokens, bool, error) {
+	p := reg.Get(provider)
+	if p == nil {
+		return session.IdPTokens{}, false, fmt.Errorf("unknown auth provider: %q", provider)
+	}
+
+	relyingParty := p.relyingParty()
+	if relyingParty == nil {
+		return session.IdPTokens{}, false, errors.New("identity provider not ready")
+	}
+
+	res, err := rp.RefreshTokens[*oidc.IDTokenClaims](ctx, relyingParty, tokens.RefreshToken, "", "")
+	if err != nil {
+		var oidcErr *oidc.Error
+		if errors.As(err, &oidcErr) && oidcErr.ErrorType == oidc.InvalidGrant {
+			return session.IdPTokens{}, true, nil
+		}
+		return session.IdPTokens{}, false, err
+	}
+
+	// refresh tokenを再発行するIdPでは、以降は新しい方のみ使える
+	return session.IdPTokens{RefreshToken: res.RefreshToken, IDToken: res.IDToken}, false, nil
+}
+
+// Logout はセッションのIdPのトークンを失効させ、IdPからもログアウトするURL（RP-Initiated Logout）を返す
+// IdPが end_session_endpoint を公開していない・IDトークンが無い場合は空
+// 失効に失敗してもログアウトは続ける（トークンはセッションと共に破棄される）
+func (reg *Registry) Logout(ctx context.Context, provider string, tokens session.IdPTokens, postLogoutRedirectURL string) string {
+	lg := slog.Default().With("module", "auth", "provider", provider)
+
+	p := reg.Get(provider)
+	if p == nil {
+		return ""
+	}
+
+	relyingParty := p.relyingParty()
+	if relyingParty == nil {
+		lg.Warn("identity provider not ready, tokens not revoked")
+		return ""
+	}
+
+	// token revocation（RFC 7009）。対応していないIdP（GitHub等）は何もしない
+	if tokens.RefreshToken != "" && relyingParty.GetRevokeEndpoint() != "" {
+		revokeCtx, cancel := context.WithTimeout(ctx, revokeTimeout)
+		err := rp.RevokeToken(revokeCtx, relyingParty, tokens.RefreshToken, "refresh_token")
+		cancel()
+		if err != nil {
+			lg.Warn("revoke refresh token failed", "err", err)
+		}
+	}
+
+	endpoint := relyingParty.GetEndSessionEndpoint()
+	if endpoint == "" || tokens.IDToken == "" {
+		return ""
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		lg.Warn("invalid end_session_endpoint", "err", err)
+		return ""
+	}
+	q := u.Query()
+	q.Set("id_token_hint", tokens.IDToken)
+	q.Set("client_id", relyingParty.OAuthConfig().ClientID)
+	if postLogoutRedirectURL != "" {
+		// IdPに登録したURLのみ使える（未登録の場合はIdPの画面に留まる）
+		q.Set("post_logout_redirect_uri", postLogoutRedirectURL)
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/devoidc"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+)
+
+const (
+	testIssuer    = "https://idp.test/dev/oidc"
+	testLogoutURL = "https://app.test/"
+)
+
+// failingTransport は fail の間、全てのリクエストを失敗させる（IdPの障害）
+type failingTransport struct {
+	base http.RoundTripper
+	fail atomic.Bool
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.fail.Load() {
+		return nil, errors.New("identity provider unreachable")
+	}
+	return t.base.RoundTrip(req)
+}
+
+// 開発用のOPと、それを使う設定
+func newTestOP(t *testing.T, id, issuer string) (*devoidc.Provider, configs.AuthProviderConfig) {
+	t.Helper()
+	pc := configs.AuthProviderConfig{
+		ID:           id,
+		Type:         configs.AuthProviderTypeOIDC,
+		Issuer:       issuer,
+		ClientID:     "minkan-dev",
+		ClientSecret: "minkan-dev-secret",
+		Scopes:       []string{"openid", "email", "offline_access"},
+		RedirectURL:  "https://app.test/v1/auth/callback/" + id,
+	}
+	op, err := devoidc.New(devoidc.Config{
+		Issuer:                 pc.Issuer,
+		ClientID:               pc.ClientID,
+		ClientSecret:           pc.ClientSecret,
+		RedirectURIs:           []string{pc.RedirectURL},
+		PostLogoutRedirectURIs: []string{testLogoutURL},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return op, pc
+}
+
+func newTestRegistry(t *testing.T, transport http.RoundTripper, providers ...configs.AuthProviderConfig) *Registry {
+	t.Helper()
+	reg, err := NewRegistry(&configs.ConfigList{
+		AuthProviders:     providers,
+		OIDCCookieKey:     base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		CookieSessionName: "session_id",
+		CookieCSRFName:    "csrf_token",
+		CookiePath:        "/",
+		CookieSameSite:    http.SameSiteLaxMode,
+	}, transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reg
+}
+
+// Run を開始し、全てのIdPの初期化を待つ
+func runRegistry(t *testing.T, reg *Registry) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go reg.Run(ctx)
+
+	for _, p := range reg.Providers() {
+		waitReady(t, p, 5*time.Second)
+	}
+}
+
+func waitReady(t *testing.T, p *Provider, timeout time.Duration) {
+	t.Helper()
+	for deadline := time.Now().Add(timeout); p.relyingParty() == nil; {
+		if time.Now().After(deadline) {
+			t.Fatalf("provider %s not ready", p.ID)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// OPでユーザーを選び、認可コードをトークンに交換する（ブラウザを経由するログインの代わり）
+func issueTokens(t *testing.T, op *devoidc.Provider, pc configs.AuthProviderConfig) session.IdPTokens {
+	t.Helper()
+	w := httptest.NewRecorder()
+	op.ServeHTTP(w, formRequest("/authorize", url.Values{
+		"client_id":     {pc.ClientID},
+		"redirect_uri":  {pc.RedirectURL},
+		"response_type": {"code"},
+		"scope":         {strings.Join(pc.Scopes, " ")},
+		"sub":           {"dev-alice"},
+	}))
+	loc, err := url.Parse(w.Header().Get("Location"))
+	if err != nil || w.Code != http.StatusFound {
+		t.Fatalf("authorize = %d %s", w.Code, w.Header().Get("Location"))
+	}
+
+	w = httptest.NewRecorder()
+	op.ServeHTTP(w, formRequest("/token", url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {loc.Query().Get("code")},
+		"redirect_uri":  {pc.RedirectURL},
+		"client_id":     {pc.ClientID},
+		"client_secret": {pc.ClientSecret},
+	}))
+	var res struct {
+		RefreshToken string `json:"refresh_token"`
+		IDToken      string `json:"id_token"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.RefreshToken == "" || res.IDToken == "" {
+		t.Fatalf("token = %d %s", w.Code, w.Body)
+	}
+	return session.IdPTokens{RefreshToken: res.RefreshToken, IDToken: res.IDToken}
+}
+
+func formRequest(path string, form url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	op, pc := newTestOP(t, "dev", testIssuer)
+	reg := newTestRegistry(t, op.Transport(nil), pc)
+	runRegistry(t, reg)
+	tokens := issueTokens(t, op, pc)
+
+	logoutURL := reg.Logout(ctx, pc.ID, tokens, testLogoutURL)
+	u, err := url.Parse(logoutURL)
+	if err != nil || !strings.HasPrefix(logoutURL, testIssuer+"/end_session?") {
+		t.Fatalf("logout url = %q", logoutURL)
+	}
+	q := u.Query()
+	if q.Get("id_token_hint") != tokens.IDToken || q.Get("client_id") != pc.ClientID || q.Get("post_logout_redirect_uri") != testLogoutURL {
+		t.Errorf("end_session query = %v", q)
+	}
+
+	// refresh tokenはIdPで失効している
+	if _, revoked, err := reg.Refresh(ctx, pc.ID, tokens); !revoked || err != nil {
+		t.Errorf("refresh after logout = revoked %v, %v", revoked, err)
+	}
+
+	// IDトークンが無い・IdPが不明な場合はIdPからログアウトしない
+	if got := reg.Logout(ctx, pc.ID, session.IdPTokens{RefreshToken: "rt"}, testLogoutURL); got != "" {
+		t.Errorf("logout without id token = %q", got)
+	}
+	if got := reg.Logout(ctx, "unknown", tokens, testLogoutURL); got != "" {
+		t.Errorf("logout with unknown provider = %q", got)
+	}
+
+	// 遷移先が未設定の場合は post_logout_redirect_uri を付けない
+	u, _ = url.Parse(reg.Logout(ctx, pc.ID, tokens, ""))
+	if u == nil || u.Query().Has("post_logout_redirect_uri") || u.Query().Get("id_token_hint") == "" {
+		t.Errorf("logout url without redirect = %v", u)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+	op, pc := newTestOP(t, "dev", testIssuer)
+	transport := &failingTransport{base: op.Transport(nil)}
+	reg := newTestRegistry(t, transport, pc)
+	runRegistry(t, reg)
+	tokens := issueTokens(t, op, pc)
+
+	renewed, revoked, err := reg.Refresh(ctx, pc.ID, tokens)
+	if err != nil || revoked || renewed.RefreshToken == "" || renewed.RefreshToken == tokens.RefreshToken || renewed.IDToken == "" {
+		t.Fatalf("Refresh = %+v, revoked %v, %v", renewed, revoked, err)
+	}
+
+	// 再発行で使えなくなったrefresh tokenは失効扱い
+	if _, revoked, err := reg.Refresh(ctx, pc.ID, tokens); !revoked || err != nil {
+		t.Errorf("refresh with rotated token = revoked %v, %v", revoked, err)
+	}
+
+	// IdPに到達できない場合は失効と判断しない
+	transport.fail.Store(true)
+	if _, revoked, err := reg.Refresh(ctx, pc.ID, renewed); revoked || err == nil {
+		t.Errorf("refresh while idp down = revoked %v, %v", revoked, err)
+	}
+	transport.fail.Store(false)
+	if _, revoked, err := reg.Refresh(ctx, pc.ID, renewed); revoked || err != nil {
+		t.Errorf("refresh after idp recovered = revoked %v, %v", revoked, err)
+	}
+
+	if _, _, err := reg.Refresh(ctx, "unknown", renewed); err == nil {
+		t.Error("refresh with unknown provider succeeded")
+	}
+}
@@ -2,85 +2,113 @@ package auth
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/zitadel/oidc/v3/pkg/client"
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
 	httphelper "github.com/zitadel/oidc/v3/pkg/http"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"golang.org/x/oauth2"
 )
 
-type OIDC struct {
-	RP rp.RelyingParty
-}
-
-// Issuer / ClientID / Secret / RedirectURL / CookieKeyなど、OIDCに関わる設定を反映した
-// rp.RelyingParty インスタンス を作成し、それをラップした *OIDC 構造体を返す
-func NewOIDCFromEnv(cfg *configs.ConfigList) (*OIDC, error) {
-	issuer := cfg.OIDCGoogleIssuer
-	clientID := cfg.OIDCGoogleClientID
-	clientSecret := cfg.OIDCGoogleClientSecret
-	enablePKCE := cfg.OIDCGoogleEnablePKCE
-	redirectURL := cfg.OIDCRedirectURL
-	cookieKeyB64 := cfg.OIDCCookieKey
-	cookieKey, err := base64.StdEncoding.DecodeString(cookieKeyB64)
-
-	if err != nil {
-		return nil, err
-	}
-
-	// state 値や PKCE の code_verifier, セッション情報を暗号化・署名付き Cookieとして保管
-	// SPA + API構成なので、SameSite:None + Secure設定にする（おそらく必須）
-	cookieHandler := httphelper.NewCookieHandler(
-		cookieKey,
-		cookieKey,
-		httphelper.WithSameSite(http.SameSiteNoneMode), // None + Secure
-		// httphelper.WithUnsecure(), // HTTPS対応したら削除
-	)
-
-	// HTTPクライアント準備
-	httpClient := &http.Client{
-		Timeout: time.Minute, // OIDCメタデータ/トークン/ユーザー情報などのHTTP呼び出しに使用
-	}
-
+// Issuer / ClientID / Secret / RedirectURLなど、IdPに関わる設定を反映した *Provider を返す
+// rp.RelyingParty はIdPとの通信が必要なため、ここでは作成せず初期化処理（init）のみ用意する（Registry.Run で実行）
+func newProvider(pc configs.AuthProviderConfig, httpClient *http.Client, cookieHandler *httphelper.CookieHandler, cache *docCache) (*Provider, error) {
 	// ロガーの準備
-	logger := slog.Default().With("module", "oidc")
+	logger := slog.Default().With("module", "oidc", "provider", pc.ID)
 
 	// RPオプション
 	options := []rp.Option{
 		rp.WithCookieHandler(cookieHandler),                         // state/nonce/PKCEの保存
 		rp.WithVerifierOpts(rp.WithIssuedAtOffset(5 * time.Second)), // iat許容オフセット
-		rp.WithHTTPClient(httpClient),                               // 上で作成したHTTPクライアント
+		rp.WithHTTPClient(httpClient),                               // OIDCメタデータ/トークン/ユーザー情報などのHTTP呼び出しに使用
 		rp.WithLogger(logger),                                       // ロガーを組み込み
-		rp.WithSigningAlgsFromDiscovery(),                           // OPのdiscoveryから署名アルゴリズム取得
-		// rp.WithPKCE(cookieHandler),                                  // PKCE対応
 	}
 
-	// // clientSecretが空ならpublic client扱い → PKCE必須（セキュリティ向上）
-	// if clientSecret == "" {
-	// 	options = append(options, rp.WithPKCE(cookieHandler))
-	// }
-
 	// PKCEを有効化
-	if enablePKCE {
+	if pc.EnablePKCE {
 		options = append(options, rp.WithPKCE(cookieHandler))
 	}
 
-	// RP(NewRelyingPartyOIDC)を作成
-	rpClient, err := rp.NewRelyingPartyOIDC(
-		context.Background(),
-		issuer,
-		clientID,
-		clientSecret,
-		redirectURL,
-		[]string{oidc.ScopeOpenID, oidc.ScopeEmail, oidc.ScopeProfile},
-		options...,
-	)
-	if err != nil {
-		return nil, err
+	p := &Provider{
+		ID:          pc.ID,
+		DisplayName: pc.DisplayName,
+		wake:        make(chan struct{}, 1),
+		cookies:     newRPCookies(pc.ID),
+	}
+	for k, v := range pc.AuthParams {
+		p.authParams = append(p.authParams, rp.WithURLParam(k, v))
+	}
+
+	switch pc.Type {
+	case configs.AuthProviderTypeOIDC:
+		// RP(NewRelyingPartyOIDC)を作成（OPのdiscoveryから署名アルゴリズム取得）
+		options = append(options, rp.WithSigningAlgsFromDiscovery())
+		p.init = func(ctx context.Context) (rp.RelyingParty, error) {
+			// discoveryとJWKSを取得しておき、以降IdPが不調でも取得済みの内容で処理を続けられるようにする
+			cache.allow(strings.TrimSuffix(pc.Issuer, "/") + oidc.DiscoveryEndpoint)
+			discovery, err := client.Discover(ctx, pc.Issuer, httpClient)
+			if err != nil {
+				return nil, err
+			}
+			if err := cache.prefetch(ctx, httpClient, discovery.JwksURI); err != nil {
+				return nil, err
+			}
+
+			return rp.NewRelyingPartyOIDC(
+				ctx,
+				pc.Issuer,
+				pc.ClientID,
+				pc.ClientSecret,
+				pc.RedirectURL,
+				pc.Scopes,
+				options...,
+			)
+		}
+		p.identify = identifyByIDToken(pc.ID)
+
+	case configs.AuthProviderTypeGitHub:
+		// IDトークンが無いため、アクセストークンでユーザー情報APIから本人確認する
+		// discoveryが無いため、初期化でIdPとの通信は発生しない
+		gh := newGitHubAdapter(httpClient)
+		p.init = func(context.Context) (rp.RelyingParty, error) {
+			return rp.NewRelyingPartyOAuth(&oauth2.Config{
+				ClientID:     pc.ClientID,
+				ClientSecret: pc.ClientSecret,
+				RedirectURL:  pc.RedirectURL,
+				Scopes:       pc.Scopes,
+				Endpoint:     gh.endpoint,
+			}, options...)
+		}
+		p.identify = gh.identify(pc.ID)
+
+	default:
+		return nil, errors.New("unknown auth provider type: " + pc.Type)
+	}
+
+	return p, nil
+}
+
+// IDトークンのクレームからユーザーを特定する（OIDC）
+func identifyByIDToken(providerID string) identifyFunc {
+	return func(_ context.Context, tokens *oidc.Tokens[*oidc.IDTokenClaims]) (*Identity, error) {
+		claims := tokens.IDTokenClaims
+		if claims == nil {
+			return nil, errors.New("id token missing")
+		}
+
+		return &Identity{
+			Provider:      providerID,
+			Issuer:        claims.Issuer,
+			Subject:       claims.Subject,
+			Email:         claims.Email,
+			EmailVerified: bool(claims.EmailVerified),
+			Name:          claims.Name,
+		}, nil
 	}
-	return &OIDC{RP: rpClient}, nil
 }
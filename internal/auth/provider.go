@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+// Identity はIdPで本人確認できたユーザー
+type Identity struct {
+	Provider      string // IdPの識別子（AUTH_PROVIDERS）
+	Issuer        string // users.oidc_iss に保存する値（GitHubは https://github.com）
+	Subject       string // IdP内で不変のユーザーID
+	Email         string
+	EmailVerified bool
+	Name          string
+
+	// IdPから受け取ったトークン（セッションに暗号化して保存する。連携の保留中のCookieには含めない）
+	RefreshToken string `json:"-"`
+	IDToken      string `json:"-"`
+}
+
+// ログイン完了時に呼ぶ処理（ユーザー登録・セッション発行等）
+type LoginFunc func(w http.ResponseWriter, r *http.Request, id *Identity)
+
+// トークンレスポンスからユーザーを特定する（IdPの種類ごとに実装する）
+type identifyFunc func(ctx context.Context, tokens *oidc.Tokens[*oidc.IDTokenClaims]) (*Identity, error)
+
+// IdPの初期化（discovery等）の再試行間隔
+const (
+	initRetryMin     = 2 * time.Second // 失敗直後・ログイン要求による再試行の最短間隔
+	initRetryMax     = 5 * time.Minute
+	initAttemptLimit = 30 * time.Second // 1回の初期化の上限
+)
+
+// Provider はログインに使うIdP 1件
+// rp.RelyingParty はIdPとの通信（discovery）に成功してから作成するため、それまではログインできない（Ready が false）
+type Provider struct {
+	ID          string
+	DisplayName string
+	identify    identifyFunc
+	authParams  []rp.URLParamOpt // 認可リクエストに追加するパラメータ（AuthParams）
+	init        func(ctx context.Context) (rp.RelyingParty, error)
+	wake        chan struct{} // 初期化前のログイン要求で、再試行の待機を打ち切る
+	cookies     rpCookies     // state・PKCEのCookie名（IdPごとに分ける）
+
+	mu sync.RWMutex
+	rp rp.RelyingParty // 初期化前はnil
+}
+
+// Ready はログインに使えるか（初期化済みか）を返す
+// 初期化前の場合は、待機中の再試行を早める
+func (p *Provider) Ready() bool {
+	if p.relyingParty() != nil {
+		return true
+	}
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return false
+}
+
+// 初期化済みの rp.RelyingParty（初期化前はnil）
+func (p *Provider) relyingParty() rp.RelyingParty {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.rp
+}
+
+// Login は認可リクエスト URL を生成してユーザーを IdP にリダイレクトする
+// stateの生成や、cookieへの保存、認可リクエストURLの生成、http.Redirect(w, r, authURL, 302) を実行
+// 初期化前の場合は503を返す（呼び出し側で Ready を確認しておくこと）
+func (p *Provider) Login(w http.ResponseWriter, r *http.Request) {
+	relyingParty := p.relyingParty()
+	if relyingParty == nil {
+		WriteNotReady(w)
+		return
+	}
+
+	genState := func() string {
+		return uuid.New().String()
+	}
+	rp.AuthURLHandler(genState, relyingParty, p.authParams...)(p.cookies.writer(w), r)
+}
+
+// Callback は認可コードをトークンに交換し、本人確認できた場合に onLogin を呼ぶ
+// state・PKCEの検証やトークン交換の失敗時は、RPがエラーレスポンスを返す
+func (p *Provider) Callback(w http.ResponseWriter, r *http.Request, onLogin LoginFunc) {
+	lg := slog.Default().With("module", "auth", "provider", p.ID)
+
+	relyingParty := p.relyingParty()
+	if relyingParty == nil {
+		WriteNotReady(w)
+		return
+	}
+
+	callback := func(
+		w http.ResponseWriter,
+		r *http.Request,
+		tokens *oidc.Tokens[*oidc.IDTokenClaims],
+		_ string,
+		_ rp.RelyingParty,
+	) {
+		id, err := p.identify(r.Context(), tokens)
+		if err != nil {
+			http.Error(w, "identity lookup failed", http.StatusBadGateway)
+			lg.Error("identify user failed", "err", err)
+			return
+		}
+		if id.Issuer == "" || id.Subject == "" {
+			http.Error(w, "identity lookup failed", http.StatusBadGateway)
+			lg.Error("identity without issuer or subject")
+			return
+		}
+		id.RefreshToken = tokens.RefreshToken
+		id.IDToken = tokens.IDToken
+		onLogin(w, r, id)
+	}
+
+	rp.CodeExchangeHandler(callback, relyingParty)(p.cookies.writer(w), p.cookies.request(r))
+}
+
+// 初期化に成功するまで、間隔を空けながら再試行する
+func (p *Provider) initLoop(ctx context.Context) {
+	lg := slog.Default().With("module", "auth", "provider", p.ID)
+
+	backoff := initRetryMin
+	for {
+		attemptCtx, cancel := context.WithTimeout(ctx, initAttemptLimit)
+		relyingParty, err := p.init(attemptCtx)
+		cancel()
+
+		if err == nil {
+			p.mu.Lock()
+			p.rp = relyingParty
+			p.mu.Unlock()
+			lg.Info("identity provider ready")
+			return
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		lg.Warn("identity provider init failed", "err", err, "retryIn", backoff)
+
+		// 最短間隔までは待ち、その後はログイン要求があれば待たずに再試行する
+		if !sleep(ctx, initRetryMin) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff - initRetryMin):
+		case <-p.wake:
+		}
+
+		backoff = min(backoff*2, initRetryMax)
+	}
+}
+
+// dだけ待つ（ctxが終了した場合はfalse）
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// WriteNotReady は初期化前のIdPへのログイン要求に503を返す
+func WriteNotReady(w http.ResponseWriter) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(initRetryMin.Seconds())))
+	http.Error(w, "identity provider not ready", http.StatusServiceUnavailable)
+}
+
+// Registry は設定されたIdPの一覧（AUTH_PROVIDERS の順）
+type Registry struct {
+	providers []*Provider
+	cookies   *httphelper.CookieHandler // state・PKCE・アカウント連携の暗号化Cookie
+}
+
+// AUTH_PROVIDERS の全てのIdPを準備する（IdPとの通信は Run で行うため、IdPが停止していても失敗しない）
+// transport はIdPとの通信に使う（nilの場合は http.DefaultTransport。開発用のOPはプロセス内で処理する）
+func NewRegistry(cfg *configs.ConfigList, transport http.RoundTripper) (*Registry, error) {
+	if len(cfg.AuthProviders) == 0 {
+		return nil, errors.New("no auth providers configured")
+	}
+
+	cookieKey, err := base64.StdEncoding.DecodeString(cfg.OIDCCookieKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// state 値や PKCE の code_verifier, セッション情報を暗号化・署名付き Cookieとして保管
+	// 全てのIdPで共有するが、state・PKCEのCookie名はIdPごとに分ける（rpCookies）
+	// 属性（Secure・SameSite等）はセッションのCookieと同じ設定を使う
+	cookieHandler := httphelper.NewCookieHandler(
+		cookieKey,
+		cookieKey,
+		cookies.NewPolicy(cfg).OIDCOptions()...,
+	)
+
+	// HTTPクライアント準備（全てのIdPで共有。discovery・JWKSは直近の取得結果を保持する）
+	cache := newDocCache(transport)
+	httpClient := &http.Client{
+		Timeout:   time.Minute,
+		Transport: cache,
+	}
+
+	reg := &Registry{cookies: cookieHandler}
+	for _, pc := range cfg.AuthProviders {
+		p, err := newProvider(pc, httpClient, cookieHandler, cache)
+		if err != nil {
+			return nil, err
+		}
+		reg.providers = append(reg.providers, p)
+	}
+	return reg, nil
+}
+
+// Run は全てのIdPを初期化する（失敗したIdPは成功するまで再試行する）。ctx終了で停止する
+// 初期化前のIdPのログインは503になるが、ログイン済みのユーザーやその他のAPIには影響しない
+func (reg *Registry) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, p := range reg.providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.initLoop(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// 識別子でIdPを取得する（無い場合はnil）
+func (reg *Registry) Get(id string) *Provider {
+	for _, p := range reg.providers {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// 既定のIdP（AUTH_PROVIDERS の先頭。provider未指定のログイン・従来のコールバックURLで使う）
+func (reg *Registry) Default() *Provider {
+	return reg.providers[0]
+}
+
+// 全てのIdP（AUTH_PROVIDERS の順）
+func (reg *Registry) Providers() []*Provider {
+	return reg.providers
+}
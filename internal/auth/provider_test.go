@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/devoidc"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+// testIdP は開発用のOPをhttptestで公開するIdP（障害を再現できる）
+type testIdP struct {
+	op *devoidc.Provider
+	pc configs.AuthProviderConfig
+
+	down           atomic.Bool // 全てのリクエストに503を返す
+	failAfterFirst bool        // discovery・JWKSは最初の取得の後は503を返す
+
+	mu     sync.Mutex
+	served map[string]int // パスごとのリクエスト数
+}
+
+func newTestIdP(t *testing.T, failAfterFirst bool) *testIdP {
+	t.Helper()
+	idp := &testIdP{failAfterFirst: failAfterFirst, served: make(map[string]int)}
+	srv := httptest.NewServer(idp)
+	t.Cleanup(srv.Close)
+	idp.op, idp.pc = newTestOP(t, "dev", srv.URL+"/oidc")
+	return idp
+}
+
+func (idp *testIdP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/oidc")
+	idp.mu.Lock()
+	idp.served[path]++
+	n := idp.served[path]
+	idp.mu.Unlock()
+
+	document := path == oidc.DiscoveryEndpoint || path == "/keys"
+	if idp.down.Load() || (idp.failAfterFirst && document && n > 1) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	http.StripPrefix("/oidc", idp.op).ServeHTTP(w, r)
+}
+
+func (idp *testIdP) count(path string) int {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return idp.served[path]
+}
+
+// 初期化後にdiscovery・JWKSが取得できなくなっても、取得済みの鍵でIDトークンを検証できる
+func TestProviderServesCachedDocuments(t *testing.T) {
+	idp := newTestIdP(t, true)
+	reg := newTestRegistry(t, nil, idp.pc)
+	runRegistry(t, reg)
+	tokens := issueTokens(t, idp.op, idp.pc)
+
+	// 再発行されたIDトークンの検証でJWKSを取得する（IdPは503を返す）
+	renewed, revoked, err := reg.Refresh(context.Background(), idp.pc.ID, tokens)
+	if err != nil || revoked || renewed.IDToken == "" {
+		t.Fatalf("Refresh = %+v, revoked %v, %v", renewed, revoked, err)
+	}
+	if n := idp.count("/keys"); n < 2 {
+		t.Errorf("jwks fetched %d times, want a refetch served from the cache", n)
+	}
+
+}
+
+// IdPが停止している間はログインに503を返し、復旧後の再試行で初期化する
+func TestProviderInitRetries(t *testing.T) {
+	idp := newTestIdP(t, false)
+	idp.down.Store(true)
+	reg := newTestRegistry(t, nil, idp.pc)
+	p := reg.Default()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reg.Run(ctx)
+
+	for deadline := time.Now().Add(5 * time.Second); idp.count(oidc.DiscoveryEndpoint) == 0; {
+		if time.Now().After(deadline) {
+			t.Fatal("discovery not requested")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	w := httptest.NewRecorder()
+	p.Login(w, httptest.NewRequest(http.MethodGet, "/v1/auth/login", nil))
+	if w.Code != http.StatusServiceUnavailable || w.Header().Get("Retry-After") == "" {
+		t.Errorf("login before init = %d %v", w.Code, w.Header())
+	}
+	if p.Ready() {
+		t.Fatal("provider ready while idp down")
+	}
+
+	// 復旧後は最短間隔の後、ログイン要求（Ready）で待たずに再試行する
+	idp.down.Store(false)
+	for deadline := time.Now().Add(initRetryMin + 5*time.Second); !p.Ready(); {
+		if time.Now().After(deadline) {
+			t.Fatal("provider not ready after the idp recovered")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	w = httptest.NewRecorder()
+	p.Login(w, httptest.NewRequest(http.MethodGet, "/v1/auth/login", nil))
+	if w.Code != http.StatusFound || !strings.HasPrefix(w.Header().Get("Location"), idp.pc.Issuer+"/authorize?") {
+		t.Errorf("login after init = %d %s", w.Code, w.Header().Get("Location"))
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// rp（zitadel/oidc）が state・PKCE の保存に使うCookie名（固定）
+var rpCookieNames = []string{"state", "pkce"}
+
+// rpCookies はrpのCookie名にIdPごとの接頭辞を付ける
+// Cookie名が固定のままでは、別のIdPへのログインを同時に開始した場合（複数のタブ等）に
+// 後から開始した方のstate・PKCEで上書きされ、先に開始した方のコールバックが失敗するため
+type rpCookies struct {
+	prefix string
+}
+
+func newRPCookies(providerID string) rpCookies {
+	return rpCookies{prefix: "oidc_" + providerID + "_"}
+}
+
+// レスポンスで発行・削除するrpのCookieの名前に接頭辞を付ける
+func (c rpCookies) writer(w http.ResponseWriter) http.ResponseWriter {
+	return &prefixedCookieWriter{ResponseWriter: w, prefix: c.prefix}
+}
+
+// このIdPのCookieのみ、接頭辞を外した名前でrpに渡す（他のIdPのstate・PKCEは渡さない）
+func (c rpCookies) request(r *http.Request) *http.Request {
+	inner := r.Clone(r.Context())
+	inner.Header.Del("Cookie")
+	for _, ck := range r.Cookies() {
+		if slices.Contains(rpCookieNames, ck.Name) {
+			continue
+		}
+		if name, ok := strings.CutPrefix(ck.Name, c.prefix); ok && slices.Contains(rpCookieNames, name) {
+			ck.Name = name
+		}
+		inner.AddCookie(ck)
+	}
+	return inner
+}
+
+type prefixedCookieWriter struct {
+	http.ResponseWriter
+	prefix  string
+	renamed bool
+}
+
+// ヘッダの送信前に Set-Cookie の名前を書き換える
+func (w *prefixedCookieWriter) rename() {
+	if w.renamed {
+		return
+	}
+	w.renamed = true
+
+	values := w.Header()["Set-Cookie"]
+	for i, v := range values {
+		if name, rest, ok := strings.Cut(v, "="); ok && slices.Contains(rpCookieNames, name) {
+			values[i] = w.prefix + name + "=" + rest
+		}
+	}
+}
+
+func (w *prefixedCookieWriter) WriteHeader(code int) {
+	w.rename()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *prefixedCookieWriter) Write(b []byte) (int, error) {
+	w.rename()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *prefixedCookieWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/devoidc"
+)
+
+// 別のIdPへのログインを同時に開始しても、それぞれのコールバックが成功する
+func TestConcurrentLoginsToDifferentProviders(t *testing.T) {
+	opA, pcA := newTestOP(t, "idp-a", "https://idp-a.test/oidc")
+	opB, pcB := newTestOP(t, "idp-b", "https://idp-b.test/oidc")
+	pcA.EnablePKCE, pcB.EnablePKCE = true, true
+	reg := newTestRegistry(t, opA.Transport(opB.Transport(nil)), pcA, pcB)
+	runRegistry(t, reg)
+
+	// ブラウザの代わり
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, _ := url.Parse("https://app.test/")
+	send := func(handle func(w http.ResponseWriter, r *http.Request), target string) *http.Response {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		for _, c := range jar.Cookies(app) {
+			r.AddCookie(c)
+		}
+		w := httptest.NewRecorder()
+		handle(w, r)
+		res := w.Result()
+		jar.SetCookies(app, res.Cookies())
+		return res
+	}
+
+	logins := []struct {
+		op      *devoidc.Provider
+		pc      configs.AuthProviderConfig
+		authURL *url.URL
+	}{{op: opA, pc: pcA}, {op: opB, pc: pcB}}
+
+	// 1. 両方のIdPへのログインを開始する（state・PKCEのCookieが2組発行される）
+	for i, l := range logins {
+		res := send(reg.Get(l.pc.ID).Login, "https://app.test/v1/auth/login?provider="+l.pc.ID)
+		u, err := res.Location()
+		if err != nil || res.StatusCode != http.StatusFound {
+			t.Fatalf("login %s = %d", l.pc.ID, res.StatusCode)
+		}
+		logins[i].authURL = u
+	}
+	names := map[string]bool{}
+	for _, c := range jar.Cookies(app) {
+		names[c.Name] = true
+	}
+	for _, name := range []string{"oidc_idp-a_state", "oidc_idp-a_pkce", "oidc_idp-b_state", "oidc_idp-b_pkce"} {
+		if !names[name] {
+			t.Errorf("cookie %s missing: %v", name, names)
+		}
+	}
+
+	// 2. 先に開始した方から順にIdPで認可し、コールバックを受ける
+	for _, l := range logins {
+		form := l.authURL.Query()
+		form.Set("sub", "dev-alice")
+		w := httptest.NewRecorder()
+		l.op.ServeHTTP(w, formRequest("/authorize", form))
+		if w.Code != http.StatusFound {
+			t.Fatalf("authorize %s = %d %s", l.pc.ID, w.Code, w.Body)
+		}
+
+		var got *Identity
+		res := send(func(w http.ResponseWriter, r *http.Request) {
+			reg.Get(l.pc.ID).Callback(w, r, func(w http.ResponseWriter, _ *http.Request, id *Identity) {
+				got = id
+				w.WriteHeader(http.StatusNoContent)
+			})
+		}, w.Header().Get("Location"))
+		if got == nil || got.Provider != l.pc.ID || got.Subject != "dev-alice" {
+			t.Errorf("callback %s = %d, identity %+v", l.pc.ID, res.StatusCode, got)
+		}
+	}
+
+	// コールバックで使ったCookieは削除されている
+	for _, c := range jar.Cookies(app) {
+		t.Errorf("cookie %s left after callbacks", c.Name)
+	}
+}
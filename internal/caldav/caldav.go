@@ -0,0 +1,274 @@
+package caldav
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// リクエストボディの最大サイズ
+	maxBodyBytes = 1 << 20
+
+	// アプリパスワードの最終利用日時を記録する間隔
+	touchInterval = time.Minute
+
+	// PUTでの更新時のminkan更新元
+	origin = "caldav"
+)
+
+// Handler はMinkanのタスクをCalDAV(RFC 4791)で公開する
+// プロジェクトごとに1つのカレンダーコレクションとし、各ノードをVTODOとして返す
+// 認証はアプリパスワードのBasic認証のみ（OIDCのCookieセッションはCalDAVクライアントでは使えないため）
+// ETagはminkan_statesのversionから作るため、どのノードを更新しても全リソースのETagが変わる
+type Handler struct {
+	Prefix       string // マウント先のパス（例 "/caldav"）
+	Store        *minkan.Store
+	StateRepo    *repository.MinkanStatesRepository
+	UserRepo     *repository.UserRepository
+	AppPasswords *repository.AppPasswordRepository
+	NodeURL      func(pjID, nodeID string) string // VTODOのURLに載せるアプリのURL
+}
+
+func NewHandler(
+	prefix string,
+	store *minkan.Store,
+	stateRepo *repository.MinkanStatesRepository,
+	userRepo *repository.UserRepository,
+	appPasswords *repository.AppPasswordRepository,
+	nodeURL func(pjID, nodeID string) string,
+) *Handler {
+	return &Handler{
+		Prefix:       strings.TrimRight(prefix, "/"),
+		Store:        store,
+		StateRepo:    stateRepo,
+		UserRepo:     userRepo,
+		AppPasswords: appPasswords,
+		NodeURL:      nodeURL,
+	}
+}
+
+// リソースの種類
+type kind int
+
+const (
+	kindRoot      kind = iota // /caldav/
+	kindPrincipal             // /caldav/principal/
+	kindHome                  // /caldav/calendars/（calendar-home-set）
+	kindCalendar              // /caldav/calendars/{pjId}/
+	kindObject                // /caldav/calendars/{pjId}/{nodeId}.ics
+)
+
+// target はリクエスト先のリソース
+type target struct {
+	kind   kind
+	pjID   string
+	nodeID string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("module", "caldav", "method", r.Method, "path", r.URL.Path)
+
+	t, ok := h.parsePath(r.URL.EscapedPath())
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// クライアントは認証前にOPTIONSで対応状況を確認するため、OPTIONSは認証不要
+	if r.Method == http.MethodOptions {
+		writeOptions(w)
+		return
+	}
+
+	userID, ok, err := h.authenticate(r.Context(), r)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("authenticate error", "err", err)
+		return
+	}
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Minkan CalDAV", charset="UTF-8"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	lg = lg.With("userID", userID)
+
+	if r.Body != nil {
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+		defer func() {
+			if err := r.Body.Close(); err != nil {
+				lg.Error("failed to close request body", "err", err)
+			}
+		}()
+	}
+
+	switch r.Method {
+	case "PROPFIND":
+		h.propfind(w, r, lg, userID, t)
+	case "REPORT":
+		h.report(w, r, lg, userID, t)
+	case http.MethodGet, http.MethodHead:
+		h.get(w, r, lg, userID, t)
+	case http.MethodPut:
+		h.put(w, r, lg, userID, t)
+	case http.MethodDelete, "MKCOL", "MKCALENDAR", "PROPPATCH", "MOVE", "COPY", "LOCK", "UNLOCK":
+		// タスクの作成・削除やカレンダーの変更はアプリ側で行う
+		http.Error(w, "forbidden", http.StatusForbidden)
+	default:
+		w.Header().Set("Allow", allowMethods)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+const allowMethods = "OPTIONS, GET, HEAD, PUT, PROPFIND, REPORT"
+
+func writeOptions(w http.ResponseWriter) {
+	w.Header().Set("DAV", "1, 3, calendar-access")
+	w.Header().Set("Allow", allowMethods)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Basic認証のパスワードをアプリパスワードとして照合する（ユーザー名は照合しない）
+func (h *Handler) authenticate(ctx context.Context, r *http.Request) (int64, bool, error) {
+	_, password, ok := r.BasicAuth()
+	if !ok || password == "" {
+		return 0, false, nil
+	}
+
+	p, err := h.AppPasswords.FindAppPasswordByHash(ctx, token.Hash(password))
+	if err != nil {
+		return 0, false, err
+	}
+	if p == nil {
+		return 0, false, nil
+	}
+
+	if err := h.AppPasswords.TouchAppPassword(ctx, p.ID, time.Now(), touchInterval); err != nil {
+		slog.Default().With("module", "caldav").Warn("failed to update app password last used time", "err", err)
+	}
+	return p.UserID, true, nil
+}
+
+// Prefix以下のパス（エスケープ済み）をリソースに変換する
+func (h *Handler) parsePath(escapedPath string) (target, bool) {
+	rel, ok := strings.CutPrefix(escapedPath, h.Prefix)
+	if !ok {
+		return target{}, false
+	}
+	rel = strings.Trim(rel, "/")
+	if rel == "" {
+		return target{kind: kindRoot}, true
+	}
+
+	segs := strings.Split(rel, "/")
+	switch {
+	case len(segs) == 1 && segs[0] == "principal":
+		return target{kind: kindPrincipal}, true
+	case segs[0] != "calendars":
+		return target{}, false
+	case len(segs) == 1:
+		return target{kind: kindHome}, true
+	}
+
+	pjID, err := url.PathUnescape(segs[1])
+	if err != nil || pjID == "" {
+		return target{}, false
+	}
+	if len(segs) == 2 {
+		return target{kind: kindCalendar, pjID: pjID}, true
+	}
+
+	file, ok := strings.CutSuffix(segs[2], ".ics")
+	if len(segs) > 3 || !ok {
+		return target{}, false
+	}
+	nodeID, err := url.PathUnescape(file)
+	if err != nil || nodeID == "" {
+		return target{}, false
+	}
+	return target{kind: kindObject, pjID: pjID, nodeID: nodeID}, true
+}
+
+// リソースのパス（href）
+func (h *Handler) href(t target) string {
+	switch t.kind {
+	case kindPrincipal:
+		return h.Prefix + "/principal/"
+	case kindHome:
+		return h.Prefix + "/calendars/"
+	case kindCalendar:
+		return h.Prefix + "/calendars/" + url.PathEscape(t.pjID) + "/"
+	case kindObject:
+		return h.Prefix + "/calendars/" + url.PathEscape(t.pjID) + "/" + url.PathEscape(t.nodeID) + ".ics"
+	}
+	return h.Prefix + "/"
+}
+
+// ETagはstateのversionから作る
+func etag(version int32) string {
+	return `"` + strconv.FormatInt(int64(version), 10) + `"`
+}
+
+// If-Match・If-None-Matchのいずれかのエンティティタグと一致するか
+func etagMatches(header, tag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// ユーザーのstate（未登録の場合は空のstateとversion 0）
+func (h *Handler) load(ctx context.Context, userID int64) (*repository.Minkan, int32, error) {
+	state, m, err := h.loadState(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return m, state.Version, nil
+}
+
+// 保存されているstate（JSON）と、その内容を読み込んだもの
+// stateが無い場合は空のstate（version 0）
+func (h *Handler) loadState(ctx context.Context, userID int64) (*repository.MinkanState, *repository.Minkan, error) {
+	state, err := h.StateRepo.FindStateByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if state == nil {
+		return &repository.MinkanState{UserID: userID}, &repository.Minkan{Projects: repository.Projects{}}, nil
+	}
+
+	m, err := minkan.Decode(state.StateJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	return state, m, nil
+}
+
+// プロジェクトの一覧（表示順を安定させるため作成日時・ID順）
+func sortedProjects(m *repository.Minkan) []*repository.Project {
+	pjs := make([]*repository.Project, 0, len(m.Projects))
+	for id := range m.Projects {
+		pj := m.Projects[id]
+		pjs = append(pjs, &pj)
+	}
+	sort.Slice(pjs, func(i, j int) bool {
+		if !pjs[i].CreatedAt.Equal(pjs[j].CreatedAt) {
+			return pjs[i].CreatedAt.Before(pjs[j].CreatedAt)
+		}
+		return pjs[i].Id < pjs[j].Id
+	})
+	return pjs
+}
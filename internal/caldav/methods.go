@@ -0,0 +1,371 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/ical"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// 競合時にPUTをやり直す最大回数（If-Matchが無い場合のみ）
+const maxPutRetries = 5
+
+// リクエスト中で共通の情報を読み込む
+func (h *Handler) newPropContext(r *http.Request, userID int64) (*propContext, error) {
+	user, err := h.UserRepo.FindUserByUserID(r.Context(), userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		// アプリパスワードは退会時に削除されるため通常は起こらない
+		return nil, errors.New("user not found")
+	}
+
+	m, version, err := h.load(r.Context(), userID)
+	if err != nil {
+		return nil, err
+	}
+	return &propContext{h: h, user: user, m: m, version: version, now: time.Now()}, nil
+}
+
+// リソースのプロパティ（存在しない場合はfalse）
+func (pc *propContext) props(t target) ([]prop, bool) {
+	switch t.kind {
+	case kindRoot:
+		return pc.rootProps(), true
+	case kindPrincipal:
+		return pc.principalProps(), true
+	case kindHome:
+		return pc.homeProps(), true
+	}
+
+	pj, ok := pc.m.Projects[t.pjID]
+	if !ok {
+		return nil, false
+	}
+	if t.kind == kindCalendar {
+		return pc.calendarProps(&pj), true
+	}
+
+	idx := minkan.FindNode(&pj, t.nodeID)
+	if idx < 0 {
+		return nil, false
+	}
+	return pc.objectProps(&pj, &pj.Nodes[idx]), true
+}
+
+// 子リソース（Depth: 1 で返すもの）
+func (pc *propContext) children(t target) []target {
+	children := []target{}
+	switch t.kind {
+	case kindRoot:
+		children = append(children, target{kind: kindPrincipal}, target{kind: kindHome})
+	case kindHome:
+		for _, pj := range sortedProjects(pc.m) {
+			children = append(children, target{kind: kindCalendar, pjID: pj.Id})
+		}
+	case kindCalendar:
+		pj := pc.m.Projects[t.pjID]
+		for _, n := range pj.Nodes {
+			children = append(children, target{kind: kindObject, pjID: t.pjID, nodeID: n.Id})
+		}
+	}
+	return children
+}
+
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, lg *slog.Logger, userID int64, t target) {
+	req, err := parsePropfind(r.Body)
+	if err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode propfind error", "err", err)
+		return
+	}
+
+	pc, err := h.newPropContext(r, userID)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("load caldav resources error", "err", err)
+		return
+	}
+
+	props, ok := pc.props(t)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	responses := []response{{href: h.href(t), props: props}}
+
+	// Depth: infinity（既定）は1として扱う
+	if r.Header.Get("Depth") != "0" {
+		for _, child := range pc.children(t) {
+			props, _ := pc.props(child)
+			responses = append(responses, response{href: h.href(child), props: props})
+		}
+	}
+
+	if err := writeMultistatus(w, responses, req); err != nil {
+		lg.Error("failed to write multistatus", "err", err)
+	}
+}
+
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, lg *slog.Logger, userID int64, t target) {
+	var body reportBody
+	if err := decodeBody(r.Body, &body); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode report error", "err", err)
+		return
+	}
+
+	pc, err := h.newPropContext(r, userID)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("load caldav resources error", "err", err)
+		return
+	}
+
+	responses := []response{}
+
+	switch body.XMLName {
+	case xml.Name{Space: nsCalDAV, Local: "calendar-query"}:
+		if t.kind != kindCalendar {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if _, ok := pc.m.Projects[t.pjID]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		// VTODO以外のコンポーネントは無いため、VEVENT等の検索には空の結果を返す（time-range等の条件は無視する）
+		if body.Filter == nil || body.Filter.CompFilter.Name != "VCALENDAR" || onlyTodos(body.Filter.CompFilter.CompFilters) {
+			for _, child := range pc.children(t) {
+				props, _ := pc.props(child)
+				responses = append(responses, response{href: h.href(child), props: props})
+			}
+		}
+
+	case xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}:
+		for _, href := range body.Hrefs {
+			res := response{href: href, status: http.StatusNotFound}
+			if child, ok := h.parseHref(href); ok && child.kind == kindObject {
+				if props, ok := pc.props(child); ok {
+					res = response{href: h.href(child), props: props}
+				}
+			}
+			responses = append(responses, res)
+		}
+
+	default:
+		writeError(w, http.StatusForbidden, xml.Name{Space: nsDAV, Local: "supported-report"})
+		lg.Warn("unsupported report", "report", body.XMLName.Local)
+		return
+	}
+
+	if err := writeMultistatus(w, responses, body.request()); err != nil {
+		lg.Error("failed to write multistatus", "err", err)
+	}
+}
+
+// comp-filterがVTODOのみを対象としているか（指定が無い場合も含む）
+func onlyTodos(filters []struct {
+	Name string `xml:"name,attr"`
+}) bool {
+	for _, f := range filters {
+		if f.Name != "VTODO" {
+			return false
+		}
+	}
+	return true
+}
+
+// multiget等のhref（絶対URLの場合もある）をリソースに変換する
+func (h *Handler) parseHref(href string) (target, bool) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return target{}, false
+	}
+	return h.parsePath(u.EscapedPath())
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, lg *slog.Logger, userID int64, t target) {
+	if t.kind != kindObject {
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	pc, err := h.newPropContext(r, userID)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("load caldav resources error", "err", err)
+		return
+	}
+
+	pj, ok := pc.m.Projects[t.pjID]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	idx := minkan.FindNode(&pj, t.nodeID)
+	if idx < 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("ETag", etag(pc.version))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.WriteString(w, pc.objectData(&pj, &pj.Nodes[idx])); err != nil && r.Method != http.MethodHead {
+		lg.Error("failed to write calendar object", "err", err)
+	}
+}
+
+// errNotFound はPUT先のノードが存在しない（タスクの新規作成は受け付けない）
+var errNotFound = errors.New("calendar object not found")
+
+// VTODOの完了状態・タイトルをノードに反映する
+// - STATUS:COMPLETED（またはCOMPLETEDがありSTATUSが無い場合）でisDoneにし、カードを完了カラムへ移動する
+// - 完了を取り消した場合は、完了カラムにあるカードを進行中(IN-PROCESS)またはTODOカラムへ戻す
+// その他のプロパティ（日時・優先度等）の変更は反映しない
+func (h *Handler) put(w http.ResponseWriter, r *http.Request, lg *slog.Logger, userID int64, t target) {
+	if t.kind != kindObject {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	cal, err := ical.Decode(r.Body)
+	if err != nil || cal.Name != "VCALENDAR" || len(cal.Children("VTODO")) != 1 {
+		writeError(w, http.StatusForbidden, xml.Name{Space: nsCalDAV, Local: "valid-calendar-object-resource"})
+		lg.Warn("invalid calendar object", "err", err)
+		return
+	}
+	todo := cal.Children("VTODO")[0]
+
+	if uid := todo.Prop("UID"); uid == nil || ical.UnescapeText(uid.Value) != calendar.UID(t.pjID, t.nodeID) {
+		writeError(w, http.StatusForbidden, xml.Name{Space: nsCalDAV, Local: "no-uid-conflict"})
+		lg.Warn("calendar object UID mismatch")
+		return
+	}
+
+	ifMatch := r.Header.Get("If-Match")
+
+	for attempt := 0; ; attempt++ {
+		state, m, err := h.loadState(r.Context(), userID)
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("load minkan state error", "err", err)
+			return
+		}
+		version := state.Version
+
+		// 既存のノードの変更のみ受け付けるため、If-None-Match: * は常に失敗
+		if r.Header.Get("If-None-Match") == "*" || (ifMatch != "" && !etagMatches(ifMatch, etag(version))) {
+			http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+			return
+		}
+
+		changed, err := applyTodo(m, t.pjID, t.nodeID, todo)
+		if errors.Is(err, errNotFound) {
+			http.Error(w, "creating tasks is not supported", http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("apply calendar object error", "err", err)
+			return
+		}
+		if !changed {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		// 保存済みのJSONのうち、このノードに関わる項目のみを書き換える
+		stateJSON, err := patchTodo(state.StateJSON, m, t.pjID, t.nodeID)
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("patch minkan state error", "err", err)
+			return
+		}
+
+		newVersion, err := h.Store.Replace(r.Context(), userID, stateJSON, version, origin)
+		if errors.Is(err, repository.ErrOptimisticLock) {
+			if ifMatch != "" {
+				http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+				return
+			}
+			if attempt < maxPutRetries {
+				continue
+			}
+		}
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("update minkan state error", "err", err)
+			return
+		}
+
+		lg.Info("calendar object updated", "pjID", t.pjID, "nodeID", t.nodeID, "version", newVersion)
+
+		// 保存される内容は送られたVTODOと異なるため、ETagは返さない（クライアントに再取得させる）
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+}
+
+// VTODOの内容をノードに反映し、変更があったかを返す
+func applyTodo(m *repository.Minkan, pjID, nodeID string, todo *ical.Component) (bool, error) {
+	pj, ok := m.Projects[pjID]
+	if !ok {
+		return false, errNotFound
+	}
+	idx := minkan.FindNode(&pj, nodeID)
+	if idx < 0 {
+		return false, errNotFound
+	}
+	node := &pj.Nodes[idx]
+	changed := false
+
+	status := ""
+	if p := todo.Prop("STATUS"); p != nil {
+		status = strings.ToUpper(p.Value)
+	}
+	done := status == "COMPLETED" || (status == "" && todo.Prop("COMPLETED") != nil)
+
+	// CANCELLEDは完了状態を変えない
+	if status != "CANCELLED" && done != node.Data.IsDone {
+		node.Data.IsDone = done
+		changed = true
+
+		col := minkan.FindCardColumn(&m.KanbanColumns, pjID, nodeID)
+		switch {
+		case done:
+			minkan.MoveCard(m, pjID, nodeID, minkan.ColumnDone, nil)
+		case col == minkan.ColumnDone && status == "IN-PROCESS":
+			minkan.MoveCard(m, pjID, nodeID, minkan.ColumnDoing, nil)
+		case col == minkan.ColumnDone:
+			minkan.MoveCard(m, pjID, nodeID, minkan.ColumnTodo, nil)
+		}
+	}
+
+	if p := todo.Prop("SUMMARY"); p != nil {
+		if label := ical.UnescapeText(p.Value); label != "" && label != node.Data.Label {
+			node.Data.Label = label
+			changed = true
+		}
+	}
+
+	if changed {
+		pj.UpdatedAt = time.Now().UTC()
+		m.Projects[pjID] = pj
+	}
+	return changed, nil
+}
@@ -0,0 +1,158 @@
+package caldav
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// VTODOを反映したmのうち、ノード(pjID, nodeID)に関わる項目のみをstateJSONに書き戻す
+// - ノードの完了・ラベル、プロジェクトの更新日時
+// - 並びが変わったカンバンのカラムと、プロジェクトのカンバンの並び
+// それ以外（他のノード・プロジェクト、フロントエンドが追加した項目等）は保存済みのJSONのまま残す
+func patchTodo(stateJSON json.RawMessage, m *repository.Minkan, pjID, nodeID string) (json.RawMessage, error) {
+	before, err := minkan.Decode(stateJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	pj := m.Projects[pjID]
+	idx := minkan.FindNode(&pj, nodeID)
+	if idx < 0 {
+		return nil, errNotFound
+	}
+	node := pj.Nodes[idx]
+
+	top, err := object(stateJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// projects.<pjID>.nodes[<node>].data
+	projects, err := object(top["projects"])
+	if err != nil {
+		return nil, err
+	}
+	project, err := object(projects[pjID])
+	if err != nil {
+		return nil, err
+	}
+	var nodes []json.RawMessage
+	if err := json.Unmarshal(project["nodes"], &nodes); err != nil {
+		return nil, err
+	}
+	i := rawNodeIndex(nodes, nodeID)
+	if i < 0 {
+		return nil, errNotFound
+	}
+	rawNode, err := object(nodes[i])
+	if err != nil {
+		return nil, err
+	}
+	data, err := object(rawNode["data"])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := set(data, "isDone", node.Data.IsDone); err != nil {
+		return nil, err
+	}
+	if err := set(data, "label", node.Data.Label); err != nil {
+		return nil, err
+	}
+	if err := set(rawNode, "data", data); err != nil {
+		return nil, err
+	}
+	if nodes[i], err = json.Marshal(rawNode); err != nil {
+		return nil, err
+	}
+	if err := set(project, "nodes", nodes); err != nil {
+		return nil, err
+	}
+	if err := set(project, "updatedAt", pj.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := set(projects, pjID, project); err != nil {
+		return nil, err
+	}
+	if err := set(top, "projects", projects); err != nil {
+		return nil, err
+	}
+
+	// カンバンは並びが変わったカラムのみ
+	columns, err := object(top["kanbanColumns"])
+	if err != nil {
+		return nil, err
+	}
+	columnsChanged := false
+	for _, name := range minkan.Columns {
+		cards := *minkan.ColumnCards(&m.KanbanColumns, name)
+		if reflect.DeepEqual(cards, *minkan.ColumnCards(&before.KanbanColumns, name)) {
+			continue
+		}
+		if err := set(columns, name, cards); err != nil {
+			return nil, err
+		}
+		columnsChanged = true
+	}
+	if columnsChanged {
+		if err := set(top, "kanbanColumns", columns); err != nil {
+			return nil, err
+		}
+	}
+
+	if !reflect.DeepEqual(m.KanbanIndex[pjID], before.KanbanIndex[pjID]) {
+		index, err := object(top["kanbanIndex"])
+		if err != nil {
+			return nil, err
+		}
+		if err := set(index, pjID, m.KanbanIndex[pjID]); err != nil {
+			return nil, err
+		}
+		if err := set(top, "kanbanIndex", index); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(top)
+}
+
+// JSONオブジェクトを項目ごとに読み込む（nullや未設定の場合は空）
+func object(raw json.RawMessage) (map[string]json.RawMessage, error) {
+	obj := map[string]json.RawMessage{}
+	if len(raw) == 0 {
+		return obj, nil
+	}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, fmt.Errorf("unexpected minkan state shape: %w", err)
+	}
+	if obj == nil {
+		obj = map[string]json.RawMessage{}
+	}
+	return obj, nil
+}
+
+func set(obj map[string]json.RawMessage, key string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	obj[key] = b
+	return nil
+}
+
+// nodesのうちidがnodeIDのノードの位置（見つからない場合は-1）
+func rawNodeIndex(nodes []json.RawMessage, nodeID string) int {
+	for i, raw := range nodes {
+		var n struct {
+			ID string `json:"id"`
+		}
+		if json.Unmarshal(raw, &n) == nil && n.ID == nodeID {
+			return i
+		}
+	}
+	return -1
+}
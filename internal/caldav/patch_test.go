@@ -0,0 +1,134 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/internal/ical"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const patchState = `{
+  "currentPjId": "pj1",
+  "frontendOnly": {"theme": "dark"},
+  "kanbanColumns": {
+    "backlog": [],
+    "todo": [{"pjId": "pj1", "nodeId": "n1"}],
+    "doing": [{"pjId": "pj1", "nodeId": "n2", "pinned": true}],
+    "done": []
+  },
+  "kanbanIndex": {"pj1": ["n1", "n2"], "pj2": []},
+  "projects": {
+    "pj1": {
+      "id": "pj1",
+      "name": "Project",
+      "createdAt": "2025-01-01T00:00:00Z",
+      "updatedAt": "2025-01-01T00:00:00Z",
+      "viewport": {"zoom": 1.5},
+      "nodes": [
+        {"id": "n1", "type": "custom", "position": {"x": 0, "y": 0}, "measured": {"width": 150}, "data": {"label": "before", "isDone": false, "comments": [], "parentId": null, "color": "red"}},
+        {"id": "n2", "type": "custom", "position": {"x": 10, "y": 0}, "data": {"label": "other", "isDone": false, "comments": [], "parentId": "n1", "tags": ["x"]}}
+      ],
+      "edges": []
+    },
+    "pj2": {"id": "pj2", "name": "Other", "nodes": [], "edges": [], "layout": "grid"}
+  }
+}`
+
+func decodeTodo(t *testing.T, props string) *ical.Component {
+	t.Helper()
+	cal, err := ical.Decode(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nUID:x\r\n" + props + "END:VTODO\r\nEND:VCALENDAR\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cal.Children("VTODO")[0]
+}
+
+func TestPatchTodoKeepsOtherContent(t *testing.T) {
+	m, err := minkan.Decode(json.RawMessage(patchState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, err := applyTodo(m, "pj1", "n1", decodeTodo(t, "SUMMARY:after\r\nSTATUS:COMPLETED\r\n"))
+	if err != nil || !changed {
+		t.Fatalf("applyTodo = %v, %v", changed, err)
+	}
+
+	out, err := patchTodo(json.RawMessage(patchState), m, "pj1", "n1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		FrontendOnly  json.RawMessage            `json:"frontendOnly"`
+		KanbanColumns map[string]json.RawMessage `json:"kanbanColumns"`
+		KanbanIndex   map[string][]string        `json:"kanbanIndex"`
+		Projects      map[string]struct {
+			UpdatedAt string          `json:"updatedAt"`
+			Viewport  json.RawMessage `json:"viewport"`
+			Layout    string          `json:"layout"`
+			Nodes     []struct {
+				Measured json.RawMessage `json:"measured"`
+				Data     struct {
+					Label  string   `json:"label"`
+					IsDone bool     `json:"isDone"`
+					Color  string   `json:"color"`
+					Tags   []string `json:"tags"`
+				} `json:"data"`
+			} `json:"nodes"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	pj := got.Projects["pj1"]
+	n1, n2 := pj.Nodes[0], pj.Nodes[1]
+	if n1.Data.Label != "after" || !n1.Data.IsDone {
+		t.Errorf("node not updated: %+v", n1.Data)
+	}
+	if pj.UpdatedAt == "2025-01-01T00:00:00Z" {
+		t.Error("project updatedAt not updated")
+	}
+
+	// 変更したノード・プロジェクトの他の項目、他のノード・プロジェクトはそのまま
+	if n1.Data.Color != "red" || string(n1.Measured) != compact(t, `{"width": 150}`) {
+		t.Errorf("unknown fields of the node lost: %+v", n1)
+	}
+	if n2.Data.Label != "other" || len(n2.Data.Tags) != 1 {
+		t.Errorf("other node changed: %+v", n2.Data)
+	}
+	if string(pj.Viewport) != compact(t, `{"zoom": 1.5}`) || got.Projects["pj2"].Layout != "grid" {
+		t.Errorf("project fields lost: %s", out)
+	}
+	if string(got.FrontendOnly) != compact(t, `{"theme": "dark"}`) {
+		t.Errorf("top-level field lost: %s", got.FrontendOnly)
+	}
+
+	// カードは完了へ移動し、並びの変わらないカラムは元の内容のまま
+	var done []repository.KanbanCardRef
+	if err := json.Unmarshal(got.KanbanColumns["done"], &done); err != nil {
+		t.Fatal(err)
+	}
+	if string(got.KanbanColumns["todo"]) != `[]` || len(done) != 1 || done[0] != (repository.KanbanCardRef{PjId: "pj1", NodeId: "n1"}) {
+		t.Errorf("card not moved: %s", out)
+	}
+	if string(got.KanbanColumns["doing"]) != compact(t, `[{"pjId": "pj1", "nodeId": "n2", "pinned": true}]`) {
+		t.Errorf("unchanged column rewritten: %s", got.KanbanColumns["doing"])
+	}
+	if idx := got.KanbanIndex["pj1"]; len(idx) != 2 || idx[0] != "n2" || idx[1] != "n1" {
+		t.Errorf("kanbanIndex = %v", got.KanbanIndex)
+	}
+}
+
+func compact(t *testing.T, s string) string {
+	t.Helper()
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
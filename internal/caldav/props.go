@@ -0,0 +1,130 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/ical"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+var (
+	propResourceType          = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName           = xml.Name{Space: nsDAV, Local: "displayname"}
+	propCurrentUserPrincipal  = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL          = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propOwner                 = xml.Name{Space: nsDAV, Local: "owner"}
+	propCurrentUserPrivileges = xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}
+	propSupportedReportSet    = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propGetETag               = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType        = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propCalendarHomeSet       = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propCalendarUserAddresses = xml.Name{Space: nsCalDAV, Local: "calendar-user-address-set"}
+	propSupportedComponents   = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propCalendarData          = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propGetCTag               = xml.Name{Space: nsCS, Local: "getctag"}
+)
+
+// 値が固定のプロパティ
+func static(name xml.Name, value string) prop {
+	return prop{name: name, value: func() string { return value }}
+}
+
+// 読み取りとタスクの内容の変更のみ許可する（作成・削除は不可）
+const (
+	readPrivileges  = "<D:privilege><D:read/></D:privilege>"
+	writePrivileges = readPrivileges + "<D:privilege><D:write-content/></D:privilege>"
+)
+
+// プロパティ作成に使う、リクエスト中で共通の情報
+type propContext struct {
+	h       *Handler
+	user    *repository.User
+	m       *repository.Minkan
+	version int32
+	now     time.Time
+}
+
+func (pc *propContext) principalHref() string {
+	return pc.h.href(target{kind: kindPrincipal})
+}
+
+func (pc *propContext) rootProps() []prop {
+	return []prop{
+		static(propResourceType, "<D:collection/>"),
+		static(propCurrentUserPrincipal, hrefValue(pc.principalHref())),
+		static(propCurrentUserPrivileges, readPrivileges),
+	}
+}
+
+func (pc *propContext) principalProps() []prop {
+	props := []prop{
+		static(propResourceType, "<D:collection/><D:principal/>"),
+		static(propDisplayName, escape(pc.user.DisplayName)),
+		static(propCurrentUserPrincipal, hrefValue(pc.principalHref())),
+		static(propPrincipalURL, hrefValue(pc.principalHref())),
+		static(propCalendarHomeSet, hrefValue(pc.h.href(target{kind: kindHome}))),
+		static(propCurrentUserPrivileges, readPrivileges),
+	}
+	if pc.user.Email != "" {
+		props = append(props, static(propCalendarUserAddresses, hrefValue("mailto:"+pc.user.Email)))
+	}
+	return props
+}
+
+func (pc *propContext) homeProps() []prop {
+	return []prop{
+		static(propResourceType, "<D:collection/>"),
+		static(propDisplayName, "Minkan"),
+		static(propCurrentUserPrincipal, hrefValue(pc.principalHref())),
+		static(propOwner, hrefValue(pc.principalHref())),
+		static(propCurrentUserPrivileges, readPrivileges),
+	}
+}
+
+func (pc *propContext) calendarProps(pj *repository.Project) []prop {
+	return []prop{
+		static(propResourceType, "<D:collection/><C:calendar/>"),
+		static(propDisplayName, escape(pj.Name)),
+		static(propCurrentUserPrincipal, hrefValue(pc.principalHref())),
+		static(propOwner, hrefValue(pc.principalHref())),
+		static(propCurrentUserPrivileges, writePrivileges),
+		static(propSupportedComponents, `<C:comp name="VTODO"/>`),
+		static(propSupportedReportSet,
+			"<D:supported-report><D:report><C:calendar-query/></D:report></D:supported-report>"+
+				"<D:supported-report><D:report><C:calendar-multiget/></D:report></D:supported-report>"),
+		static(propGetETag, escape(etag(pc.version))),
+		static(propGetCTag, strconv.FormatInt(int64(pc.version), 10)),
+	}
+}
+
+func (pc *propContext) objectProps(pj *repository.Project, n *repository.Node) []prop {
+	return []prop{
+		static(propResourceType, ""),
+		static(propCurrentUserPrivileges, writePrivileges),
+		static(propGetETag, escape(etag(pc.version))),
+		static(propGetContentType, "text/calendar; charset=utf-8; component=VTODO"),
+		{
+			name:     propCalendarData,
+			value:    func() string { return escape(pc.objectData(pj, n)) },
+			explicit: true,
+		},
+	}
+}
+
+// ノード1件をVTODO1件のVCALENDARとして返す
+func (pc *propContext) objectData(pj *repository.Project, n *repository.Node) string {
+	e := calendar.NewEntry(pc.m, pj, n, pc.now)
+
+	cal := ical.NewComponent("VCALENDAR")
+	cal.Add("VERSION", "2.0")
+	cal.AddText("PRODID", calendar.ProdID)
+	cal.AddComponent(calendar.Todo(&e, pc.h.NodeURL, pc.now))
+
+	var b strings.Builder
+	_ = cal.Encode(&b)
+	return b.String()
+}
@@ -0,0 +1,221 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// XML名前空間
+const (
+	nsDAV    = "DAV:"
+	nsCalDAV = "urn:ietf:params:xml:ns:caldav"
+	nsCS     = "http://calendarserver.org/ns/" // getctag（CalendarServerの拡張）
+)
+
+// prop はリソースのプロパティ1件
+type prop struct {
+	name xml.Name
+	// 値（要素の内側のXML）。calendar-data等の生成コストが高いものもあるため、返す場合のみ呼び出す
+	value func() string
+	// allpropでは返さないプロパティ（RFC 4791ではcalendar-dataは明示的な指定が必要）
+	explicit bool
+}
+
+// response はmultistatusのresponse要素1件
+type response struct {
+	href   string
+	props  []prop
+	status int // 0以外の場合はpropstatの代わりにstatusを返す（multigetで見つからないhref等）
+}
+
+// propRequest はPROPFIND・REPORTで要求されたプロパティ
+type propRequest struct {
+	all   bool // allprop（ボディ無しのPROPFINDを含む）
+	names []xml.Name
+}
+
+// <D:prop> の子要素の名前を集める
+type propNames struct {
+	Names []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+func (p *propNames) request() propRequest {
+	req := propRequest{}
+	for _, n := range p.Names {
+		req.names = append(req.names, n.XMLName)
+	}
+	return req
+}
+
+type propfindBody struct {
+	XMLName  xml.Name   `xml:"DAV: propfind"`
+	AllProp  *struct{}  `xml:"DAV: allprop"`
+	PropName *struct{}  `xml:"DAV: propname"`
+	Prop     *propNames `xml:"DAV: prop"`
+}
+
+// calendar-query・calendar-multiget等のREPORTのボディ（ルート要素の名前で種類を判定する）
+type reportBody struct {
+	XMLName xml.Name
+	AllProp *struct{}  `xml:"DAV: allprop"`
+	Prop    *propNames `xml:"DAV: prop"`
+	Hrefs   []string   `xml:"DAV: href"`
+	Filter  *struct {
+		CompFilter struct {
+			Name        string `xml:"name,attr"`
+			CompFilters []struct {
+				Name string `xml:"name,attr"`
+			} `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+		} `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+func (b *reportBody) request() propRequest {
+	if b.Prop == nil || b.AllProp != nil {
+		return propRequest{all: true}
+	}
+	return b.Prop.request()
+}
+
+var errEmptyBody = errors.New("empty body")
+
+// ボディのXMLを読み込む（空の場合はerrEmptyBody）
+func decodeBody(r io.Reader, v any) error {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return errEmptyBody
+	}
+	return xml.Unmarshal(body, v)
+}
+
+// PROPFINDのボディから要求されたプロパティを読み込む（ボディ無し・propnameはallprop扱い）
+func parsePropfind(r io.Reader) (propRequest, error) {
+	var body propfindBody
+	err := decodeBody(r, &body)
+	if errors.Is(err, errEmptyBody) {
+		return propRequest{all: true}, nil
+	}
+	if err != nil {
+		return propRequest{}, err
+	}
+	if body.Prop == nil || body.AllProp != nil || body.PropName != nil {
+		return propRequest{all: true}, nil
+	}
+	return body.Prop.request(), nil
+}
+
+// 207 Multi-Statusを書き出す
+func writeMultistatus(w http.ResponseWriter, responses []response, req propRequest) error {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString(`<D:multistatus xmlns:D="DAV:" xmlns:C="` + nsCalDAV + `" xmlns:CS="` + nsCS + `">`)
+
+	for _, res := range responses {
+		b.WriteString("<D:response><D:href>" + escape(res.href) + "</D:href>")
+		if res.status != 0 {
+			b.WriteString("<D:status>" + statusLine(res.status) + "</D:status></D:response>")
+			continue
+		}
+
+		found, missing := selectProps(res.props, req)
+		if len(found) > 0 {
+			b.WriteString("<D:propstat><D:prop>")
+			for _, p := range found {
+				writeElement(&b, p.name, p.value())
+			}
+			b.WriteString("</D:prop><D:status>" + statusLine(http.StatusOK) + "</D:status></D:propstat>")
+		}
+		if len(missing) > 0 {
+			b.WriteString("<D:propstat><D:prop>")
+			for _, name := range missing {
+				writeElement(&b, name, "")
+			}
+			b.WriteString("</D:prop><D:status>" + statusLine(http.StatusNotFound) + "</D:status></D:propstat>")
+		}
+		b.WriteString("</D:response>")
+	}
+	b.WriteString("</D:multistatus>\n")
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// 要求されたプロパティを、値のあるものと無いものに分ける
+func selectProps(props []prop, req propRequest) ([]prop, []xml.Name) {
+	if req.all {
+		found := []prop{}
+		for _, p := range props {
+			if !p.explicit {
+				found = append(found, p)
+			}
+		}
+		return found, nil
+	}
+
+	found := []prop{}
+	missing := []xml.Name{}
+	for _, name := range req.names {
+		ok := false
+		for _, p := range props {
+			if p.name == name {
+				found = append(found, p)
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			missing = append(missing, name)
+		}
+	}
+	return found, missing
+}
+
+// プロパティの要素を書き出す（名前空間は要素ごとに既定の名前空間として宣言する）
+func writeElement(b *strings.Builder, name xml.Name, inner string) {
+	b.WriteString("<" + name.Local + ` xmlns="` + escape(name.Space) + `"`)
+	if inner == "" {
+		b.WriteString("/>")
+		return
+	}
+	b.WriteString(">" + inner + "</" + name.Local + ">")
+}
+
+// DAVのエラー応答（RFC 4918 16. の事前条件）
+func writeError(w http.ResponseWriter, status int, condition xml.Name) {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString(`<D:error xmlns:D="DAV:">`)
+	writeElement(&b, condition, "")
+	b.WriteString("</D:error>\n")
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, b.String())
+}
+
+func statusLine(code int) string {
+	return "HTTP/1.1 " + strconv.Itoa(code) + " " + http.StatusText(code)
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// hrefを1つだけ含む要素（current-user-principal等）
+func hrefValue(href string) string {
+	return "<D:href>" + escape(href) + "</D:href>"
+}
@@ -0,0 +1,130 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// Entry は期限・開始日時を持つノード1件
+type Entry struct {
+	PjID     string     `json:"pjId"`
+	PjName   string     `json:"pjName"`
+	NodeID   string     `json:"nodeId"`
+	Label    string     `json:"label"`
+	IsDone   bool       `json:"isDone"`
+	Priority *int       `json:"priority,omitempty"`
+	StartAt  *time.Time `json:"startAt,omitempty"`
+	DueAt    *time.Time `json:"dueAt,omitempty"`
+	Column   *string    `json:"column"`  // カンバンのカラム（カンバンに無い場合はnull）
+	Overdue  bool       `json:"overdue"` // 未完了かつ期限切れ
+}
+
+// ユーザーのタイムゾーン名からLocationを返す（未設定・不正な場合はdef）
+func Location(name string, def *time.Location) *time.Location {
+	if name == "" {
+		return def
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return def
+	}
+	return loc
+}
+
+// 日付(年月日)をlocでの0時として返す
+func StartOfDay(date time.Time, loc *time.Location) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// [from, to) と期間が重なるノードを、日時・優先度順に返す
+// - 開始日時と期限の両方がある場合は、その期間が範囲と重なれば対象
+// - どちらか一方のみの場合は、その日時が範囲内なら対象
+func Query(m *repository.Minkan, from, to, now time.Time) []Entry {
+	entries := []Entry{}
+
+	for _, pj := range m.Projects {
+		for _, n := range pj.Nodes {
+			d := n.Data
+			if d.StartAt == nil && d.DueAt == nil {
+				continue
+			}
+
+			begin, end := d.StartAt, d.DueAt
+			if begin == nil {
+				begin = end
+			}
+			if end == nil {
+				end = begin
+			}
+			if !begin.Before(to) || end.Before(from) {
+				continue
+			}
+
+			entries = append(entries, NewEntry(m, &pj, &n, now))
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if ta, tb := a.sortTime(), b.sortTime(); !ta.Equal(tb) {
+			return ta.Before(tb)
+		}
+		if pa, pb := a.sortPriority(), b.sortPriority(); pa != pb {
+			return pa < pb
+		}
+		if a.PjID != b.PjID {
+			return a.PjID < b.PjID
+		}
+		return a.NodeID < b.NodeID
+	})
+
+	return entries
+}
+
+// NewEntry はノード1件をエントリに変換する（日時の有無は問わない）
+func NewEntry(m *repository.Minkan, pj *repository.Project, n *repository.Node, now time.Time) Entry {
+	d := n.Data
+	e := Entry{
+		PjID:     pj.Id,
+		PjName:   pj.Name,
+		NodeID:   n.Id,
+		Label:    d.Label,
+		IsDone:   d.IsDone,
+		Priority: d.Priority,
+		StartAt:  d.StartAt,
+		DueAt:    d.DueAt,
+		Overdue:  !d.IsDone && d.DueAt != nil && d.DueAt.Before(now),
+	}
+	if col := minkan.FindCardColumn(&m.KanbanColumns, pj.Id, n.Id); col != minkan.ColumnNone {
+		e.Column = &col
+	}
+	return e
+}
+
+// 期限があれば期限、無ければ開始日時で並べる
+func (e *Entry) sortTime() time.Time {
+	if e.DueAt != nil {
+		return *e.DueAt
+	}
+	return *e.StartAt
+}
+
+// 優先度未設定は最後
+func (e *Entry) sortPriority() int {
+	if e.Priority == nil {
+		return minkan.PriorityLowest + 1
+	}
+	return *e.Priority
+}
+
+// Result は GET /calendar の結果
+type Result struct {
+	TimeZone string  `json:"timeZone"`
+	From     string  `json:"from"` // YYYY-MM-DD
+	To       string  `json:"to"`   // YYYY-MM-DD
+	Entries  []Entry `json:"entries"`
+}
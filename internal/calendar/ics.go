@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/ical"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+// iCalendarのPRODID
+const ProdID = "-//Minkan//Minkan Calendar//JA"
+
+// カレンダーアプリに再取得を促す間隔
+const feedRefreshInterval = "PT1H"
+
+// Feed はiCalendarフィードの内容
+type Feed struct {
+	Name     string         // カレンダー名
+	Location *time.Location // ユーザーのタイムゾーン
+	Entries  []Entry
+	NodeURL  func(pjID, nodeID string) string // ノードを開くアプリのURL（nil・空文字の場合はURLを付けない）
+	Now      time.Time                        // DTSTAMP
+}
+
+// ICS はエントリをVCALENDARに変換する
+// - 未完了で開始日時があるノードは予定(VEVENT)として、開始〜期限の期間で出力する
+// - それ以外（期限のみ・完了済み）はタスク(VTODO)として出力し、完了済みはSTATUS:COMPLETEDとする
+// （VEVENTのSTATUSにはCOMPLETEDが無いため、完了済みは常にVTODO）
+func (f *Feed) ICS() *ical.Component {
+	cal := ical.NewComponent("VCALENDAR")
+	cal.Add("VERSION", "2.0")
+	cal.AddText("PRODID", ProdID)
+	cal.Add("CALSCALE", "GREGORIAN")
+	cal.Add("METHOD", "PUBLISH")
+	cal.AddText("X-WR-CALNAME", f.Name)
+	cal.AddText("X-WR-TIMEZONE", f.Location.String())
+	cal.Add("REFRESH-INTERVAL", feedRefreshInterval, "VALUE=DURATION")
+	cal.Add("X-PUBLISHED-TTL", feedRefreshInterval)
+
+	for i := range f.Entries {
+		cal.AddComponent(f.component(&f.Entries[i]))
+	}
+	return cal
+}
+
+func (f *Feed) component(e *Entry) *ical.Component {
+	if e.StartAt == nil || e.IsDone {
+		return Todo(e, f.NodeURL, f.Now)
+	}
+
+	c := ical.NewComponent("VEVENT")
+	c.AddTime("DTSTART", *e.StartAt)
+	if e.DueAt != nil && e.DueAt.After(*e.StartAt) {
+		c.AddTime("DTEND", *e.DueAt)
+	}
+	addEntryProps(c, e, f.NodeURL, f.Now)
+	return c
+}
+
+// Todo はエントリをタスク(VTODO)に変換する（フィードとCalDAVで共通）
+func Todo(e *Entry, nodeURL func(pjID, nodeID string) string, now time.Time) *ical.Component {
+	c := ical.NewComponent("VTODO")
+	if e.StartAt != nil {
+		c.AddTime("DTSTART", *e.StartAt)
+	}
+	if e.DueAt != nil {
+		c.AddTime("DUE", *e.DueAt)
+	}
+	if e.IsDone {
+		c.Add("STATUS", "COMPLETED")
+		c.Add("PERCENT-COMPLETE", "100")
+	} else {
+		c.Add("STATUS", "NEEDS-ACTION")
+	}
+	addEntryProps(c, e, nodeURL, now)
+	return c
+}
+
+// VEVENT・VTODOに共通のプロパティ
+func addEntryProps(c *ical.Component, e *Entry, nodeURL func(pjID, nodeID string) string, now time.Time) {
+	c.AddText("UID", UID(e.PjID, e.NodeID))
+	c.AddTime("DTSTAMP", now)
+	c.AddText("SUMMARY", e.Label)
+	c.AddText("CATEGORIES", e.PjName)
+	if e.Priority != nil {
+		c.Add("PRIORITY", strconv.Itoa(ICalPriority(*e.Priority)))
+	}
+
+	var desc strings.Builder
+	desc.WriteString("プロジェクト: " + e.PjName)
+	if e.Column != nil {
+		desc.WriteString("\nカンバン: " + *e.Column)
+	}
+	if nodeURL != nil {
+		if link := nodeURL(e.PjID, e.NodeID); link != "" {
+			desc.WriteString("\n" + link)
+			c.Add("URL", link, "VALUE=URI")
+		}
+	}
+	c.AddText("DESCRIPTION", desc.String())
+}
+
+// UID はノードのiCalendar上のUID（プロジェクト・ノードで一意）
+func UID(pjID, nodeID string) string {
+	return pjID + "." + nodeID + "@minkan"
+}
+
+// ICalPriority はノードの優先度(1〜5)をiCalendarのPRIORITY(1〜9, 1が最高)に変換する
+func ICalPriority(p int) int {
+	return 1 + (p-minkan.PriorityHighest)*2
+}
@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// 折り返しを戻したiCalendarの各行
+func encodeLines(t *testing.T, f *Feed) []string {
+	t.Helper()
+	var b strings.Builder
+	if err := f.ICS().Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(out, "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+// BEGIN:name 〜 END:name の範囲（UIDで対象を選ぶ）
+func component(lines []string, name, uid string) []string {
+	for i := 0; i < len(lines); i++ {
+		if lines[i] != "BEGIN:"+name {
+			continue
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if lines[j] == "END:"+name {
+				c := lines[i : j+1]
+				for _, l := range c {
+					if l == "UID:"+uid {
+						return c
+					}
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func hasLine(lines []string, want string) bool {
+	for _, l := range lines {
+		if l == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFeedICS(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	at := func(day, hour int) *time.Time {
+		v := time.Date(2025, 1, day, hour, 0, 0, 0, tokyo)
+		return &v
+	}
+	prio := func(p int) *int { return &p }
+	doing := "doing"
+
+	f := &Feed{
+		Name:     "Minkan",
+		Location: tokyo,
+		NodeURL: func(pjID, nodeID string) string {
+			return "https://app.test/app/" + pjID + "/" + nodeID
+		},
+		Now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Entries: []Entry{
+			// 未完了で開始日時あり → VEVENT
+			{PjID: "pj1", PjName: "仕事", NodeID: "event", Label: "会議, 定例; 週次", StartAt: at(2, 10), DueAt: at(2, 11), Priority: prio(1), Column: &doing},
+			// 期限のみ → VTODO
+			{PjID: "pj1", PjName: "仕事", NodeID: "todo", Label: "提出", DueAt: at(3, 18), Priority: prio(3)},
+			// 完了済みは開始日時があってもVTODO
+			{PjID: "pj1", PjName: "仕事", NodeID: "done", Label: "準備", IsDone: true, StartAt: at(1, 9), DueAt: at(1, 12)},
+		},
+	}
+	lines := encodeLines(t, f)
+
+	if lines[0] != "BEGIN:VCALENDAR" || lines[len(lines)-1] != "END:VCALENDAR" {
+		t.Fatalf("not a VCALENDAR: %q ... %q", lines[0], lines[len(lines)-1])
+	}
+	for _, want := range []string{"VERSION:2.0", "PRODID:" + ProdID, "METHOD:PUBLISH", "X-WR-CALNAME:Minkan", "X-WR-TIMEZONE:Asia/Tokyo", "REFRESH-INTERVAL;VALUE=DURATION:PT1H"} {
+		if !hasLine(lines, want) {
+			t.Errorf("calendar missing %q", want)
+		}
+	}
+
+	event := component(lines, "VEVENT", "pj1.event@minkan")
+	if event == nil {
+		t.Fatalf("VEVENT for event not found:\n%s", strings.Join(lines, "\n"))
+	}
+	for _, want := range []string{
+		"DTSTART:20250102T010000Z",
+		"DTEND:20250102T020000Z",
+		"DTSTAMP:20250101T000000Z",
+		`SUMMARY:会議\, 定例\; 週次`,
+		"CATEGORIES:仕事",
+		"PRIORITY:1",
+		"URL;VALUE=URI:https://app.test/app/pj1/event",
+		`DESCRIPTION:プロジェクト: 仕事\nカンバン: doing\nhttps://app.test/app/pj1/event`,
+	} {
+		if !hasLine(event, want) {
+			t.Errorf("VEVENT missing %q:\n%s", want, strings.Join(event, "\n"))
+		}
+	}
+
+	todo := component(lines, "VTODO", "pj1.todo@minkan")
+	if todo == nil {
+		t.Fatalf("VTODO for todo not found:\n%s", strings.Join(lines, "\n"))
+	}
+	for _, want := range []string{"DUE:20250103T090000Z", "STATUS:NEEDS-ACTION", "PRIORITY:5"} {
+		if !hasLine(todo, want) {
+			t.Errorf("VTODO missing %q:\n%s", want, strings.Join(todo, "\n"))
+		}
+	}
+	for _, l := range todo {
+		if strings.HasPrefix(l, "DTSTART") {
+			t.Errorf("VTODO without start has %q", l)
+		}
+	}
+
+	done := component(lines, "VTODO", "pj1.done@minkan")
+	if done == nil {
+		t.Fatalf("done entry is not a VTODO:\n%s", strings.Join(lines, "\n"))
+	}
+	for _, want := range []string{"DTSTART:20250101T000000Z", "DUE:20250101T030000Z", "STATUS:COMPLETED", "PERCENT-COMPLETE:100"} {
+		if !hasLine(done, want) {
+			t.Errorf("completed VTODO missing %q:\n%s", want, strings.Join(done, "\n"))
+		}
+	}
+	if component(lines, "VEVENT", "pj1.done@minkan") != nil {
+		t.Errorf("completed entry also exported as VEVENT")
+	}
+}
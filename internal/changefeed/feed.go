@@ -0,0 +1,132 @@
+package changefeed
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/jsonpatch"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// minkan_revisions.originの最大長
+const maxOriginLen = 128
+
+// Revision は1回の更新で生じた差分
+type Revision struct {
+	Version   int32           `json:"version"` // 差分適用後のversion
+	Patch     json.RawMessage `json:"patch"`   // JSON Patch(RFC 6902)
+	Origin    string          `json:"origin"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// Result は GET /minkan/changes の結果
+type Result struct {
+	Version int32      `json:"version"` // changesを全て適用した後のversion
+	Reload  bool       `json:"reload"`  // trueの場合、差分を返せないため GET /minkan で全体を取得し直す
+	Changes []Revision `json:"changes"`
+}
+
+// Feed はminkan_statesの更新差分を記録し、指定versionからの差分を返す
+type Feed struct {
+	Repo      *repository.MinkanRevisionsRepository
+	StateRepo *repository.MinkanStatesRepository
+	Keep      int32 // ユーザーごとに保持する差分の件数
+}
+
+func NewFeed(repo *repository.MinkanRevisionsRepository, stateRepo *repository.MinkanStatesRepository, keep int32) *Feed {
+	return &Feed{Repo: repo, StateRepo: stateRepo, Keep: keep}
+}
+
+// minkan.Storeの更新フックとして登録し、更新ごとの差分を保存する
+// 保存に失敗した場合、そのversionを跨ぐ取得はReloadになる
+func (f *Feed) Record(ctx context.Context, c *minkan.Change) {
+	lg := slog.Default().With("module", "changefeed", "userID", c.UserID, "version", c.Version)
+
+	ops, err := jsonpatch.Diff(c.Old, c.New)
+	if err != nil {
+		lg.Error("diff minkan state failed", "err", err)
+		return
+	}
+	patchJSON, err := json.Marshal(ops)
+	if err != nil {
+		lg.Error("marshal patch failed", "err", err)
+		return
+	}
+
+	origin := c.Origin
+	if len(origin) > maxOriginLen {
+		origin = origin[:maxOriginLen]
+	}
+
+	rev := &repository.MinkanRevision{
+		UserID:    c.UserID,
+		Version:   c.Version,
+		PatchJSON: patchJSON,
+		Origin:    origin,
+	}
+	if err := f.Repo.InsertRevision(ctx, rev); err != nil {
+		lg.Error("insert minkan revision failed", "err", err)
+		return
+	}
+
+	// 保持件数を超えた古い差分を削除
+	if c.Version > f.Keep {
+		if err := f.Repo.DeleteRevisionsUpTo(ctx, c.UserID, c.Version-f.Keep); err != nil {
+			lg.Warn("prune minkan revisions failed", "err", err)
+		}
+	}
+}
+
+// sinceの次のversionから現在までの差分を返す
+// - sinceが現在のversionと同じ場合は空のchangesを返す
+// - 途中の差分が削除済み・未記録の場合や、sinceが範囲外の場合はReload=trueを返す
+func (f *Feed) Since(ctx context.Context, userID int64, since int32) (*Result, error) {
+	state, err := f.StateRepo.FindStateByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, minkan.ErrStateNotFound
+	}
+
+	res := &Result{Version: state.Version, Changes: []Revision{}}
+	if since == state.Version {
+		return res, nil
+	}
+	if since < 1 || since > state.Version || state.Version-since > f.Keep {
+		res.Reload = true
+		return res, nil
+	}
+
+	revs, err := f.Repo.ListRevisions(ctx, userID, since, state.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// sinceから連続している分だけ返す
+	// 直後の更新の記録がまだ終わっていない場合は、途中までを返して次回の取得に回す
+	next := since + 1
+	for _, rev := range revs {
+		if rev.Version != next {
+			break
+		}
+		res.Changes = append(res.Changes, Revision{
+			Version:   rev.Version,
+			Patch:     rev.PatchJSON,
+			Origin:    rev.Origin,
+			UpdatedAt: rev.CreatedAt,
+		})
+		next++
+	}
+
+	if len(res.Changes) == 0 {
+		res.Reload = true
+		return res, nil
+	}
+	res.Version = res.Changes[len(res.Changes)-1].Version
+
+	return res, nil
+}
@@ -0,0 +1,84 @@
+// Package cookies はアプリが発行するCookie（セッション・CSRFトークン・OIDCのstate）の属性をまとめて扱う
+// 属性は設定（configs.ConfigList）から作り、Cookieの発行・削除はここを通す
+package cookies
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/configs"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+)
+
+// Policy はCookieの名前と属性
+type Policy struct {
+	SessionName string
+	CSRFName    string
+	Domain      string // CSRFトークンのCookieのみに付与（セッションID・OIDCのCookieは常にAPIのホストのみ）
+	Path        string
+	Secure      bool
+	SameSite    http.SameSite
+}
+
+func NewPolicy(cfg *configs.ConfigList) Policy {
+	return Policy{
+		SessionName: cfg.CookieSessionName,
+		CSRFName:    cfg.CookieCSRFName,
+		Domain:      cfg.CookieDomain,
+		Path:        cfg.CookiePath,
+		Secure:      cfg.CookieSecure,
+		SameSite:    cfg.CookieSameSite,
+	}
+}
+
+// リクエストのセッションIDを返す（無い場合は空）
+func (p Policy) SessionID(r *http.Request) string {
+	c, err := r.Cookie(p.SessionName)
+	if err != nil {
+		return ""
+	}
+	return c.Value
+}
+
+// SetSession はセッションIDとCSRFトークンのCookieを発行する
+// ログイン・セッションIDの再発行・有効期限の延長で共通して使う
+func (p Policy) SetSession(w http.ResponseWriter, sessID, csrfToken string, ttl time.Duration) {
+	maxAge := int(ttl.Seconds()) // ブラウザ閉後もCookieをキープ
+
+	// セッションIDはJSから読み取れないようにする
+	http.SetCookie(w, p.cookie(p.SessionName, sessID, "", true, maxAge))
+
+	// CSRFトークンはフロントエンド(JS)がヘッダに付与するため読めるようにする
+	http.SetCookie(w, p.cookie(p.CSRFName, csrfToken, p.Domain, false, maxAge))
+}
+
+// ClearSession はセッションIDとCSRFトークンのCookieを失効させる
+func (p Policy) ClearSession(w http.ResponseWriter) {
+	http.SetCookie(w, p.cookie(p.SessionName, "", "", true, -1))
+	http.SetCookie(w, p.cookie(p.CSRFName, "", p.Domain, false, -1))
+}
+
+// OIDCOptions はOIDCのstate・PKCEのCookieに同じ属性を適用するオプションを返す
+func (p Policy) OIDCOptions() []httphelper.CookieHandlerOpt {
+	opts := []httphelper.CookieHandlerOpt{
+		httphelper.WithSameSite(p.SameSite),
+		httphelper.WithPath(p.Path),
+	}
+	if !p.Secure {
+		opts = append(opts, httphelper.WithUnsecure())
+	}
+	return opts
+}
+
+func (p Policy) cookie(name, value, domain string, httpOnly bool, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     p.Path,
+		Domain:   domain,
+		HttpOnly: httpOnly,
+		Secure:   p.Secure,
+		SameSite: p.SameSite,
+		MaxAge:   maxAge,
+	}
+}
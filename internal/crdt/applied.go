@@ -0,0 +1,45 @@
+package crdt
+
+import "sort"
+
+// Span はカウンタの閉区間 [From, To]（JSONでは [from, to]）
+type Span [2]uint64
+
+// Applied はレプリカごとの適用済み操作のカウンタの集合（重複排除用）
+// 操作は順不同・再送ありで届くため、最大値ではなく集合で持つ
+// 1端末の連続した編集はカウンタが連番になるため区間の列で保持する（同期の際のクロックの飛びのみ区間が分かれる）
+type Applied map[string][]Span
+
+// idの操作が適用済みかどうか
+func (a Applied) Has(id ID) bool {
+	spans := a[id.Replica]
+	i := sort.Search(len(spans), func(i int) bool { return spans[i][1] >= id.Counter })
+	return i < len(spans) && spans[i][0] <= id.Counter
+}
+
+// idの操作を適用済みにする（隣接する区間は結合する）
+func (a Applied) Add(id ID) {
+	c := id.Counter
+	spans := a[id.Replica]
+	i := sort.Search(len(spans), func(i int) bool { return spans[i][1] >= c })
+	if i < len(spans) && spans[i][0] <= c {
+		return
+	}
+
+	joinLeft := i > 0 && spans[i-1][1]+1 == c
+	joinRight := i < len(spans) && spans[i][0] == c+1
+	switch {
+	case joinLeft && joinRight:
+		spans[i-1][1] = spans[i][1]
+		spans = append(spans[:i], spans[i+1:]...)
+	case joinLeft:
+		spans[i-1][1] = c
+	case joinRight:
+		spans[i][0] = c
+	default:
+		spans = append(spans, Span{})
+		copy(spans[i+1:], spans[i:])
+		spans[i] = Span{c, c}
+	}
+	a[id.Replica] = spans
+}
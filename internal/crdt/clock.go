@@ -0,0 +1,43 @@
+package crdt
+
+import "fmt"
+
+// ID はLamportタイムスタンプ（カウンタ + レプリカID）
+// 全操作に一意に振られ、(Counter, Replica) の辞書順で全順序を与える
+type ID struct {
+	Counter uint64 `json:"c"`
+	Replica string `json:"r"`
+}
+
+func (id ID) IsZero() bool {
+	return id.Counter == 0 && id.Replica == ""
+}
+
+// idがotherより前であればtrue
+func (id ID) Less(other ID) bool {
+	if id.Counter != other.Counter {
+		return id.Counter < other.Counter
+	}
+	return id.Replica < other.Replica
+}
+
+func (id ID) String() string {
+	return fmt.Sprintf("%d@%s", id.Counter, id.Replica)
+}
+
+// Register はLast-Writer-Winsレジスタ
+// タイムスタンプが大きい書き込みが常に勝つため、適用順序に依らず同じ値に収束する
+type Register[T any] struct {
+	Value T  `json:"v"`
+	TS    ID `json:"ts"`
+}
+
+// tsが現在の値より新しい場合のみ上書きし、上書きしたかを返す
+func (r *Register[T]) Set(v T, ts ID) bool {
+	if !r.TS.Less(ts) {
+		return false
+	}
+	r.Value = v
+	r.TS = ts
+	return true
+}
@@ -0,0 +1,206 @@
+package crdt
+
+import (
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// NodeState はノード1つ分のCRDT状態
+// 親ノード(木構造)・ラベル・完了フラグ・位置をそれぞれLWWレジスタで持つ
+type NodeState struct {
+	Created  ID                        `json:"created"`
+	Parent   Register[string]          `json:"parent"`
+	Label    Register[string]          `json:"label"`
+	Done     Register[bool]            `json:"done"`
+	Position Register[minkan.Position] `json:"position"`
+	Deleted  bool                      `json:"deleted,omitempty"`
+}
+
+// ProjectState はプロジェクト1つ分のCRDT状態
+type ProjectState struct {
+	Created ID                    `json:"created"`
+	Name    Register[string]      `json:"name"`
+	Deleted bool                  `json:"deleted,omitempty"`
+	Nodes   map[string]*NodeState `json:"nodes"`
+}
+
+// Document はminkan_stateのCRDT表現
+// 同じ操作の集合を適用すれば、適用順序に依らず同じ内容に収束する
+type Document struct {
+	Clock    uint64                   `json:"clock"`   // 観測済みの最大カウンタ（Lamportクロック）
+	Applied  Applied                  `json:"applied"` // レプリカごとの適用済み操作（重複排除用）
+	Projects map[string]*ProjectState `json:"projects"`
+	Columns  map[string]*Sequence     `json:"columns"` // カラム名 -> カード列
+
+	// 旧形式のスナップショットの適用済み最大カウンタ（normalizeでAppliedへ移す）
+	Replicas map[string]uint64 `json:"replicas,omitempty"`
+}
+
+func NewDocument() *Document {
+	d := &Document{
+		Applied:  Applied{},
+		Projects: map[string]*ProjectState{},
+		Columns:  map[string]*Sequence{},
+	}
+	d.normalize()
+	return d
+}
+
+// JSONから復元した際にnilのmapを初期化する
+func (d *Document) normalize() {
+	if d.Applied == nil {
+		d.Applied = Applied{}
+	}
+	for replica, upto := range d.Replicas {
+		// 旧形式では最大カウンタ以下を全て適用済みとみなしていたため、同じ範囲を適用済みにする
+		if upto > 0 && len(d.Applied[replica]) == 0 {
+			d.Applied[replica] = []Span{{1, upto}}
+		}
+	}
+	d.Replicas = nil
+	if d.Projects == nil {
+		d.Projects = map[string]*ProjectState{}
+	}
+	for _, pj := range d.Projects {
+		if pj.Nodes == nil {
+			pj.Nodes = map[string]*NodeState{}
+		}
+	}
+	if d.Columns == nil {
+		d.Columns = map[string]*Sequence{}
+	}
+	for _, name := range minkan.Columns {
+		if d.Columns[name] == nil {
+			d.Columns[name] = &Sequence{Elements: []Element{}}
+		}
+	}
+}
+
+// 操作が適用済みかどうか
+// 拒否された操作の再送等で順不同に届くため、最大カウンタではなく適用済みの集合で判定する
+func (d *Document) Seen(id ID) bool {
+	return d.Applied.Has(id)
+}
+
+// 次に発行する操作のIDを採番する（サーバ側で操作を生成する場合に使用）
+func (d *Document) NextID(replica string) ID {
+	return ID{Counter: d.Clock + 1, Replica: replica}
+}
+
+// 操作をドキュメントに適用する
+// - 適用済みの操作は無視する（冪等）
+// - 参照先が存在しない等で適用できない場合はErrInvalidOpをラップしたエラーを返し、ドキュメントは変更しない
+func (d *Document) Apply(op *Op) error {
+	if err := op.Validate(); err != nil {
+		return err
+	}
+	if d.Seen(op.ID) {
+		return nil
+	}
+
+	if err := d.apply(op); err != nil {
+		return err
+	}
+
+	d.Applied.Add(op.ID)
+	if op.ID.Counter > d.Clock {
+		d.Clock = op.ID.Counter
+	}
+	return nil
+}
+
+func (d *Document) apply(op *Op) error {
+	if op.Kind == KindProjectCreate {
+		pj, ok := d.Projects[op.PjID]
+		if !ok {
+			pj = &ProjectState{Created: op.ID, Nodes: map[string]*NodeState{}}
+			d.Projects[op.PjID] = pj
+		} else if op.ID.Less(pj.Created) {
+			// 同じIDの並行した作成は、適用順序に依らず最も古い操作を作成とする
+			pj.Created = op.ID
+		}
+		pj.Name.Set(op.Label, op.ID)
+
+		// ルートノードはプロジェクトと同時に作られる（親を持たない唯一のノード）
+		if root, ok := pj.Nodes[minkan.RootNodeID]; !ok {
+			pj.Nodes[minkan.RootNodeID] = &NodeState{Created: op.ID}
+		} else if op.ID.Less(root.Created) {
+			root.Created = op.ID
+		}
+		return nil
+	}
+
+	pj, ok := d.Projects[op.PjID]
+	if !ok {
+		return invalidOp("project %q not found", op.PjID)
+	}
+
+	switch op.Kind {
+	case KindProjectRename:
+		pj.Name.Set(op.Label, op.ID)
+
+	case KindProjectDelete:
+		pj.Deleted = true
+
+	case KindNodeCreate:
+		if _, ok := pj.Nodes[op.ParentID]; !ok {
+			return invalidOp("parent node %q not found", op.ParentID)
+		}
+		node, ok := pj.Nodes[op.NodeID]
+		if !ok {
+			node = &NodeState{Created: op.ID}
+			pj.Nodes[op.NodeID] = node
+		} else if op.ID.Less(node.Created) {
+			node.Created = op.ID
+		}
+		node.Parent.Set(op.ParentID, op.ID)
+		node.Label.Set(op.Label, op.ID)
+		node.Done.Set(false, op.ID)
+		if op.Position != nil {
+			node.Position.Set(*op.Position, op.ID)
+		}
+
+	case KindNodeMove:
+		node, ok := pj.Nodes[op.NodeID]
+		if !ok {
+			return invalidOp("node %q not found", op.NodeID)
+		}
+		if _, ok := pj.Nodes[op.ParentID]; !ok {
+			return invalidOp("parent node %q not found", op.ParentID)
+		}
+		// 並行した付け替えによる循環は具体化(Materialize)時に解消する
+		node.Parent.Set(op.ParentID, op.ID)
+
+	case KindNodePosition, KindNodeDelete, KindLabelSet, KindDoneSet:
+		node, ok := pj.Nodes[op.NodeID]
+		if !ok {
+			return invalidOp("node %q not found", op.NodeID)
+		}
+		switch op.Kind {
+		case KindNodePosition:
+			node.Position.Set(*op.Position, op.ID)
+		case KindNodeDelete:
+			node.Deleted = true
+		case KindLabelSet:
+			node.Label.Set(op.Label, op.ID)
+		case KindDoneSet:
+			node.Done.Set(op.Done, op.ID)
+		}
+
+	case KindCardInsert:
+		if _, ok := pj.Nodes[op.NodeID]; !ok {
+			return invalidOp("node %q not found", op.NodeID)
+		}
+		ref := repository.KanbanCardRef{PjId: op.PjID, NodeId: op.NodeID}
+		if !d.Columns[op.Column].Insert(op.After, op.ID, ref) {
+			return invalidOp("element %s not found in column %q", op.After, op.Column)
+		}
+
+	case KindCardDelete:
+		if !d.Columns[op.Column].Delete(*op.Target) {
+			return invalidOp("element %s not found in column %q", op.Target, op.Column)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package crdt
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+func id(c uint64, r string) ID { return ID{Counter: c, Replica: r} }
+
+func pos(x, y float32) *minkan.Position { return &minkan.Position{X: x, Y: y} }
+
+// 3端末の並行した編集
+// a: プロジェクトとノードn1を作成してtodoに入れた後、b・cへ同期
+// b: n2を作成してn1をn2の下へ移動、n2をn1のカードの右に挿入
+// c: n1のラベルと完了を変更、n2をn1の下へ移動（bと循環し、後から移動したn2がルート直下になる）、n1のカードをdoingへ移動、同じ位置にカードを挿入
+func scenario() []Op {
+	a1, a4 := id(1, "a"), id(4, "a")
+	return []Op{
+		{ID: a1, Kind: KindProjectCreate, PjID: "pj", Label: "Project"},
+		{ID: id(2, "a"), Kind: KindNodeCreate, PjID: "pj", NodeID: "n1", ParentID: minkan.RootNodeID, Label: "n1", Position: pos(0, 0)},
+		{ID: id(3, "a"), Kind: KindLabelSet, PjID: "pj", NodeID: "n1", Label: "first"},
+		{ID: a4, Kind: KindCardInsert, PjID: "pj", NodeID: "n1", Column: "todo"},
+
+		{ID: id(5, "b"), Kind: KindNodeCreate, PjID: "pj", NodeID: "n2", ParentID: minkan.RootNodeID, Label: "n2", Position: pos(10, 0)},
+		{ID: id(6, "b"), Kind: KindNodeMove, PjID: "pj", NodeID: "n1", ParentID: "n2"},
+		{ID: id(7, "b"), Kind: KindCardInsert, PjID: "pj", NodeID: "n2", Column: "todo", After: &a4},
+		{ID: id(8, "b"), Kind: KindLabelSet, PjID: "pj", NodeID: "n1", Label: "from b"},
+		{ID: id(9, "b"), Kind: KindProjectRename, PjID: "pj", Label: "Renamed by b"},
+
+		{ID: id(5, "c"), Kind: KindLabelSet, PjID: "pj", NodeID: "n1", Label: "from c"},
+		{ID: id(6, "c"), Kind: KindDoneSet, PjID: "pj", NodeID: "n1", Done: true},
+		{ID: id(7, "c"), Kind: KindCardDelete, PjID: "pj", Column: "todo", Target: &a4},
+		{ID: id(8, "c"), Kind: KindCardInsert, PjID: "pj", NodeID: "n1", Column: "doing"},
+		{ID: id(9, "c"), Kind: KindNodePosition, PjID: "pj", NodeID: "n1", Position: pos(5, 5)},
+		{ID: id(10, "c"), Kind: KindNodeMove, PjID: "pj", NodeID: "n2", ParentID: "n1"},
+		{ID: id(11, "c"), Kind: KindCardInsert, PjID: "pj", NodeID: "n2", Column: "todo", After: &a4},
+		{ID: id(12, "c"), Kind: KindProjectRename, PjID: "pj", Label: "Renamed by c"},
+	}
+}
+
+// 操作を与えられた順に届け、参照先が未着で適用できなかった操作は後から再送する
+// （端末は拒否された操作を次の同期で再送する）
+func deliver(t *testing.T, ops []Op) *Document {
+	t.Helper()
+
+	d := NewDocument()
+	pending := append([]Op(nil), ops...)
+	for len(pending) > 0 {
+		var retry []Op
+		for i := range pending {
+			if err := d.Apply(&pending[i]); err != nil {
+				if !errors.Is(err, ErrInvalidOp) {
+					t.Fatalf("apply %s: %v", pending[i].ID, err)
+				}
+				retry = append(retry, pending[i])
+			}
+		}
+		if len(retry) == len(pending) {
+			t.Fatalf("no progress with %d pending ops", len(pending))
+		}
+		pending = retry
+	}
+	return d
+}
+
+func docJSON(t *testing.T, d *Document) string {
+	t.Helper()
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// 具体化した結果のうち、日時以外（木構造・ラベル・カラム）
+type view struct {
+	Name    string
+	Nodes   map[string][3]any // id -> {親, ラベル, 完了}
+	Columns repository.KanbanColumns
+}
+
+func materialized(d *Document) view {
+	m := Materialize(d, &repository.Minkan{Projects: repository.Projects{}})
+	v := view{Nodes: map[string][3]any{}, Columns: m.KanbanColumns}
+	pj := m.Projects["pj"]
+	v.Name = pj.Name
+	for _, n := range pj.Nodes {
+		parent := ""
+		if n.Data.ParentId != nil {
+			parent = *n.Data.ParentId
+		}
+		v.Nodes[n.Id] = [3]any{parent, n.Data.Label, n.Data.IsDone}
+	}
+	return v
+}
+
+func TestApplyConverges(t *testing.T) {
+	ops := scenario()
+	want := deliver(t, ops)
+	wantJSON := docJSON(t, want)
+	wantView := materialized(want)
+
+	// LWW・削除優先・循環の解消・RGAの並行挿入の結果
+	if wantView.Name != "Renamed by c" {
+		t.Errorf("project name = %q", wantView.Name)
+	}
+	if got := wantView.Nodes["n1"]; got[1] != "from b" || got[2] != true {
+		t.Errorf("n1 = %v, want label %q and done", got, "from b")
+	}
+	if wantView.Nodes["n2"][0] != minkan.RootNodeID || wantView.Nodes["n1"][0] != "n2" {
+		t.Errorf("cycle not resolved to the latest move: %v", wantView.Nodes)
+	}
+	todo := []repository.KanbanCardRef{{PjId: "pj", NodeId: "n2"}}
+	doing := []repository.KanbanCardRef{{PjId: "pj", NodeId: "n1"}}
+	if !reflect.DeepEqual(wantView.Columns.Todo, todo) || !reflect.DeepEqual(wantView.Columns.Doing, doing) {
+		t.Errorf("columns = %+v", wantView.Columns)
+	}
+
+	t.Run("commutative", func(t *testing.T) {
+		// 各端末の操作をまとめて、端末の順序を入れ替えて届ける
+		var a, b, c []Op
+		for _, op := range ops {
+			switch op.ID.Replica {
+			case "a":
+				a = append(a, op)
+			case "b":
+				b = append(b, op)
+			case "c":
+				c = append(c, op)
+			}
+		}
+		orders := [][][]Op{{a, b, c}, {a, c, b}}
+		for _, order := range orders {
+			var list []Op
+			for _, ops := range order {
+				list = append(list, ops...)
+			}
+			if got := docJSON(t, deliver(t, list)); got != wantJSON {
+				t.Errorf("diverged:\n got %s\nwant %s", got, wantJSON)
+			}
+		}
+	})
+
+	t.Run("idempotent", func(t *testing.T) {
+		d := deliver(t, ops)
+		for i := range ops {
+			if err := d.Apply(&ops[i]); err != nil {
+				t.Fatalf("reapply %s: %v", ops[i].ID, err)
+			}
+		}
+		if got := docJSON(t, d); got != wantJSON {
+			t.Errorf("reapplying changed the document:\n got %s\nwant %s", got, wantJSON)
+		}
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		for i := 0; i < 200; i++ {
+			shuffled := append([]Op(nil), ops...)
+			rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+			d := deliver(t, shuffled)
+			if got := docJSON(t, d); got != wantJSON {
+				t.Fatalf("diverged for order %v:\n got %s\nwant %s", opIDs(shuffled), got, wantJSON)
+			}
+			if got := materialized(d); !reflect.DeepEqual(got, wantView) {
+				t.Fatalf("materialized state diverged for order %v:\n got %+v\nwant %+v", opIDs(shuffled), got, wantView)
+			}
+		}
+	})
+}
+
+func opIDs(ops []Op) []string {
+	ids := make([]string, len(ops))
+	for i, op := range ops {
+		ids[i] = op.ID.String()
+	}
+	return ids
+}
+
+// 同じレプリカの操作がカウンタの逆順に届いても、先に届いた大きいカウンタで小さい方を捨てない
+func TestApplyLowerCounterAfterHigher(t *testing.T) {
+	d := NewDocument()
+	ops := []Op{
+		{ID: id(1, "a"), Kind: KindProjectCreate, PjID: "pj", Label: "Project"},
+		{ID: id(3, "a"), Kind: KindNodeCreate, PjID: "pj", NodeID: "n2", ParentID: minkan.RootNodeID},
+		{ID: id(2, "a"), Kind: KindNodeCreate, PjID: "pj", NodeID: "n1", ParentID: minkan.RootNodeID},
+	}
+	for i := range ops {
+		if err := d.Apply(&ops[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d.Projects["pj"].Nodes["n1"] == nil {
+		t.Fatal("op 2@a was dropped after 3@a")
+	}
+	if got, want := d.Applied["a"], []Span{{1, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("applied = %v, want %v", got, want)
+	}
+}
+
+func TestAppliedSpans(t *testing.T) {
+	a := Applied{}
+	for _, c := range []uint64{5, 1, 3, 2, 9, 10, 4, 7} {
+		a.Add(id(c, "r"))
+	}
+	if got, want := a["r"], []Span{{1, 5}, {7, 7}, {9, 10}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("spans = %v, want %v", got, want)
+	}
+
+	for c := uint64(0); c <= 11; c++ {
+		want := c >= 1 && c <= 5 || c == 7 || c == 9 || c == 10
+		if got := a.Has(id(c, "r")); got != want {
+			t.Errorf("Has(%d) = %v, want %v", c, got, want)
+		}
+	}
+	if a.Has(id(1, "other")) {
+		t.Error("counter of another replica reported as applied")
+	}
+}
+
+// 旧形式（レプリカごとの最大カウンタ）のスナップショットを読み込める
+func TestLegacyReplicasSnapshot(t *testing.T) {
+	var d Document
+	if err := json.Unmarshal([]byte(`{"clock": 4, "replicas": {"a": 4}, "projects": {}, "columns": {}}`), &d); err != nil {
+		t.Fatal(err)
+	}
+	d.normalize()
+
+	if !d.Seen(id(3, "a")) || d.Seen(id(5, "a")) {
+		t.Errorf("applied = %v", d.Applied)
+	}
+	if b, _ := json.Marshal(&d); strings.Contains(string(b), `"replicas"`) {
+		t.Errorf("legacy field written back: %s", b)
+	}
+}
@@ -0,0 +1,230 @@
+package crdt
+
+import (
+	"sort"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// ServerReplica はサーバが生成する操作のレプリカID
+const ServerReplica = "server"
+
+// importer はminkan_stateとドキュメントの差分を操作として生成する
+type importer struct {
+	d   *Document
+	ops []Op
+}
+
+// 操作を採番してドキュメントに適用し、記録する
+func (im *importer) emit(op Op) error {
+	op.ID = im.d.NextID(ServerReplica)
+	if err := im.d.Apply(&op); err != nil {
+		return err
+	}
+	im.ops = append(im.ops, op)
+	return nil
+}
+
+// PUT /minkan 等、CRDTを経由せずに更新されたminkan_stateをドキュメントに取り込む
+// ドキュメントをmと同じ内容にするための操作を生成・適用し、生成した操作を返す
+func (d *Document) Import(m *repository.Minkan) ([]Op, error) {
+	im := &importer{d: d}
+
+	pjIDs := make([]string, 0, len(m.Projects))
+	for pjID := range m.Projects {
+		pjIDs = append(pjIDs, pjID)
+	}
+	sort.Strings(pjIDs)
+
+	for _, pjID := range pjIDs {
+		if err := im.importProject(m.Projects[pjID]); err != nil {
+			return nil, err
+		}
+	}
+
+	// mに無いプロジェクトは削除
+	docPjIDs := make([]string, 0, len(d.Projects))
+	for pjID := range d.Projects {
+		docPjIDs = append(docPjIDs, pjID)
+	}
+	sort.Strings(docPjIDs)
+	for _, pjID := range docPjIDs {
+		if _, ok := m.Projects[pjID]; !ok && !d.Projects[pjID].Deleted {
+			if err := im.emit(Op{Kind: KindProjectDelete, PjID: pjID}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, name := range minkan.Columns {
+		if err := im.importColumn(name, *minkan.ColumnCards(&m.KanbanColumns, name)); err != nil {
+			return nil, err
+		}
+	}
+
+	return im.ops, nil
+}
+
+func (im *importer) importProject(pj repository.Project) error {
+	ps, ok := im.d.Projects[pj.Id]
+	if ok && ps.Deleted {
+		// 削除優先のため、削除済みプロジェクトは復活させない
+		return nil
+	}
+
+	if !ok {
+		if err := im.emit(Op{Kind: KindProjectCreate, PjID: pj.Id, Label: pj.Name}); err != nil {
+			return err
+		}
+		ps = im.d.Projects[pj.Id]
+	} else if ps.Name.Value != pj.Name {
+		if err := im.emit(Op{Kind: KindProjectRename, PjID: pj.Id, Label: pj.Name}); err != nil {
+			return err
+		}
+	}
+
+	nodes := map[string]repository.Node{}
+	children := map[string][]string{}
+	for _, n := range pj.Nodes {
+		nodes[n.Id] = n
+	}
+	for _, n := range pj.Nodes {
+		if n.Id == minkan.RootNodeID {
+			continue
+		}
+		parent := minkan.RootNodeID
+		if n.Data.ParentId != nil {
+			if _, ok := nodes[*n.Data.ParentId]; ok {
+				parent = *n.Data.ParentId
+			}
+		}
+		children[parent] = append(children[parent], n.Id)
+	}
+
+	// 親が先に作られるよう、ルートから幅優先で処理する
+	parents, visible := ps.resolve()
+	seen := map[string]bool{}
+	queue := []string{minkan.RootNodeID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		queue = append(queue, children[id]...)
+
+		n, ok := nodes[id]
+		if !ok {
+			continue // mにルートノードが無い場合
+		}
+		parent := ""
+		if id != minkan.RootNodeID {
+			parent = minkan.RootNodeID
+			if n.Data.ParentId != nil && nodes[*n.Data.ParentId].Id != "" {
+				parent = *n.Data.ParentId
+			}
+		}
+
+		if err := im.importNode(pj.Id, ps, n, parent, parents, visible); err != nil {
+			return err
+		}
+	}
+
+	// mに無いノードは削除
+	ids := make([]string, 0, len(ps.Nodes))
+	for id := range ps.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if _, ok := nodes[id]; !ok && id != minkan.RootNodeID && !ps.Nodes[id].Deleted {
+			if err := im.emit(Op{Kind: KindNodeDelete, PjID: pj.Id, NodeID: id}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) importNode(pjID string, ps *ProjectState, n repository.Node, parent string, parents map[string]string, visible map[string]bool) error {
+	pos := minkan.Position{X: n.Position.X, Y: n.Position.Y}
+
+	st, ok := ps.Nodes[n.Id]
+	if !ok {
+		if err := im.emit(Op{Kind: KindNodeCreate, PjID: pjID, NodeID: n.Id, ParentID: parent, Label: n.Data.Label, Position: &pos}); err != nil {
+			return err
+		}
+		if n.Data.IsDone {
+			return im.emit(Op{Kind: KindDoneSet, PjID: pjID, NodeID: n.Id, Done: true})
+		}
+		return nil
+	}
+
+	// 削除優先のため、削除済み(子孫を含む)のノードは復活させない
+	if st.Deleted || (n.Id != minkan.RootNodeID && !visible[n.Id]) {
+		return nil
+	}
+
+	if parent != "" && parents[n.Id] != parent {
+		if err := im.emit(Op{Kind: KindNodeMove, PjID: pjID, NodeID: n.Id, ParentID: parent}); err != nil {
+			return err
+		}
+	}
+	if st.Label.Value != n.Data.Label {
+		if err := im.emit(Op{Kind: KindLabelSet, PjID: pjID, NodeID: n.Id, Label: n.Data.Label}); err != nil {
+			return err
+		}
+	}
+	if st.Done.Value != n.Data.IsDone {
+		if err := im.emit(Op{Kind: KindDoneSet, PjID: pjID, NodeID: n.Id, Done: n.Data.IsDone}); err != nil {
+			return err
+		}
+	}
+	if st.Position.Value != pos {
+		if err := im.emit(Op{Kind: KindNodePosition, PjID: pjID, NodeID: n.Id, Position: &pos}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// カラムの並びがmと異なる場合は、表示中の要素を全て削除して並べ直す
+func (im *importer) importColumn(name string, want []repository.KanbanCardRef) error {
+	current := im.d.visibleCards()[name]
+
+	same := len(current) == len(want)
+	for i := 0; same && i < len(want); i++ {
+		same = current[i].Value == want[i]
+	}
+	if same {
+		return nil
+	}
+
+	// 重複・非表示カードを含め、このカラムの表示中要素は全て消す
+	for _, e := range im.d.Columns[name].Visible() {
+		target := e.ID
+		if err := im.emit(Op{Kind: KindCardDelete, PjID: e.Value.PjId, Column: name, Target: &target}); err != nil {
+			return err
+		}
+	}
+
+	var after *ID
+	for _, ref := range want {
+		pj, ok := im.d.Projects[ref.PjId]
+		if !ok || pj.Nodes[ref.NodeId] == nil {
+			continue // ドキュメントに存在しないノードのカードは取り込めない
+		}
+		op := Op{Kind: KindCardInsert, PjID: ref.PjId, NodeID: ref.NodeId, Column: name, After: after}
+		if err := im.emit(op); err != nil {
+			return err
+		}
+		id := im.ops[len(im.ops)-1].ID
+		after = &id
+	}
+
+	return nil
+}
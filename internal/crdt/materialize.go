@@ -0,0 +1,266 @@
+package crdt
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// 実効的な親ノードと表示対象ノードを求める
+// - 親が存在しないノードはルート直下に置く
+// - 並行した付け替えで循環した場合は、循環内で最も新しく付け替えられたノードをルート直下に置く
+// - 削除済みノードとその子孫は表示しない
+func (pj *ProjectState) resolve() (parents map[string]string, visible map[string]bool) {
+	ids := make([]string, 0, len(pj.Nodes))
+	for id := range pj.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parents = make(map[string]string, len(pj.Nodes))
+	for _, id := range ids {
+		if id == minkan.RootNodeID {
+			continue
+		}
+		parent := pj.Nodes[id].Parent.Value
+		if _, ok := pj.Nodes[parent]; !ok || parent == id {
+			parent = minkan.RootNodeID
+		}
+		parents[id] = parent
+	}
+
+	// 循環の解消（1周ごとに1つずつ切るので、結果はノードIDの順序のみに依存する）
+	for {
+		cycle := findCycle(ids, parents)
+		if cycle == nil {
+			break
+		}
+		latest := cycle[0]
+		for _, id := range cycle[1:] {
+			if pj.Nodes[latest].Parent.TS.Less(pj.Nodes[id].Parent.TS) {
+				latest = id
+			}
+		}
+		parents[latest] = minkan.RootNodeID
+	}
+
+	visible = make(map[string]bool, len(pj.Nodes))
+	for _, id := range ids {
+		ok := true
+		for cur := id; ; {
+			if pj.Nodes[cur].Deleted {
+				ok = false
+				break
+			}
+			parent, has := parents[cur]
+			if !has {
+				break
+			}
+			cur = parent
+		}
+		visible[id] = ok
+	}
+
+	return parents, visible
+}
+
+// 親の連鎖に循環があればその循環に含まれるノードを返す
+func findCycle(ids []string, parents map[string]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(ids))
+
+	for _, start := range ids {
+		if state[start] != unvisited {
+			continue
+		}
+
+		path := []string{}
+		cur := start
+		for {
+			if state[cur] == done {
+				break
+			}
+			if state[cur] == visiting {
+				// pathのうちcur以降が循環
+				for i, id := range path {
+					if id == cur {
+						return path[i:]
+					}
+				}
+			}
+			state[cur] = visiting
+			path = append(path, cur)
+
+			parent, ok := parents[cur]
+			if !ok {
+				break
+			}
+			cur = parent
+		}
+		for _, id := range path {
+			state[id] = done
+		}
+	}
+	return nil
+}
+
+// 表示対象のカード要素（同じカードが複数カラムにある場合は最も新しく挿入されたものを採用）
+func (d *Document) visibleCards() map[string][]Element {
+	latest := map[repository.KanbanCardRef]ID{}
+	for _, name := range minkan.Columns {
+		for _, e := range d.Columns[name].Visible() {
+			if cur, ok := latest[e.Value]; !ok || cur.Less(e.ID) {
+				latest[e.Value] = e.ID
+			}
+		}
+	}
+
+	// 表示対象ノードかどうかはプロジェクトごとに判定
+	visibleNodes := map[string]map[string]bool{}
+	for pjID, pj := range d.Projects {
+		if !pj.Deleted {
+			_, visibleNodes[pjID] = pj.resolve()
+		}
+	}
+
+	cards := make(map[string][]Element, len(minkan.Columns))
+	for _, name := range minkan.Columns {
+		list := []Element{}
+		for _, e := range d.Columns[name].Visible() {
+			if latest[e.Value] != e.ID || !visibleNodes[e.Value.PjId][e.Value.NodeId] {
+				continue
+			}
+			list = append(list, e)
+		}
+		cards[name] = list
+	}
+	return cards
+}
+
+// ドキュメントをminkan_stateに具体化する
+// CRDTで扱わない情報(コメント、作成日時、作業中プロジェクト等)はbaseから引き継ぐ
+func Materialize(d *Document, base *repository.Minkan) *repository.Minkan {
+	now := time.Now().UTC()
+
+	out := &repository.Minkan{
+		CurrentPjId: base.CurrentPjId,
+		Projects:    repository.Projects{},
+		KanbanIndex: repository.KanbanIndex{},
+	}
+
+	pjIDs := make([]string, 0, len(d.Projects))
+	for pjID, ps := range d.Projects {
+		if !ps.Deleted {
+			pjIDs = append(pjIDs, pjID)
+		}
+	}
+	sort.Strings(pjIDs)
+
+	for _, pjID := range pjIDs {
+		ps := d.Projects[pjID]
+		basePj, had := base.Projects[pjID]
+		parents, visible := ps.resolve()
+
+		// 既存ノードの並び順を維持し、新規ノードは作成順で末尾に追加
+		order := []string{}
+		known := map[string]repository.Node{}
+		for _, n := range basePj.Nodes {
+			known[n.Id] = n
+			if visible[n.Id] {
+				order = append(order, n.Id)
+			}
+		}
+		added := []string{}
+		for id, ok := range visible {
+			if _, exists := known[id]; ok && !exists {
+				added = append(added, id)
+			}
+		}
+		sort.Slice(added, func(i, j int) bool {
+			return ps.Nodes[added[i]].Created.Less(ps.Nodes[added[j]].Created)
+		})
+		order = append(order, added...)
+
+		baseEdges := map[[2]string]repository.Edge{}
+		for _, e := range basePj.Edges {
+			baseEdges[[2]string{e.Source, e.Target}] = e
+		}
+
+		pj := repository.Project{
+			Id:        pjID,
+			Name:      ps.Name.Value,
+			Nodes:     []repository.Node{},
+			Edges:     []repository.Edge{},
+			CreatedAt: basePj.CreatedAt,
+			UpdatedAt: basePj.UpdatedAt,
+		}
+		if !had {
+			pj.CreatedAt = now
+		}
+
+		for _, id := range order {
+			st := ps.Nodes[id]
+			parent := parents[id]
+
+			node, ok := known[id]
+			if !ok {
+				node = minkan.NewNode(id, parent, "")
+			}
+			node.Data.Label = st.Label.Value
+			node.Data.IsDone = st.Done.Value
+			if !st.Position.TS.IsZero() {
+				node.Position.X = st.Position.Value.X
+				node.Position.Y = st.Position.Value.Y
+			}
+
+			if parent == "" {
+				node.Data.ParentId = nil
+			} else {
+				p := parent
+				node.Data.ParentId = &p
+
+				edge, ok := baseEdges[[2]string{parent, id}]
+				if !ok {
+					edge = minkan.NewEdge(parent, id)
+				}
+				pj.Edges = append(pj.Edges, edge)
+			}
+
+			pj.Nodes = append(pj.Nodes, node)
+		}
+
+		if !had || pj.Name != basePj.Name ||
+			!reflect.DeepEqual(pj.Nodes, basePj.Nodes) || !reflect.DeepEqual(pj.Edges, basePj.Edges) {
+			pj.UpdatedAt = now
+		}
+
+		out.Projects[pjID] = pj
+		out.KanbanIndex[pjID] = []string{}
+	}
+
+	cards := d.visibleCards()
+	for _, name := range minkan.Columns {
+		col := minkan.ColumnCards(&out.KanbanColumns, name)
+		*col = []repository.KanbanCardRef{}
+		for _, e := range cards[name] {
+			*col = append(*col, e.Value)
+			out.KanbanIndex[e.Value.PjId] = append(out.KanbanIndex[e.Value.PjId], e.Value.NodeId)
+		}
+	}
+
+	if _, ok := out.Projects[out.CurrentPjId]; !ok {
+		out.CurrentPjId = ""
+		if len(pjIDs) > 0 {
+			out.CurrentPjId = pjIDs[0]
+		}
+	}
+
+	return out
+}
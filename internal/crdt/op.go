@@ -0,0 +1,105 @@
+package crdt
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+// ErrInvalidOp は操作が形式的に不正、または現在のドキュメントに適用できない場合のエラー
+var ErrInvalidOp = errors.New("invalid crdt operation")
+
+func invalidOp(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidOp, fmt.Sprintf(format, args...))
+}
+
+type OpKind string
+
+const (
+	KindProjectCreate OpKind = "project.create" // プロジェクト作成（Labelがプロジェクト名）
+	KindProjectRename OpKind = "project.rename" // プロジェクト名のLWW更新
+	KindProjectDelete OpKind = "project.delete" // プロジェクト削除（削除優先）
+
+	KindNodeCreate   OpKind = "node.create"   // ノード作成（ParentID, Label, Positionの初期値付き）
+	KindNodeMove     OpKind = "node.move"     // 親ノードのLWW更新（木構造）
+	KindNodePosition OpKind = "node.position" // 表示位置のLWW更新
+	KindNodeDelete   OpKind = "node.delete"   // ノード削除（子孫も非表示、削除優先）
+	KindLabelSet     OpKind = "label.set"     // ラベルのLWW更新
+	KindDoneSet      OpKind = "done.set"      // 完了フラグのLWW更新
+
+	KindCardInsert OpKind = "card.insert" // カラム(RGA)へのカード挿入
+	KindCardDelete OpKind = "card.delete" // カラム(RGA)からのカード削除
+)
+
+// Op はオフライン編集を表すCRDT操作
+// IDは操作ごとに一意で、同じ操作を何度適用しても結果は変わらない
+type Op struct {
+	ID       ID               `json:"id"`
+	Kind     OpKind           `json:"kind"`
+	PjID     string           `json:"pjId"`
+	NodeID   string           `json:"nodeId,omitempty"`
+	ParentID string           `json:"parentId,omitempty"` // node.create, node.move
+	Label    string           `json:"label,omitempty"`    // project.create, project.rename, node.create, label.set
+	Done     bool             `json:"done,omitempty"`     // done.set
+	Position *minkan.Position `json:"position,omitempty"` // node.create, node.position
+	Column   string           `json:"column,omitempty"`   // card.insert, card.delete
+	After    *ID              `json:"after,omitempty"`    // card.insert: 左隣の要素ID（nilは先頭）
+	Target   *ID              `json:"target,omitempty"`   // card.delete: 削除する要素ID
+}
+
+// ドキュメントに依存しない形式チェック
+func (op *Op) Validate() error {
+	if op.ID.Counter == 0 || op.ID.Replica == "" {
+		return invalidOp("id is required")
+	}
+	if op.PjID == "" {
+		return invalidOp("pjId is required")
+	}
+
+	switch op.Kind {
+	case KindProjectCreate, KindProjectRename, KindProjectDelete:
+		return nil
+
+	case KindNodeCreate:
+		if op.NodeID == "" || op.ParentID == "" {
+			return invalidOp("nodeId and parentId are required")
+		}
+	case KindNodeMove:
+		if op.NodeID == "" || op.ParentID == "" {
+			return invalidOp("nodeId and parentId are required")
+		}
+		if op.NodeID == minkan.RootNodeID {
+			return invalidOp("root node cannot be moved")
+		}
+	case KindNodePosition:
+		if op.NodeID == "" || op.Position == nil {
+			return invalidOp("nodeId and position are required")
+		}
+	case KindNodeDelete:
+		if op.NodeID == "" {
+			return invalidOp("nodeId is required")
+		}
+		if op.NodeID == minkan.RootNodeID {
+			return invalidOp("root node cannot be deleted")
+		}
+	case KindLabelSet, KindDoneSet:
+		if op.NodeID == "" {
+			return invalidOp("nodeId is required")
+		}
+
+	case KindCardInsert:
+		if op.NodeID == "" || !minkan.IsColumn(op.Column) {
+			return invalidOp("nodeId and a valid column are required")
+		}
+	case KindCardDelete:
+		if op.Target == nil || !minkan.IsColumn(op.Column) {
+			return invalidOp("target and a valid column are required")
+		}
+
+	default:
+		return invalidOp("unknown op kind %q", op.Kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package crdt
+
+import "github.com/yopi416/mind-kanban-backend/internal/repository"
+
+// Element はSequence上の1要素（削除されても墓石として残る）
+type Element struct {
+	ID      ID                       `json:"id"`
+	Value   repository.KanbanCardRef `json:"value"`
+	Deleted bool                     `json:"deleted,omitempty"`
+}
+
+// Sequence はカンバンのカラムを表すRGA(Replicated Growable Array)
+// 挿入は「左隣の要素ID」を基準に行い、同じ位置への並行挿入はIDの大きい方を左に置くことで収束させる
+type Sequence struct {
+	Elements []Element `json:"elements"`
+}
+
+// 要素IDの位置を返す（見つからない場合は-1）
+func (s *Sequence) indexOf(id ID) int {
+	for i := range s.Elements {
+		if s.Elements[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Sequence) Has(id ID) bool {
+	return s.indexOf(id) >= 0
+}
+
+// afterの右側にidの要素を挿入する（afterがnilの場合は先頭）
+// - 同じIDの要素が既にあれば何もしない（冪等）
+// - afterが存在しない場合はfalseを返す
+func (s *Sequence) Insert(after *ID, id ID, value repository.KanbanCardRef) bool {
+	if s.Has(id) {
+		return true
+	}
+
+	pos := 0
+	if after != nil {
+		idx := s.indexOf(*after)
+		if idx < 0 {
+			return false
+		}
+		pos = idx + 1
+	}
+
+	// 同じ基準位置に後から(より大きいIDで)挿入された要素は左側に残す
+	for pos < len(s.Elements) && id.Less(s.Elements[pos].ID) {
+		pos++
+	}
+
+	s.Elements = append(s.Elements, Element{})
+	copy(s.Elements[pos+1:], s.Elements[pos:])
+	s.Elements[pos] = Element{ID: id, Value: value}
+	return true
+}
+
+// 要素を墓石にする（存在しない場合はfalse）
+func (s *Sequence) Delete(id ID) bool {
+	idx := s.indexOf(id)
+	if idx < 0 {
+		return false
+	}
+	s.Elements[idx].Deleted = true
+	return true
+}
+
+// 削除されていない要素を順に返す
+func (s *Sequence) Visible() []Element {
+	list := make([]Element, 0, len(s.Elements))
+	for _, e := range s.Elements {
+		if !e.Deleted {
+			list = append(list, e)
+		}
+	}
+	return list
+}
@@ -0,0 +1,298 @@
+package crdt
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// minkan_statesの楽観ロック競合時のリトライ回数
+const maxSyncRetries = 3
+
+// SyncRequest はオフライン端末からの同期リクエスト
+type SyncRequest struct {
+	ReplicaID string `json:"replicaId"` // 端末(レプリカ)のID。送信する操作のIDのレプリカと一致させる
+	Since     int64  `json:"since"`     // 前回の同期で受け取ったseq（初回は0）
+	Ops       []Op   `json:"ops"`       // 前回の同期以降に端末で行った操作（カウンタ順）
+}
+
+// SeqOp は操作ログ上の位置(seq)付きの操作
+type SeqOp struct {
+	Seq int64 `json:"seq"`
+	Op  Op    `json:"op"`
+}
+
+// Rejection は適用できなかった操作とその理由
+type Rejection struct {
+	ID    ID     `json:"id"`
+	Error string `json:"error"`
+}
+
+// SyncResult は同期結果
+type SyncResult struct {
+	Seq      int64       `json:"seq"`                // 次回の同期でsinceに指定する値
+	Clock    uint64      `json:"clock"`              // サーバが観測した最大カウンタ（端末のLamportクロックを進める）
+	Version  int32       `json:"version"`            // 同期後のminkan_statesのversion
+	Ops      []SeqOp     `json:"ops"`                // 端末が未受信の他レプリカの操作（seq順）
+	Rejected []Rejection `json:"rejected"`           // 適用できなかった端末の操作
+	Reset    bool        `json:"reset"`              // trueの場合、端末はDocumentで状態を置き換える
+	Document *Document   `json:"document,omitempty"` // Reset時のみ
+}
+
+// Syncer はオフライン端末の操作ログをサーバのドキュメントへマージする
+// 操作ログは crdt_ops に、定期的に圧縮したドキュメントは crdt_snapshots に保存する
+type Syncer struct {
+	DB           *sql.DB
+	Repo         *repository.CRDTRepository
+	StateRepo    *repository.MinkanStatesRepository
+	Store        *minkan.Store // コミット後の更新通知用
+	CompactEvery int64         // スナップショットを作り直すまでの操作ログ件数
+}
+
+func NewSyncer(db *sql.DB, store *minkan.Store, compactEvery int64) *Syncer {
+	return &Syncer{
+		DB:           db,
+		Repo:         repository.NewCRDTRepository(db),
+		StateRepo:    repository.NewMinkanStatesRepository(db),
+		Store:        store,
+		CompactEvery: compactEvery,
+	}
+}
+
+// 端末の操作をマージし、端末が未受信の操作を返す
+func (s *Syncer) Sync(ctx context.Context, userID int64, req *SyncRequest) (*SyncResult, error) {
+	if req.ReplicaID == "" || req.ReplicaID == ServerReplica {
+		return nil, invalidOp("invalid replicaId")
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := s.sync(ctx, userID, req)
+		if errors.Is(err, repository.ErrOptimisticLock) && attempt < maxSyncRetries {
+			continue
+		}
+		return res, err
+	}
+}
+
+func (s *Syncer) sync(ctx context.Context, userID int64, req *SyncRequest) (*SyncResult, error) {
+	lg := slog.Default().With("module", "crdt", "userID", userID)
+
+	if err := s.Repo.EnsureSnapshot(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			lg.Error("rollback error", "err", err)
+		}
+	}()
+
+	// スナップショット行のロックで同一ユーザーの同期を直列化
+	snap, err := s.Repo.LockSnapshot(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil {
+		return nil, minkan.ErrStateNotFound
+	}
+
+	// スナップショット + 以降の操作ログでドキュメントを復元
+	doc := NewDocument()
+	bootstrap := snap.DocJSON == nil
+	if !bootstrap {
+		if err := json.Unmarshal(snap.DocJSON, doc); err != nil {
+			return nil, err
+		}
+		doc.normalize()
+	}
+
+	maxSeq := snap.UptoSeq
+	stored, err := s.Repo.ListOpsAfter(ctx, tx, userID, snap.UptoSeq)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range stored {
+		var op Op
+		if err := json.Unmarshal(row.OpJSON, &op); err != nil {
+			return nil, err
+		}
+		if err := doc.Apply(&op); err != nil {
+			// 保存済みの操作は受信時に検証済みのため、ここで失敗するのはデータ不整合
+			lg.Error("stored op could not be applied", "seq", row.Seq, "err", err)
+		}
+		maxSeq = row.Seq
+	}
+
+	state, err := s.StateRepo.FindStateByUserIDTx(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, minkan.ErrStateNotFound
+	}
+	base, err := minkan.Decode(state.StateJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// CRDTを経由せずに更新された内容(PUT /minkan 等)をサーバの操作として取り込む
+	// 初回はスナップショットに直接焼き込み、操作ログには残さない
+	if bootstrap || state.Version != snap.StateVersion {
+		imported, err := doc.Import(base)
+		if err != nil {
+			return nil, err
+		}
+		if !bootstrap {
+			for i := range imported {
+				seq, err := s.appendOp(ctx, tx, userID, &imported[i])
+				if err != nil {
+					return nil, err
+				}
+				if seq > 0 {
+					maxSeq = seq
+				}
+			}
+		}
+	}
+
+	// 端末の操作を適用して操作ログへ追記
+	rejected := []Rejection{}
+	accepted := 0
+	for i := range req.Ops {
+		op := &req.Ops[i]
+
+		if op.ID.Replica != req.ReplicaID {
+			rejected = append(rejected, Rejection{ID: op.ID, Error: "op id replica does not match replicaId"})
+			continue
+		}
+		if doc.Seen(op.ID) {
+			continue // 再送された操作
+		}
+		if err := doc.Apply(op); err != nil {
+			if !errors.Is(err, ErrInvalidOp) {
+				return nil, err
+			}
+			rejected = append(rejected, Rejection{ID: op.ID, Error: err.Error()})
+			continue
+		}
+
+		seq, err := s.appendOp(ctx, tx, userID, op)
+		if err != nil {
+			return nil, err
+		}
+		if seq > 0 {
+			maxSeq = seq
+		}
+		accepted++
+	}
+
+	// マージ結果をminkan_statesへ反映
+	version := state.Version
+	var newStateJSON json.RawMessage
+	if accepted > 0 {
+		newStateJSON, err = json.Marshal(Materialize(doc, base))
+		if err != nil {
+			return nil, err
+		}
+		if err := s.StateRepo.UpdateStateByUserIDTx(ctx, tx, newStateJSON, userID, state.Version); err != nil {
+			return nil, err
+		}
+		version++
+	}
+	snap.StateVersion = version
+
+	// 一定件数ごとにスナップショットを作り直し、1つ前のスナップショット以前の操作ログを削除
+	// （直近1区間分の操作ログは、少し遅れている端末へ差分で返せるよう残しておく）
+	if bootstrap || maxSeq-snap.UptoSeq >= s.CompactEvery {
+		if !bootstrap && snap.UptoSeq > snap.PrunedSeq {
+			if err := s.Repo.DeleteOpsUpTo(ctx, tx, userID, snap.UptoSeq); err != nil {
+				return nil, err
+			}
+			snap.PrunedSeq = snap.UptoSeq
+		}
+
+		docJSON, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+		snap.DocJSON = docJSON
+		snap.UptoSeq = maxSeq
+	}
+
+	if err := s.Repo.UpdateSnapshot(ctx, tx, snap); err != nil {
+		return nil, err
+	}
+
+	res := &SyncResult{
+		Seq:      maxSeq,
+		Clock:    doc.Clock,
+		Version:  version,
+		Ops:      []SeqOp{},
+		Rejected: rejected,
+	}
+
+	// 初回や、操作ログが既に削除されている場合はドキュメントごと返す
+	if req.Since <= 0 || req.Since < snap.PrunedSeq || req.Since > maxSeq {
+		res.Reset = true
+		res.Document = doc
+	} else {
+		missing, err := s.Repo.ListOpsAfter(ctx, tx, userID, req.Since)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range missing {
+			if row.ReplicaID == req.ReplicaID {
+				continue // 端末自身の操作は返さない
+			}
+			var op Op
+			if err := json.Unmarshal(row.OpJSON, &op); err != nil {
+				return nil, err
+			}
+			res.Ops = append(res.Ops, SeqOp{Seq: row.Seq, Op: op})
+		}
+	}
+
+	var change *minkan.Change
+	if accepted > 0 {
+		change = &minkan.Change{
+			UserID:    userID,
+			Version:   version,
+			Old:       state.StateJSON,
+			New:       newStateJSON,
+			Origin:    "sync:" + req.ReplicaID,
+			UpdatedAt: time.Now().UTC(),
+		}
+		if err := s.Store.Committing(ctx, tx, change); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if change != nil {
+		s.Store.Committed(ctx, change)
+	}
+
+	return res, nil
+}
+
+func (s *Syncer) appendOp(ctx context.Context, tx *sql.Tx, userID int64, op *Op) (int64, error) {
+	opJSON, err := json.Marshal(op)
+	if err != nil {
+		return 0, err
+	}
+	seq, _, err := s.Repo.AppendOp(ctx, tx, userID, op.ID.Replica, op.ID.Counter, opJSON)
+	return seq, err
+}
@@ -5,6 +5,7 @@ CREATE DATABASE IF NOT EXISTS minkan
 USE minkan;
 
 -- 2) users: OIDCとユーザー属性
+-- oidc_iss / oidc_sub は登録時のIdPの記録。ログイン時の照合は user_identities で行う
 CREATE TABLE users (
   user_id        BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
   oidc_iss       VARCHAR(255) NOT NULL,
@@ -15,7 +16,7 @@ CREATE TABLE users (
   created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   last_login_at  TIMESTAMP NULL,
-  UNIQUE KEY uk_users_oidc (oidc_iss, oidc_sub),
+  time_zone      VARCHAR(64) NULL,          -- IANAタイムゾーン名（NULLはサーバのデフォルト）
   UNIQUE KEY uk_users_email (email)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -24,8 +25,251 @@ CREATE TABLE users (
 CREATE TABLE minkan_states (
   user_id        BIGINT NOT NULL PRIMARY KEY,
   state_json     JSON   NOT NULL,          -- { currentPjID, projects:[...], kanbanIndex, kanbanColumns }
-  schema_version SMALLINT NOT NULL DEFAULT 1,  -- JSONスキーマのバージョン
+  schema_version SMALLINT NOT NULL DEFAULT 4,  -- JSONスキーマのバージョン
   version        INT  NOT NULL DEFAULT 1,   -- 楽観ロック用（FEがint64扱えないので32bitに)
   updated_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   CONSTRAINT fk_states_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 4) crdt_ops: オフライン同期(CRDT)の操作ログ
+-- seqはユーザーをまたいだ通し番号だが、ユーザー内でも単調増加するので差分取得に使う
+CREATE TABLE crdt_ops (
+  seq         BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id     BIGINT NOT NULL,
+  replica_id  VARCHAR(64) NOT NULL,      -- 操作を行った端末(レプリカ)
+  counter     BIGINT UNSIGNED NOT NULL,  -- Lamportカウンタ（replica_idと合わせて操作ID）
+  op_json     JSON NOT NULL,
+  received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uk_crdt_ops_op (user_id, replica_id, counter),
+  KEY idx_crdt_ops_user_seq (user_id, seq),
+  CONSTRAINT fk_crdt_ops_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 5) crdt_snapshots: 操作ログを圧縮したCRDTドキュメント（ユーザーごとに最新1件）
+CREATE TABLE crdt_snapshots (
+  user_id       BIGINT NOT NULL PRIMARY KEY,
+  doc_json      JSON NULL,                 -- NULLは未初期化（初回同期時にminkan_statesから作成）
+  upto_seq      BIGINT NOT NULL DEFAULT 0, -- doc_jsonに反映済みの操作ログの最大seq
+  pruned_seq    BIGINT NOT NULL DEFAULT 0, -- このseq以下の操作ログは削除済み
+  state_version INT NOT NULL DEFAULT 0,    -- CRDTの内容と一致しているminkan_states.version
+  updated_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  CONSTRAINT fk_crdt_snapshots_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 6) minkan_revisions: minkan_statesの更新ごとの差分（GET /minkan/changes 用）
+-- ユーザーごとに直近の一定件数のみ保持し、古いものは更新時に削除する
+CREATE TABLE minkan_revisions (
+  user_id    BIGINT NOT NULL,
+  version    INT NOT NULL,                -- 差分適用後のminkan_states.version
+  patch_json JSON NOT NULL,               -- version-1 → version のJSON Patch(RFC 6902)
+  origin     VARCHAR(128) NOT NULL DEFAULT '', -- 更新元（X-Minkan-Origin等）
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, version),
+  CONSTRAINT fk_minkan_revisions_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 7) node_schedules: ノードごとの発火予定（繰り返しタスク等）
+-- minkan_statesの更新時にユーザー単位で作り直す索引。スケジューラはfire_atの早い順に処理する
+CREATE TABLE node_schedules (
+  user_id BIGINT NOT NULL,
+  pj_id   VARCHAR(64) NOT NULL,
+  node_id VARCHAR(64) NOT NULL,
+  kind    VARCHAR(32) NOT NULL,   -- recurrence 等
+  fire_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (user_id, pj_id, node_id, kind, fire_at),
+  KEY idx_node_schedules_fire_at (fire_at),
+  CONSTRAINT fk_node_schedules_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 8) schedule_indexes: node_schedulesを作成した時点のminkan_states.version
+-- 更新フックの実行順が前後しても、古いstateで発火予定を上書きしないため
+CREATE TABLE schedule_indexes (
+  user_id       BIGINT NOT NULL PRIMARY KEY,
+  state_version INT NOT NULL DEFAULT 0,
+  CONSTRAINT fk_schedule_indexes_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 9) schedule_runs: 発火予定の実行記録
+-- 主キーで同じ予定の重複実行を防ぐ（複数インスタンス・再起動をまたいで1回だけ実行）
+CREATE TABLE schedule_runs (
+  user_id     BIGINT NOT NULL,
+  pj_id       VARCHAR(64) NOT NULL,
+  node_id     VARCHAR(64) NOT NULL,
+  kind        VARCHAR(32) NOT NULL,
+  fire_at     TIMESTAMP NOT NULL,
+  claimed_at  TIMESTAMP NULL,        -- 実行中のインスタンスが取得した日時（NULLは未取得・失敗後）
+  finished_at TIMESTAMP NULL,        -- 完了日時
+  attempts    INT NOT NULL DEFAULT 0,
+  last_error  VARCHAR(255) NULL,
+  PRIMARY KEY (user_id, pj_id, node_id, kind, fire_at),
+  KEY idx_schedule_runs_fire_at (fire_at),
+  CONSTRAINT fk_schedule_runs_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 10) notification_settings: ユーザーごとの通知設定（行が無い場合は全て有効として扱う）
+CREATE TABLE notification_settings (
+  user_id         BIGINT NOT NULL PRIMARY KEY,
+  email_reminders TINYINT(1) NOT NULL DEFAULT 1, -- 期限前のリマインダーメール
+  email_overdue   TINYINT(1) NOT NULL DEFAULT 1, -- 期限切れの通知メール
+  updated_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  CONSTRAINT fk_notification_settings_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 11) calendar_feeds: iCalendarフィード(.ics)の秘密URLのトークン（ユーザーごとに1件）
+-- トークン自体は保存せず、SHA-256のみで照合する。再発行時は置き換え、無効化時は削除する
+CREATE TABLE calendar_feeds (
+  user_id          BIGINT NOT NULL PRIMARY KEY,
+  token_hash       BINARY(32) NOT NULL,
+  created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_accessed_at TIMESTAMP NULL,       -- カレンダーアプリから最後に取得された日時
+  UNIQUE KEY uk_calendar_feeds_token (token_hash),
+  CONSTRAINT fk_calendar_feeds_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 12) app_passwords: CalDAV等のOIDCでログインできないクライアント用のアプリパスワード
+-- パスワード自体は保存せず、SHA-256のみで照合する（ランダム生成のため低速なハッシュは不要）
+CREATE TABLE app_passwords (
+  id            BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id       BIGINT NOT NULL,
+  name          VARCHAR(64) NOT NULL,      -- 利用者が付ける名前（例 "iPhone リマインダー"）
+  password_hash BINARY(32) NOT NULL,
+  created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at  TIMESTAMP NULL,
+  UNIQUE KEY uk_app_passwords_hash (password_hash),
+  KEY idx_app_passwords_user (user_id),
+  CONSTRAINT fk_app_passwords_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 13) webhooks: ユーザーが登録したWebhookの送信先
+CREATE TABLE webhooks (
+  id          BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id     BIGINT NOT NULL,
+  url         VARCHAR(2048) NOT NULL,
+  secret      VARCHAR(255) NOT NULL,      -- 署名(HMAC)の鍵（送信時に使うため平文）
+  event_types JSON NOT NULL,              -- 購読するイベントの種類の配列（例 ["card.done"]）
+  active      TINYINT(1) NOT NULL DEFAULT 1,
+  created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  KEY idx_webhooks_user (user_id),
+  CONSTRAINT fk_webhooks_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 14) webhook_deliveries: Webhookの配信キュー兼配信記録
+-- 送信に失敗した配信は指数バックオフで再送し、一定期間後に削除する
+CREATE TABLE webhook_deliveries (
+  id               BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  webhook_id       BIGINT NOT NULL,
+  user_id          BIGINT NOT NULL,
+  event_id         VARCHAR(64) NOT NULL,
+  event_type       VARCHAR(64) NOT NULL,
+  payload_json     JSON NOT NULL,
+  status           VARCHAR(16) NOT NULL DEFAULT 'pending', -- pending / succeeded / failed
+  attempts         INT NOT NULL DEFAULT 0,
+  next_attempt_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,  -- 次に送信する日時（送信中はリース期限）
+  last_status_code INT NULL,
+  last_error       VARCHAR(255) NULL,
+  created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  finished_at      TIMESTAMP NULL,
+  KEY idx_webhook_deliveries_due (status, next_attempt_at),
+  KEY idx_webhook_deliveries_webhook (webhook_id, id),
+  KEY idx_webhook_deliveries_created_at (created_at),
+  CONSTRAINT fk_webhook_deliveries_webhook FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 15) inbound_tokens: タスク取り込み用の秘密URL・メールアドレスのトークン（ユーザーごとに1件）
+-- トークン自体は保存せず、SHA-256のみで照合する。再発行時は置き換え、無効化時は削除する
+CREATE TABLE inbound_tokens (
+  user_id      BIGINT NOT NULL PRIMARY KEY,
+  token_hash   BINARY(32) NOT NULL,
+  pj_id        VARCHAR(64) NULL,         -- 取り込み先のプロジェクト（NULLの場合は作業中のプロジェクト）
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at TIMESTAMP NULL,
+  UNIQUE KEY uk_inbound_tokens_token (token_hash),
+  CONSTRAINT fk_inbound_tokens_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 16) activity_events: ユーザーの操作履歴（追記のみ）
+-- ログイン・ログアウト・退会申請と、stateの更新から検出したノード・カードの変更を記録する
+-- 退会申請の記録を残すためusersへの外部キーは張らない（退会時にそれ以外の履歴は削除する）
+CREATE TABLE activity_events (
+  id         BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id    BIGINT NOT NULL,
+  type       VARCHAR(64) NOT NULL,       -- 例 auth.login / node.created / card.moved
+  pj_id      VARCHAR(64) NULL,
+  node_id    VARCHAR(64) NULL,
+  origin     VARCHAR(128) NOT NULL DEFAULT '', -- 更新元（例 caldav / inbound:http / scheduler:recurrence）
+  version    INT NULL,                   -- 更新後のstateのversion（stateの更新以外はNULL）
+  data_json  JSON NOT NULL,              -- 種類ごとの詳細（ラベル・移動元/先のカラム、IPアドレス等）
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  KEY idx_activity_events_user (user_id, id),
+  KEY idx_activity_events_node (user_id, pj_id, node_id, id),
+  KEY idx_activity_events_created_at (user_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 17) card_transitions: カンバンのカードのカラム移動の記録（フロー分析に使う）
+-- stateの更新時にサーバ側で更新前後を比較して記録する。カンバン外は空文字で表す
+CREATE TABLE card_transitions (
+  id              BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id         BIGINT NOT NULL,
+  pj_id           VARCHAR(64) NOT NULL,
+  node_id         VARCHAR(64) NOT NULL,
+  from_column     VARCHAR(16) NOT NULL,     -- 移動元（''はカンバン外からの追加）
+  to_column       VARCHAR(16) NOT NULL,     -- 移動先（''はカンバンからの除外）
+  version         INT NOT NULL,             -- 移動を含む更新後のstateのversion
+  transitioned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  KEY idx_card_transitions_user (user_id, transitioned_at),
+  KEY idx_card_transitions_project (user_id, pj_id, transitioned_at),
+  CONSTRAINT fk_card_transitions_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 18) access_tokens: APIをスクリプト等から使うためのパーソナルアクセストークン
+-- トークン自体は保存せず、SHA-256のみで照合する。Authorization: Bearer で送る
+CREATE TABLE access_tokens (
+  id           BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id      BIGINT NOT NULL,
+  name         VARCHAR(64) NOT NULL,     -- 利用者が付ける名前（例 "バックアップ用cron"）
+  token_hash   BINARY(32) NOT NULL,
+  scope        VARCHAR(16) NOT NULL,     -- read（参照のみ） / write（更新も可）
+  expires_at   TIMESTAMP NULL,           -- 有効期限（NULLの場合は無期限）
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at TIMESTAMP NULL,
+  UNIQUE KEY uk_access_tokens_token (token_hash),
+  KEY idx_access_tokens_user (user_id),
+  CONSTRAINT fk_access_tokens_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 19) sessions: ログインセッション（SESSION_STORE=mysql の場合に使う）
+-- セッションID自体は保存せず、SHA-256のみで照合する。期限切れの行は取得時に無視する
+-- IdPのトークンはログアウト時の失効と、IdPでアカウントが有効かの定期的な確認に使う
+CREATE TABLE sessions (
+  id_hash        BINARY(32) NOT NULL PRIMARY KEY,
+  user_id        BIGINT NOT NULL,
+  created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_seen_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at     TIMESTAMP NOT NULL,
+  ip             VARCHAR(45) NOT NULL DEFAULT '',   -- IPv6を含む接続元アドレス
+  user_agent     VARCHAR(255) NOT NULL DEFAULT '',
+  provider       VARCHAR(32) NOT NULL DEFAULT '',   -- ログインに使ったIdP（AUTH_PROVIDERS の識別子）
+  idp_tokens     BLOB NULL,                         -- IdPのrefresh token・IDトークン（SESSION_TOKEN_KEY で暗号化）
+  idp_checked_at TIMESTAMP NULL,                    -- 最後にIdPで確認した日時（refresh tokenが無い場合はNULL）
+  KEY idx_sessions_user (user_id),
+  KEY idx_sessions_expires_at (expires_at),
+  KEY idx_sessions_idp_checked_at (idp_checked_at),
+  CONSTRAINT fk_sessions_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 20) user_identities: アカウントに紐付いたIdPのログイン（1アカウントに複数）
+-- 同じIdPのアカウントは1つのユーザーにのみ紐付く。最後の1件は解除できない
+CREATE TABLE user_identities (
+  id            BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id       BIGINT NOT NULL,
+  provider      VARCHAR(32) NOT NULL,      -- AUTH_PROVIDERS の識別子（表示用）
+  oidc_iss      VARCHAR(255) NOT NULL,
+  oidc_sub      VARCHAR(255) NOT NULL,
+  email         VARCHAR(320) NULL,         -- 連携時のIdPのメールアドレス（表示用）
+  created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_login_at TIMESTAMP NULL,
+  UNIQUE KEY uk_user_identities_oidc (oidc_iss, oidc_sub),
+  KEY idx_user_identities_user (user_id),
+  CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,25 @@
+-- 既存環境向けマイグレーション: オフライン同期(CRDT)用テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS crdt_ops (
+  seq         BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id     BIGINT NOT NULL,
+  replica_id  VARCHAR(64) NOT NULL,
+  counter     BIGINT UNSIGNED NOT NULL,
+  op_json     JSON NOT NULL,
+  received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uk_crdt_ops_op (user_id, replica_id, counter),
+  KEY idx_crdt_ops_user_seq (user_id, seq),
+  CONSTRAINT fk_crdt_ops_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS crdt_snapshots (
+  user_id       BIGINT NOT NULL PRIMARY KEY,
+  doc_json      JSON NULL,
+  upto_seq      BIGINT NOT NULL DEFAULT 0,
+  pruned_seq    BIGINT NOT NULL DEFAULT 0,
+  state_version INT NOT NULL DEFAULT 0,
+  updated_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  CONSTRAINT fk_crdt_snapshots_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
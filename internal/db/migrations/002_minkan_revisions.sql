@@ -0,0 +1,13 @@
+-- 既存環境向けマイグレーション: 変更フィード(GET /minkan/changes)用テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS minkan_revisions (
+  user_id    BIGINT NOT NULL,
+  version    INT NOT NULL,
+  patch_json JSON NOT NULL,
+  origin     VARCHAR(128) NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, version),
+  CONSTRAINT fk_minkan_revisions_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,12 @@
+-- 既存環境向けマイグレーション: ノードの期限・開始日時・優先度(schema_version 2)とユーザーのタイムゾーン
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+ALTER TABLE users
+  ADD COLUMN time_zone VARCHAR(64) NULL AFTER last_login_at;
+
+-- schema_version 2 は任意項目の追加のみのため、既存のJSONはそのまま2として扱える
+ALTER TABLE minkan_states
+  ALTER COLUMN schema_version SET DEFAULT 2;
+
+UPDATE minkan_states SET schema_version = 2 WHERE schema_version = 1;
@@ -0,0 +1,41 @@
+-- 既存環境向けマイグレーション: 繰り返しタスク(schema_version 3)とスケジューラ用テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+-- schema_version 3 は任意項目(recurrence)の追加のみのため、既存のJSONはそのまま3として扱える
+ALTER TABLE minkan_states
+  ALTER COLUMN schema_version SET DEFAULT 3;
+
+UPDATE minkan_states SET schema_version = 3 WHERE schema_version < 3;
+
+CREATE TABLE IF NOT EXISTS node_schedules (
+  user_id BIGINT NOT NULL,
+  pj_id   VARCHAR(64) NOT NULL,
+  node_id VARCHAR(64) NOT NULL,
+  kind    VARCHAR(32) NOT NULL,
+  fire_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (user_id, pj_id, node_id, kind, fire_at),
+  KEY idx_node_schedules_fire_at (fire_at),
+  CONSTRAINT fk_node_schedules_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS schedule_indexes (
+  user_id       BIGINT NOT NULL PRIMARY KEY,
+  state_version INT NOT NULL DEFAULT 0,
+  CONSTRAINT fk_schedule_indexes_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS schedule_runs (
+  user_id     BIGINT NOT NULL,
+  pj_id       VARCHAR(64) NOT NULL,
+  node_id     VARCHAR(64) NOT NULL,
+  kind        VARCHAR(32) NOT NULL,
+  fire_at     TIMESTAMP NOT NULL,
+  claimed_at  TIMESTAMP NULL,
+  finished_at TIMESTAMP NULL,
+  attempts    INT NOT NULL DEFAULT 0,
+  last_error  VARCHAR(255) NULL,
+  PRIMARY KEY (user_id, pj_id, node_id, kind, fire_at),
+  KEY idx_schedule_runs_fire_at (fire_at),
+  CONSTRAINT fk_schedule_runs_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
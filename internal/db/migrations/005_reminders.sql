@@ -0,0 +1,17 @@
+-- 既存環境向けマイグレーション: リマインダー(schema_version 4)と通知設定テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+-- schema_version 4 は任意項目(reminders)の追加のみのため、既存のJSONはそのまま4として扱える
+ALTER TABLE minkan_states
+  ALTER COLUMN schema_version SET DEFAULT 4;
+
+UPDATE minkan_states SET schema_version = 4 WHERE schema_version < 4;
+
+CREATE TABLE IF NOT EXISTS notification_settings (
+  user_id         BIGINT NOT NULL PRIMARY KEY,
+  email_reminders TINYINT(1) NOT NULL DEFAULT 1,
+  email_overdue   TINYINT(1) NOT NULL DEFAULT 1,
+  updated_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  CONSTRAINT fk_notification_settings_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,12 @@
+-- 既存環境向けマイグレーション: iCalendarフィードのトークンテーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+  user_id          BIGINT NOT NULL PRIMARY KEY,
+  token_hash       BINARY(32) NOT NULL,
+  created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_accessed_at TIMESTAMP NULL,
+  UNIQUE KEY uk_calendar_feeds_token (token_hash),
+  CONSTRAINT fk_calendar_feeds_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,15 @@
+-- 既存環境向けマイグレーション: CalDAV用のアプリパスワードテーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS app_passwords (
+  id            BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id       BIGINT NOT NULL,
+  name          VARCHAR(64) NOT NULL,
+  password_hash BINARY(32) NOT NULL,
+  created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at  TIMESTAMP NULL,
+  UNIQUE KEY uk_app_passwords_hash (password_hash),
+  KEY idx_app_passwords_user (user_id),
+  CONSTRAINT fk_app_passwords_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
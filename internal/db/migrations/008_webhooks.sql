@@ -0,0 +1,36 @@
+-- 既存環境向けマイグレーション: Webhookの送信先・配信キューテーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS webhooks (
+  id          BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id     BIGINT NOT NULL,
+  url         VARCHAR(2048) NOT NULL,
+  secret      VARCHAR(255) NOT NULL,
+  event_types JSON NOT NULL,
+  active      TINYINT(1) NOT NULL DEFAULT 1,
+  created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  KEY idx_webhooks_user (user_id),
+  CONSTRAINT fk_webhooks_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id               BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  webhook_id       BIGINT NOT NULL,
+  user_id          BIGINT NOT NULL,
+  event_id         VARCHAR(64) NOT NULL,
+  event_type       VARCHAR(64) NOT NULL,
+  payload_json     JSON NOT NULL,
+  status           VARCHAR(16) NOT NULL DEFAULT 'pending',
+  attempts         INT NOT NULL DEFAULT 0,
+  next_attempt_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_status_code INT NULL,
+  last_error       VARCHAR(255) NULL,
+  created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  finished_at      TIMESTAMP NULL,
+  KEY idx_webhook_deliveries_due (status, next_attempt_at),
+  KEY idx_webhook_deliveries_webhook (webhook_id, id),
+  KEY idx_webhook_deliveries_created_at (created_at),
+  CONSTRAINT fk_webhook_deliveries_webhook FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
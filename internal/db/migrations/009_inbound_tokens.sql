@@ -0,0 +1,13 @@
+-- 既存環境向けマイグレーション: タスク取り込み用トークンテーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS inbound_tokens (
+  user_id      BIGINT NOT NULL PRIMARY KEY,
+  token_hash   BINARY(32) NOT NULL,
+  pj_id        VARCHAR(64) NULL,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at TIMESTAMP NULL,
+  UNIQUE KEY uk_inbound_tokens_token (token_hash),
+  CONSTRAINT fk_inbound_tokens_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
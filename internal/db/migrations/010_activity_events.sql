@@ -0,0 +1,18 @@
+-- 既存環境向けマイグレーション: 操作履歴テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS activity_events (
+  id         BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id    BIGINT NOT NULL,
+  type       VARCHAR(64) NOT NULL,
+  pj_id      VARCHAR(64) NULL,
+  node_id    VARCHAR(64) NULL,
+  origin     VARCHAR(128) NOT NULL DEFAULT '',
+  version    INT NULL,
+  data_json  JSON NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  KEY idx_activity_events_user (user_id, id),
+  KEY idx_activity_events_node (user_id, pj_id, node_id, id),
+  KEY idx_activity_events_created_at (user_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+-- 既存環境向けマイグレーション: カードのカラム移動の記録テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS card_transitions (
+  id              BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id         BIGINT NOT NULL,
+  pj_id           VARCHAR(64) NOT NULL,
+  node_id         VARCHAR(64) NOT NULL,
+  from_column     VARCHAR(16) NOT NULL,
+  to_column       VARCHAR(16) NOT NULL,
+  version         INT NOT NULL,
+  transitioned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  KEY idx_card_transitions_user (user_id, transitioned_at),
+  KEY idx_card_transitions_project (user_id, pj_id, transitioned_at),
+  CONSTRAINT fk_card_transitions_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+-- 既存環境向けマイグレーション: パーソナルアクセストークンテーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS access_tokens (
+  id           BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id      BIGINT NOT NULL,
+  name         VARCHAR(64) NOT NULL,
+  token_hash   BINARY(32) NOT NULL,
+  scope        VARCHAR(16) NOT NULL,
+  expires_at   TIMESTAMP NULL,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at TIMESTAMP NULL,
+  UNIQUE KEY uk_access_tokens_token (token_hash),
+  KEY idx_access_tokens_user (user_id),
+  CONSTRAINT fk_access_tokens_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
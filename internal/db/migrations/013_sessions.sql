@@ -0,0 +1,16 @@
+-- 既存環境向けマイグレーション: ログインセッションテーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS sessions (
+  id_hash      BINARY(32) NOT NULL PRIMARY KEY,
+  user_id      BIGINT NOT NULL,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at   TIMESTAMP NOT NULL,
+  ip           VARCHAR(45) NOT NULL DEFAULT '',
+  user_agent   VARCHAR(255) NOT NULL DEFAULT '',
+  KEY idx_sessions_user (user_id),
+  KEY idx_sessions_expires_at (expires_at),
+  CONSTRAINT fk_sessions_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,30 @@
+-- 既存環境向けマイグレーション: 1つのアカウントに複数のIdPのログインを紐付ける user_identities テーブルの追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+CREATE TABLE IF NOT EXISTS user_identities (
+  id            BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id       BIGINT NOT NULL,
+  provider      VARCHAR(32) NOT NULL,
+  oidc_iss      VARCHAR(255) NOT NULL,
+  oidc_sub      VARCHAR(255) NOT NULL,
+  email         VARCHAR(320) NULL,
+  created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_login_at TIMESTAMP NULL,
+  UNIQUE KEY uk_user_identities_oidc (oidc_iss, oidc_sub),
+  KEY idx_user_identities_user (user_id),
+  CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 既存のユーザーのログイン（これまではGoogleのみ）を移行する
+INSERT IGNORE INTO user_identities (user_id, provider, oidc_iss, oidc_sub, email, created_at, last_login_at)
+SELECT user_id, 'google', oidc_iss, oidc_sub, NULLIF(email, ''), created_at, last_login_at
+FROM users;
+
+-- users.oidc_iss / oidc_sub は登録時のIdPの記録として残し、ログイン時の照合は user_identities で行う
+-- （連携を解除したIdPで別のアカウントを登録できるよう、一意制約は外す）
+ALTER TABLE users
+  DROP INDEX uk_users_oidc;
+
+-- メールアドレスの無いIdP（GitHubの非公開設定等）のユーザーが一意制約で衝突しないようNULLにする
+UPDATE users SET email = NULL WHERE email = '';
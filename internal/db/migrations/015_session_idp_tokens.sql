@@ -0,0 +1,10 @@
+-- 既存環境向けマイグレーション: セッションにIdPのトークンを保存する列の追加
+-- 新規環境は init.sql に含まれているため実行不要
+USE minkan;
+
+-- 既存のセッションはトークンが無いため、IdPでの確認・ログアウト時の失効の対象外になる
+ALTER TABLE sessions
+  ADD COLUMN provider       VARCHAR(32) NOT NULL DEFAULT '' AFTER user_agent,
+  ADD COLUMN idp_tokens     BLOB NULL AFTER provider,
+  ADD COLUMN idp_checked_at TIMESTAMP NULL AFTER idp_tokens,
+  ADD KEY idx_sessions_idp_checked_at (idp_checked_at);
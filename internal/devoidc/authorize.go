@@ -0,0 +1,170 @@
+package devoidc
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// 認可リクエストのうち、選択画面のフォームで引き継ぐ項目
+var authorizeParams = []string{"client_id", "redirect_uri", "response_type", "scope", "state", "nonce", "code_challenge", "code_challenge_method"}
+
+var pickerTemplate = template.Must(template.New("picker").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><title>Development login</title></head>
+<body>
+<h1>Development login</h1>
+<p>開発用のIdPです。ログインするユーザーを選んでください。</p>
+{{range .Users}}
+<form method="post" action="authorize">
+  {{range $k, $v := $.Params}}<input type="hidden" name="{{$k}}" value="{{$v}}">{{end}}
+  <input type="hidden" name="sub" value="{{.Subject}}">
+  <button type="submit">{{.Name}} &lt;{{.Email}}&gt;</button>
+</form>
+{{end}}
+<h2>Other user</h2>
+<form method="post" action="authorize">
+  {{range $k, $v := .Params}}<input type="hidden" name="{{$k}}" value="{{$v}}">{{end}}
+  <label>Email <input type="email" name="email" required></label>
+  <label>Name <input type="text" name="name"></label>
+  <label><input type="checkbox" name="email_verified" value="true" checked> email verified</label>
+  <button type="submit">Sign in</button>
+</form>
+</body>
+</html>
+`))
+
+// ユーザーの選択画面
+func (p *Provider) authorizePage(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if msg := p.validateAuthorize(q); msg != "" {
+		http.Error(w, msg, http.StatusBadRequest)
+		return
+	}
+
+	params := make(map[string]string)
+	for _, k := range authorizeParams {
+		if v := q.Get(k); v != "" {
+			params[k] = v
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+
+	err := pickerTemplate.Execute(w, map[string]any{
+		"Users":  p.cfg.Users,
+		"Params": params,
+	})
+	if err != nil {
+		slog.Error("devoidc: failed to render picker", "err", err)
+	}
+}
+
+// 選択されたユーザーで認可コードを発行し、コールバックURLへ302
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	form := r.PostForm
+	if msg := p.validateAuthorize(form); msg != "" {
+		http.Error(w, msg, http.StatusBadRequest)
+		return
+	}
+
+	user, ok := p.pickUser(form)
+	if !ok {
+		http.Error(w, "unknown user", http.StatusBadRequest)
+		return
+	}
+
+	code, err := randomToken()
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		slog.Error("devoidc: generate code failed", "err", err)
+		return
+	}
+
+	p.mu.Lock()
+	p.codes[code] = &authCode{
+		grant: grant{
+			User:      user,
+			ClientID:  form.Get("client_id"),
+			Nonce:     form.Get("nonce"),
+			Scope:     form.Get("scope"),
+			ExpiresAt: time.Now().Add(codeTTL),
+		},
+		RedirectURI:         form.Get("redirect_uri"),
+		CodeChallenge:       form.Get("code_challenge"),
+		CodeChallengeMethod: form.Get("code_challenge_method"),
+	}
+	p.mu.Unlock()
+
+	// redirect_uri は登録済みのURLと完全一致を確認済み
+	u, _ := url.Parse(form.Get("redirect_uri"))
+	rq := u.Query()
+	rq.Set("code", code)
+	if state := form.Get("state"); state != "" {
+		rq.Set("state", state)
+	}
+	u.RawQuery = rq.Encode()
+
+	slog.Info("devoidc: authorized", "sub", user.Subject)
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// 認可リクエストを検証する（問題がある場合はその内容。リダイレクトせずに400で返す）
+func (p *Provider) validateAuthorize(v url.Values) string {
+	switch {
+	case v.Get("client_id") != p.cfg.ClientID:
+		return "unknown client_id"
+	case !slices.Contains(p.cfg.RedirectURIs, v.Get("redirect_uri")):
+		return "redirect_uri not registered"
+	case v.Get("response_type") != "code":
+		return "unsupported response_type"
+	case !slices.Contains(strings.Fields(v.Get("scope")), "openid"):
+		return "scope must include openid"
+	}
+
+	switch v.Get("code_challenge_method") {
+	case "", "plain", "S256":
+	default:
+		return "unsupported code_challenge_method"
+	}
+	return ""
+}
+
+// フォームから選択されたユーザーを返す（任意のメールアドレスの場合は sub をメールアドレスから作る）
+func (p *Provider) pickUser(form url.Values) (User, bool) {
+	if sub := form.Get("sub"); sub != "" {
+		for _, u := range p.cfg.Users {
+			if u.Subject == sub {
+				return u, true
+			}
+		}
+		return User{}, false
+	}
+
+	email := strings.ToLower(strings.TrimSpace(form.Get("email")))
+	if email == "" {
+		return User{}, false
+	}
+
+	name := strings.TrimSpace(form.Get("name"))
+	if name == "" {
+		name = email
+	}
+
+	return User{
+		Subject:       "dev-" + email,
+		Name:          name,
+		Email:         email,
+		EmailVerified: form.Get("email_verified") == "true",
+	}, true
+}
@@ -0,0 +1,188 @@
+// Package devoidc は開発・結合テスト用の組み込みOpenID Provider
+// APP_ENV=development で AUTH_PROVIDERS に dev を含む場合のみ /dev/oidc にマウントする
+// 選択画面でログインするユーザーを選ぶだけで、署名付きのIDトークンを発行する（パスワード等の確認は行わない）
+package devoidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// 発行するトークンの有効期間
+const (
+	codeTTL  = time.Minute
+	tokenTTL = time.Hour
+)
+
+// User は選択画面に並べるユーザー
+type User struct {
+	Subject       string
+	Name          string
+	Email         string
+	EmailVerified bool
+}
+
+// 選択画面に並べるユーザーの既定値（任意のメールアドレスのユーザーも入力できる）
+var DefaultUsers = []User{
+	{Subject: "dev-alice", Name: "Alice", Email: "alice@example.com", EmailVerified: true},
+	{Subject: "dev-bob", Name: "Bob", Email: "bob@example.com", EmailVerified: true},
+	{Subject: "dev-carol", Name: "Carol (unverified)", Email: "carol@example.com", EmailVerified: false},
+}
+
+// Config は開発用のOPの設定
+type Config struct {
+	Issuer       string // PUBLIC_BASE_URL + /dev/oidc
+	ClientID     string
+	ClientSecret string
+	RedirectURIs []string // 登録済みのコールバックURL（完全一致のみ許可）
+	Users        []User   // 空の場合は DefaultUsers
+
+	PostLogoutRedirectURIs []string // ログアウト後の遷移先（完全一致のみ許可）
+}
+
+// Provider は開発用のOP
+type Provider struct {
+	cfg    Config
+	signer jose.Signer
+	jwks   jose.JSONWebKeySet
+	mux    *http.ServeMux
+
+	mu            sync.Mutex
+	codes         map[string]*authCode // 認可コード ⇒ 認可リクエストの内容（1回のみ使用可）
+	accessTokens  map[string]*grant    // アクセストークン ⇒ ユーザー（userinfo用）
+	refreshTokens map[string]*grant    // refresh token ⇒ ユーザー（失効させるまで有効）
+}
+
+// 発行済みの認可コード・トークンの内容
+type grant struct {
+	User      User
+	ClientID  string
+	Nonce     string
+	Scope     string
+	ExpiresAt time.Time
+}
+
+type authCode struct {
+	grant
+	RedirectURI         string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// 署名鍵は起動ごとに生成する（再起動前に発行したIDトークンは検証できなくなるが、開発用のため問題ない）
+func New(cfg Config) (*Provider, error) {
+	if len(cfg.Users) == 0 {
+		cfg.Users = DefaultUsers
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	kidBytes := make([]byte, 8)
+	if _, err := rand.Read(kidBytes); err != nil {
+		return nil, err
+	}
+	kid := hex.EncodeToString(kidBytes)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: key, KeyID: kid}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		cfg:    cfg,
+		signer: signer,
+		jwks: jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"},
+		}},
+		mux:           http.NewServeMux(),
+		codes:         make(map[string]*authCode),
+		accessTokens:  make(map[string]*grant),
+		refreshTokens: make(map[string]*grant),
+	}
+
+	p.mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	p.mux.HandleFunc("GET /keys", p.keys)
+	p.mux.HandleFunc("GET /authorize", p.authorizePage)
+	p.mux.HandleFunc("POST /authorize", p.authorize)
+	p.mux.HandleFunc("POST /token", p.token)
+	p.mux.HandleFunc("GET /userinfo", p.userinfo)
+	p.mux.HandleFunc("POST /revoke", p.revoke)
+	p.mux.HandleFunc("GET /end_session", p.endSession)
+	p.mux.HandleFunc("GET /grants", p.grantsPage)
+	p.mux.HandleFunc("POST /grants/revoke", p.revokeGrants)
+
+	slog.Warn("development OpenID Provider enabled", "issuer", cfg.Issuer)
+	return p, nil
+}
+
+// ServeHTTP は issuer からの相対パス（/authorize 等）で処理する（マウント時は http.StripPrefix を使う）
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, _ *http.Request) {
+	iss := p.cfg.Issuer
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                iss,
+		"authorization_endpoint":                iss + "/authorize",
+		"token_endpoint":                        iss + "/token",
+		"userinfo_endpoint":                     iss + "/userinfo",
+		"jwks_uri":                              iss + "/keys",
+		"revocation_endpoint":                   iss + "/revoke",
+		"end_session_endpoint":                  iss + "/end_session",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code", "refresh_token"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(jose.RS256)},
+		"scopes_supported":                      []string{"openid", "email", "profile", "offline_access"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"code_challenge_methods_supported":      []string{"S256", "plain"},
+		"claims_supported":                      []string{"sub", "name", "email", "email_verified"},
+	})
+}
+
+func (p *Provider) keys(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, p.jwks)
+}
+
+// 認可コード・アクセストークン・refresh token用のランダム文字列
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("devoidc: failed to encode response", "err", err)
+	}
+}
+
+// OAuth2のエラーレスポンス（RFC 6749 5.2）
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
@@ -0,0 +1,193 @@
+package devoidc
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/auth"
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+)
+
+// ログイン（IdPへのリダイレクト）→ ユーザーの選択 → コールバック → セッションの発行 までを
+// APIと同じ構成（/dev/oidc へのマウント、Transport経由のdiscovery・トークン交換）で通す
+func TestLoginFlow(t *testing.T) {
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	pc := configs.AuthProviderConfig{
+		ID:           configs.DevAuthProviderID,
+		Type:         configs.AuthProviderTypeOIDC,
+		Issuer:       srv.URL + configs.DevOIDCPath,
+		ClientID:     "minkan-dev",
+		ClientSecret: "minkan-dev-secret",
+		Scopes:       []string{"openid", "email", "profile", "offline_access"},
+		EnablePKCE:   true,
+		RedirectURL:  srv.URL + "/v1/auth/callback/" + configs.DevAuthProviderID,
+	}
+	op, err := New(Config{
+		Issuer:       pc.Issuer,
+		ClientID:     pc.ClientID,
+		ClientSecret: pc.ClientSecret,
+		RedirectURIs: []string{pc.RedirectURL},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &configs.ConfigList{
+		AuthProviders:     []configs.AuthProviderConfig{pc},
+		OIDCCookieKey:     base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		CookieSessionName: "session_id",
+		CookieCSRFName:    "csrf_token",
+		CookiePath:        "/",
+		CookieSameSite:    http.SameSiteLaxMode,
+	}
+	reg, err := auth.NewRegistry(cfg, op.Transport(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reg.Run(ctx)
+
+	p := reg.Default()
+	for deadline := time.Now().Add(5 * time.Second); !p.Ready(); {
+		if time.Now().After(deadline) {
+			t.Fatal("provider not ready")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// コールバックはユーザーの登録（DB）を除いて GetAuthCallbackProvider と同じ手順でセッションを発行する
+	sm := session.NewSessionManager(session.NewMemoryStore(), time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	policy := cookies.NewPolicy(cfg)
+	var logins []*auth.Identity
+
+	mux.Handle(configs.DevOIDCPath+"/", http.StripPrefix(configs.DevOIDCPath, op))
+	mux.HandleFunc("GET /v1/auth/login", p.Login)
+	mux.HandleFunc("GET /v1/auth/callback/dev", func(w http.ResponseWriter, r *http.Request) {
+		p.Callback(w, r, func(w http.ResponseWriter, r *http.Request, id *auth.Identity) {
+			logins = append(logins, id)
+			meta := session.MetaFromRequest(r)
+			meta.Provider = id.Provider
+			meta.IdPTokens = session.IdPTokens{RefreshToken: id.RefreshToken, IDToken: id.IDToken}
+			sessionID, err := sm.CreateSession(r.Context(), 1, meta)
+			if err != nil {
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			policy.SetSession(w, sessionID, sm.CSRFToken(sessionID), sm.GetTTL())
+			http.Redirect(w, r, "/app", http.StatusFound)
+		})
+	})
+
+	// ブラウザの代わり（Cookieを保持し、リダイレクトは1つずつ確認する）
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	// 1. ログイン: state・PKCEのCookieを発行し、OPの認可エンドポイントへ
+	authURL := redirectTo(t, client, srv.URL+"/v1/auth/login", nil)
+	if !strings.HasPrefix(authURL.String(), pc.Issuer+"/authorize?") {
+		t.Fatalf("login redirected to %s", authURL)
+	}
+	q := authURL.Query()
+	if q.Get("client_id") != pc.ClientID || q.Get("redirect_uri") != pc.RedirectURL || q.Get("state") == "" || q.Get("code_challenge_method") != "S256" {
+		t.Errorf("authorize request = %v", q)
+	}
+
+	// 2. OPの選択画面でユーザーを選ぶ
+	res, err := client.Get(authURL.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("picker = %d", res.StatusCode)
+	}
+	q.Set("sub", "dev-alice")
+	callbackURL := redirectTo(t, client, pc.Issuer+"/authorize", q)
+	if !strings.HasPrefix(callbackURL.String(), pc.RedirectURL+"?") || callbackURL.Query().Get("code") == "" {
+		t.Fatalf("authorize redirected to %s", callbackURL)
+	}
+
+	// 3. コールバック: 認可コードをトークンに交換してセッションを発行し、フロントへ
+	if u := redirectTo(t, client, callbackURL.String(), nil); u.Path != "/app" {
+		t.Fatalf("callback redirected to %s", u)
+	}
+	if len(logins) != 1 {
+		t.Fatalf("logins = %d", len(logins))
+	}
+	id := logins[0]
+	if id.Provider != configs.DevAuthProviderID || id.Issuer != pc.Issuer || id.Subject != "dev-alice" ||
+		id.Email != "alice@example.com" || !id.EmailVerified || id.Name != "Alice" {
+		t.Errorf("identity = %+v", id)
+	}
+
+	// 4. 発行されたCookieのセッションが有効で、IdPのトークンを保持している
+	var sessionID string
+	for _, c := range jar.Cookies(authURL) {
+		if c.Name == cfg.CookieSessionName {
+			sessionID = c.Value
+		}
+	}
+	s, _ := sm.ValidateSession(context.Background(), sessionID)
+	if s == nil || s.UserID != 1 || s.Provider != configs.DevAuthProviderID {
+		t.Fatalf("session = %+v", s)
+	}
+	tokens, err := sm.IdPTokens(s)
+	if err != nil || tokens.IDToken == "" || tokens.RefreshToken == "" {
+		t.Errorf("session tokens = %+v, %v", tokens, err)
+	}
+
+	// 同じコールバックURLを再度開いてもログインしない（stateのCookie・認可コードは1回のみ）
+	res, err = client.Get(callbackURL.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode == http.StatusFound || len(logins) != 1 {
+		t.Errorf("replayed callback = %d, logins = %d", res.StatusCode, len(logins))
+	}
+}
+
+// リクエストを送り、302のリダイレクト先を返す（formがある場合はPOST）
+func redirectTo(t *testing.T, client *http.Client, target string, form url.Values) *url.URL {
+	t.Helper()
+	var res *http.Response
+	var err error
+	if form != nil {
+		res, err = client.PostForm(target, form)
+	} else {
+		res, err = client.Get(target)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("%s = %d", target, res.StatusCode)
+	}
+	u, err := res.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
@@ -0,0 +1,116 @@
+package devoidc
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+)
+
+var signedOutTemplate = template.Must(template.New("signed-out").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><title>Signed out</title></head>
+<body>
+<h1>Signed out</h1>
+<p>開発用のIdPからログアウトしました。</p>
+</body>
+</html>
+`))
+
+var grantsTemplate = template.Must(template.New("grants").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><title>Development grants</title></head>
+<body>
+<h1>Development grants</h1>
+<p>refresh tokenを失効させると、IdPでアカウントを無効にした場合と同じく、次回のIdPでの確認でセッションが削除されます。</p>
+{{range .}}
+<form method="post" action="grants/revoke">
+  <input type="hidden" name="sub" value="{{.Subject}}">
+  {{.Subject}}（refresh token {{.Count}}件）
+  <button type="submit">Revoke</button>
+</form>
+{{else}}
+<p>有効なrefresh tokenはありません。</p>
+{{end}}
+</body>
+</html>
+`))
+
+// RP-Initiated Logout（OPのセッションは持たないため、登録済みの遷移先へ戻すのみ）
+func (p *Provider) endSession(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	redirectURI := q.Get("post_logout_redirect_uri")
+	if redirectURI != "" && slices.Contains(p.cfg.PostLogoutRedirectURIs, redirectURI) {
+		u, _ := url.Parse(redirectURI)
+		if state := q.Get("state"); state != "" {
+			rq := u.Query()
+			rq.Set("state", state)
+			u.RawQuery = rq.Encode()
+		}
+		slog.Info("devoidc: end session")
+		http.Redirect(w, r, u.String(), http.StatusFound)
+		return
+	}
+
+	// 未登録の遷移先にはリダイレクトしない
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := signedOutTemplate.Execute(w, nil); err != nil {
+		slog.Error("devoidc: failed to render signed out page", "err", err)
+	}
+}
+
+// ユーザーごとの有効なrefresh tokenの一覧
+func (p *Provider) grantsPage(w http.ResponseWriter, _ *http.Request) {
+	type userGrants struct {
+		Subject string
+		Count   int
+	}
+
+	p.mu.Lock()
+	counts := make(map[string]int)
+	for _, g := range p.refreshTokens {
+		counts[g.User.Subject]++
+	}
+	p.mu.Unlock()
+
+	list := make([]userGrants, 0, len(counts))
+	for sub, n := range counts {
+		list = append(list, userGrants{Subject: sub, Count: n})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Subject < list[j].Subject })
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := grantsTemplate.Execute(w, list); err != nil {
+		slog.Error("devoidc: failed to render grants", "err", err)
+	}
+}
+
+// ユーザーのrefresh token・アクセストークンを全て失効させる（IdPでのアカウントの無効化の再現）
+func (p *Provider) revokeGrants(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	sub := r.PostForm.Get("sub")
+
+	p.mu.Lock()
+	for token, g := range p.refreshTokens {
+		if g.User.Subject == sub {
+			delete(p.refreshTokens, token)
+		}
+	}
+	for token, g := range p.accessTokens {
+		if g.User.Subject == sub {
+			delete(p.accessTokens, token)
+		}
+	}
+	p.mu.Unlock()
+
+	slog.Info("devoidc: grants revoked", "sub", sub)
+	http.Redirect(w, r, p.cfg.Issuer+"/grants", http.StatusSeeOther)
+}
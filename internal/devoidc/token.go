@@ -0,0 +1,242 @@
+package devoidc
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// 認可コード・refresh tokenをIDトークン・アクセストークンに交換する
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "invalid form")
+		return
+	}
+
+	if !p.authenticateClient(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="devoidc"`)
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		return
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		p.exchangeCode(w, r)
+	case "refresh_token":
+		p.exchangeRefreshToken(w, r)
+	default:
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "only authorization_code and refresh_token are supported")
+	}
+}
+
+// 認可コードをトークンに交換する（offline_access スコープの場合はrefresh tokenも発行する）
+func (p *Provider) exchangeCode(w http.ResponseWriter, r *http.Request) {
+	// 認可コードは1回のみ使用可（失敗した場合も破棄する）
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	ac := p.codes[code]
+	delete(p.codes, code)
+	p.mu.Unlock()
+
+	if ac == nil || !time.Now().Before(ac.ExpiresAt) {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "invalid or expired code")
+		return
+	}
+	if ac.ClientID != p.cfg.ClientID || ac.RedirectURI != r.PostForm.Get("redirect_uri") {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "redirect_uri mismatch")
+		return
+	}
+	if !verifyPKCE(ac.CodeChallenge, ac.CodeChallengeMethod, r.PostForm.Get("code_verifier")) {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "code_verifier mismatch")
+		return
+	}
+
+	p.issueTokens(w, ac.grant, slices.Contains(strings.Fields(ac.Scope), "offline_access"))
+}
+
+// refresh tokenでトークンを再発行する（refresh tokenも再発行し、元のものは使えなくなる）
+func (p *Provider) exchangeRefreshToken(w http.ResponseWriter, r *http.Request) {
+	rt := r.PostForm.Get("refresh_token")
+	p.mu.Lock()
+	g := p.refreshTokens[rt]
+	delete(p.refreshTokens, rt)
+	p.mu.Unlock()
+
+	if g == nil || g.ClientID != p.cfg.ClientID {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "invalid or revoked refresh token")
+		return
+	}
+
+	// 再発行したIDトークンには nonce を含めない（OpenID Connect Core 12.2）
+	ng := *g
+	ng.Nonce = ""
+	p.issueTokens(w, ng, true)
+}
+
+// IDトークン・アクセストークン（withRefreshの場合はrefresh tokenも）を発行する
+func (p *Provider) issueTokens(w http.ResponseWriter, g grant, withRefresh bool) {
+	now := time.Now()
+	idToken, err := p.signIDToken(&g, now)
+	if err != nil {
+		writeOAuthError(w, http.StatusInternalServerError, "server_error", "sign id token failed")
+		slog.Error("devoidc: sign id token failed", "err", err)
+		return
+	}
+
+	accessToken, err := randomToken()
+	if err != nil {
+		writeOAuthError(w, http.StatusInternalServerError, "server_error", "generate token failed")
+		slog.Error("devoidc: generate access token failed", "err", err)
+		return
+	}
+
+	res := map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(tokenTTL.Seconds()),
+		"id_token":     idToken,
+		"scope":        g.Scope,
+	}
+
+	var refreshToken string
+	if withRefresh {
+		refreshToken, err = randomToken()
+		if err != nil {
+			writeOAuthError(w, http.StatusInternalServerError, "server_error", "generate token failed")
+			slog.Error("devoidc: generate refresh token failed", "err", err)
+			return
+		}
+		res["refresh_token"] = refreshToken
+	}
+
+	ag := g
+	ag.ExpiresAt = now.Add(tokenTTL)
+	p.mu.Lock()
+	p.accessTokens[accessToken] = &ag
+	if refreshToken != "" {
+		// refresh tokenは失効（/revoke・/grants）されるまで使える
+		rg := g
+		rg.ExpiresAt = time.Time{}
+		p.refreshTokens[refreshToken] = &rg
+	}
+	p.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// トークンを失効させる（RFC 7009。無効なトークンの場合も200を返す）
+func (p *Provider) revoke(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "invalid form")
+		return
+	}
+
+	if !p.authenticateClient(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="devoidc"`)
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	p.mu.Lock()
+	_, isRefresh := p.refreshTokens[token]
+	delete(p.refreshTokens, token)
+	delete(p.accessTokens, token)
+	p.mu.Unlock()
+
+	if isRefresh {
+		slog.Info("devoidc: refresh token revoked")
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// アクセストークンのユーザーの情報を返す
+func (p *Provider) userinfo(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	p.mu.Lock()
+	g := p.accessTokens[token]
+	p.mu.Unlock()
+
+	if !ok || g == nil || !time.Now().Before(g.ExpiresAt) {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_token", "invalid or expired access token")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, userClaims(g.User))
+}
+
+// IDトークン（RS256で署名したJWT）を作成する
+func (p *Provider) signIDToken(g *grant, now time.Time) (string, error) {
+	claims := userClaims(g.User)
+	claims["iss"] = p.cfg.Issuer
+	claims["aud"] = g.ClientID
+	claims["azp"] = g.ClientID
+	claims["iat"] = now.Unix()
+	claims["auth_time"] = now.Unix()
+	claims["exp"] = now.Add(tokenTTL).Unix()
+	if g.Nonce != "" {
+		claims["nonce"] = g.Nonce
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	jws, err := p.signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return jws.CompactSerialize()
+}
+
+func userClaims(u User) map[string]any {
+	return map[string]any{
+		"sub":            u.Subject,
+		"name":           u.Name,
+		"email":          u.Email,
+		"email_verified": u.EmailVerified,
+	}
+}
+
+// client_secret_basic / client_secret_post のどちらでも認証する
+func (p *Provider) authenticateClient(r *http.Request) bool {
+	clientID, secret, ok := r.BasicAuth()
+	if ok {
+		// Basic認証の値はフォームエンコードされている（RFC 6749 2.3.1）
+		clientID, _ = url.QueryUnescape(clientID)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+
+	return subtle.ConstantTimeCompare([]byte(clientID), []byte(p.cfg.ClientID)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(secret), []byte(p.cfg.ClientSecret)) == 1
+}
+
+// PKCE（RFC 7636）の code_verifier を検証する（認可リクエストに code_challenge が無い場合は不要）
+func verifyPKCE(challenge, method, verifier string) bool {
+	if challenge == "" {
+		return true
+	}
+	if verifier == "" {
+		return false
+	}
+
+	expected := verifier
+	if method == "S256" {
+		sum := sha256.Sum256([]byte(verifier))
+		expected = base64.RawURLEncoding.EncodeToString(sum[:])
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
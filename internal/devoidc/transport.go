@@ -0,0 +1,45 @@
+package devoidc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// Transport は issuer 宛てのリクエストをネットワークを通さずにこのOPで処理する http.RoundTripper を返す
+// APIがlistenする前のdiscoveryや、結合テストでのトークン交換に使う（それ以外のリクエストは base に渡す）
+func (p *Provider) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &inProcessTransport{p: p, base: base}
+}
+
+type inProcessTransport struct {
+	p    *Provider
+	base http.RoundTripper
+}
+
+func (t *inProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u := req.URL.Scheme + "://" + req.URL.Host + req.URL.Path
+	rest, ok := strings.CutPrefix(u, t.p.cfg.Issuer)
+	if !ok || (rest != "" && !strings.HasPrefix(rest, "/")) {
+		return t.base.RoundTrip(req)
+	}
+
+	// ServeHTTP は issuer からの相対パスで処理する
+	inner := req.Clone(req.Context())
+	inner.URL.Path = rest
+	inner.URL.RawPath = ""
+	inner.RequestURI = ""
+	if inner.Body == nil {
+		inner.Body = http.NoBody
+	}
+
+	rec := httptest.NewRecorder()
+	t.p.ServeHTTP(rec, inner)
+
+	res := rec.Result()
+	res.Request = req
+	return res, nil
+}
@@ -0,0 +1,258 @@
+package events
+
+import (
+	"context"
+	"sort"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// イベントの種類
+const (
+	ProjectCreated = "project.created" // プロジェクト作成
+	ProjectDeleted = "project.deleted" // プロジェクト削除
+	ProjectRenamed = "project.renamed" // プロジェクト名の変更
+	NodeCreated    = "node.created"    // ノード追加（作成と同時のプロジェクトのノードは含まない）
+	NodeDeleted    = "node.deleted"    // ノード削除（削除と同時のプロジェクトのノードは含まない）
+	NodeRenamed    = "node.renamed"    // ノードのラベルの変更
+	NodeCompleted  = "node.completed"  // ノードの完了(isDone)
+	NodeReopened   = "node.reopened"   // ノードの完了の取り消し
+	CardMoved      = "card.moved"      // カンバンのカラム移動（追加・除外を含む）
+	CardDone       = "card.done"       // カードが完了カラムへ移動（card.movedと同時に発生する）
+)
+
+// 全ての種類（Webhookの購読対象の検証に使う）
+var Types = []string{
+	ProjectCreated, ProjectDeleted, ProjectRenamed,
+	NodeCreated, NodeDeleted, NodeRenamed, NodeCompleted, NodeReopened,
+	CardMoved, CardDone,
+}
+
+func IsType(t string) bool {
+	for _, v := range Types {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Event はstateの更新から検出したドメインイベント
+type Event struct {
+	Type      string  `json:"type"`
+	PjID      string  `json:"pjId"`
+	PjName    string  `json:"pjName"`
+	NodeID    string  `json:"nodeId,omitempty"`
+	Label     string  `json:"label,omitempty"`
+	PrevLabel string  `json:"prevLabel,omitempty"` // node.renamed: 変更前のラベル
+	From      *string `json:"from,omitempty"`      // card.*: 移動元のカラム（""はカンバン外）
+	To        *string `json:"to,omitempty"`        // card.*: 移動先のカラム（""はカンバン外）
+}
+
+// CardMove はカードのカラムの変化
+type CardMove struct {
+	Ref  repository.KanbanCardRef
+	From string // 移動元のカラム（""はカンバン外）
+	To   string // 移動先のカラム（""はカンバン外）
+}
+
+// Changes は1回の更新から検出した変更
+// 更新フック間で共有するため、フック内で変更しないこと
+type Changes struct {
+	Events    []Event    // Diffの結果
+	CardMoves []CardMove // CardMovesの結果（削除したプロジェクトのカードを含む）
+}
+
+// Hook はstateの更新から検出した変更を受け取る更新フック
+type Hook func(ctx context.Context, c *minkan.Change, d *Changes)
+
+// Hooks はhsをまとめて1つのminkan.Storeの更新フックにする
+// 比較は更新ごとに1度だけ行い、結果をhsで共有する
+func Hooks(hs ...Hook) minkan.ChangeHook {
+	return func(ctx context.Context, c *minkan.Change) {
+		// 変換できなかった場合はStore側でログ出力済み
+		if c.Before == nil || c.After == nil {
+			return
+		}
+		moves := CardMoves(c.Before, c.After)
+		d := &Changes{Events: diff(c.Before, c.After, moves), CardMoves: moves}
+		for _, h := range hs {
+			h(ctx, c, d)
+		}
+	}
+}
+
+// Diff は更新前後のstateを比較し、発生したイベントを返す
+// 順序はプロジェクトID順にプロジェクト・ノードのイベント、最後にカードのイベント（それぞれID順）
+func Diff(before, after *repository.Minkan) []Event {
+	return diff(before, after, CardMoves(before, after))
+}
+
+func diff(before, after *repository.Minkan, moves []CardMove) []Event {
+	evs := []Event{}
+
+	for _, pjID := range projectIDs(before, after) {
+		oldPj, inOld := before.Projects[pjID]
+		newPj, inNew := after.Projects[pjID]
+
+		switch {
+		case !inOld:
+			evs = append(evs, Event{Type: ProjectCreated, PjID: pjID, PjName: newPj.Name})
+		case !inNew:
+			evs = append(evs, Event{Type: ProjectDeleted, PjID: pjID, PjName: oldPj.Name})
+		default:
+			if oldPj.Name != newPj.Name {
+				evs = append(evs, Event{Type: ProjectRenamed, PjID: pjID, PjName: newPj.Name})
+			}
+			evs = append(evs, diffNodes(&oldPj, &newPj)...)
+		}
+	}
+
+	return append(evs, cardEvents(after, moves)...)
+}
+
+func diffNodes(oldPj, newPj *repository.Project) []Event {
+	evs := []Event{}
+
+	oldNodes := nodesByID(oldPj)
+	newNodes := nodesByID(newPj)
+
+	for _, id := range sortedKeys(oldNodes, newNodes) {
+		o, inOld := oldNodes[id]
+		n, inNew := newNodes[id]
+		ev := Event{PjID: newPj.Id, PjName: newPj.Name, NodeID: id}
+
+		switch {
+		case !inOld:
+			ev.Type, ev.Label = NodeCreated, n.Data.Label
+			evs = append(evs, ev)
+			continue
+		case !inNew:
+			ev.Type, ev.Label = NodeDeleted, o.Data.Label
+			evs = append(evs, ev)
+			continue
+		}
+
+		// 同じ更新でラベル変更と完了が起きた場合は両方のイベントを返す
+		if o.Data.Label != n.Data.Label {
+			renamed := ev
+			renamed.Type, renamed.Label, renamed.PrevLabel = NodeRenamed, n.Data.Label, o.Data.Label
+			evs = append(evs, renamed)
+		}
+		switch {
+		case !o.Data.IsDone && n.Data.IsDone:
+			ev.Type, ev.Label = NodeCompleted, n.Data.Label
+		case o.Data.IsDone && !n.Data.IsDone:
+			ev.Type, ev.Label = NodeReopened, n.Data.Label
+		default:
+			continue
+		}
+		evs = append(evs, ev)
+	}
+	return evs
+}
+
+// カードのイベント（更新後に存在しないプロジェクトのカードは対象外）
+func cardEvents(after *repository.Minkan, moves []CardMove) []Event {
+	evs := []Event{}
+
+	for _, mv := range moves {
+		pj, ok := after.Projects[mv.Ref.PjId]
+		if !ok {
+			continue
+		}
+
+		from, to := mv.From, mv.To
+		ev := Event{Type: CardMoved, PjID: mv.Ref.PjId, PjName: pj.Name, NodeID: mv.Ref.NodeId, From: &from, To: &to}
+		if idx := minkan.FindNode(&pj, mv.Ref.NodeId); idx >= 0 {
+			ev.Label = pj.Nodes[idx].Data.Label
+		}
+		evs = append(evs, ev)
+
+		if to == minkan.ColumnDone {
+			done := ev
+			done.Type = CardDone
+			evs = append(evs, done)
+		}
+	}
+	return evs
+}
+
+// CardMoves は更新前後のカンバンを比較し、カラムが変わったカードをプロジェクトID・ノードID順に返す
+// 削除したプロジェクトのカードもカンバンからの除外(To == "")として含む
+func CardMoves(before, after *repository.Minkan) []CardMove {
+	oldCols := CardColumns(before)
+	newCols := CardColumns(after)
+
+	keys := make([]repository.KanbanCardRef, 0, len(oldCols)+len(newCols))
+	for k := range oldCols {
+		keys = append(keys, k)
+	}
+	for k := range newCols {
+		if _, ok := oldCols[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].PjId != keys[j].PjId {
+			return keys[i].PjId < keys[j].PjId
+		}
+		return keys[i].NodeId < keys[j].NodeId
+	})
+
+	moves := []CardMove{}
+	for _, k := range keys {
+		if from, to := oldCols[k], newCols[k]; from != to {
+			moves = append(moves, CardMove{Ref: k, From: from, To: to})
+		}
+	}
+	return moves
+}
+
+// CardColumns はカード → カラム名のマップを返す
+func CardColumns(m *repository.Minkan) map[repository.KanbanCardRef]string {
+	cols := map[repository.KanbanCardRef]string{}
+	for _, name := range minkan.Columns {
+		for _, ref := range *minkan.ColumnCards(&m.KanbanColumns, name) {
+			cols[ref] = name
+		}
+	}
+	return cols
+}
+
+func projectIDs(before, after *repository.Minkan) []string {
+	ids := []string{}
+	for id := range before.Projects {
+		ids = append(ids, id)
+	}
+	for id := range after.Projects {
+		if _, ok := before.Projects[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func nodesByID(pj *repository.Project) map[string]*repository.Node {
+	nodes := make(map[string]*repository.Node, len(pj.Nodes))
+	for i := range pj.Nodes {
+		nodes[pj.Nodes[i].Id] = &pj.Nodes[i]
+	}
+	return nodes
+}
+
+func sortedKeys(a, b map[string]*repository.Node) []string {
+	keys := []string{}
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,141 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan/fakestate"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const beforeState = `{
+  "currentPjId": "pj1",
+  "kanbanColumns": {
+    "backlog": [],
+    "todo": [{"pjId": "pj1", "nodeId": "n1"}, {"pjId": "pj2", "nodeId": "m1"}],
+    "doing": [{"pjId": "pj1", "nodeId": "n2"}],
+    "done": []
+  },
+  "kanbanIndex": {},
+  "projects": {
+    "pj1": {"id": "pj1", "name": "Work", "nodes": [
+      {"id": "n1", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "draft", "isDone": false, "comments": [], "parentId": null}},
+      {"id": "n2", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "review", "isDone": false, "comments": [], "parentId": null}}
+    ], "edges": []},
+    "pj2": {"id": "pj2", "name": "Old", "nodes": [
+      {"id": "m1", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "legacy", "isDone": false, "comments": [], "parentId": null}}
+    ], "edges": []}
+  }
+}`
+
+// n1: ラベル変更・完了・doneへ移動、n2: カンバンから除外、pj2: 削除
+const afterState = `{
+  "currentPjId": "pj1",
+  "kanbanColumns": {
+    "backlog": [],
+    "todo": [],
+    "doing": [],
+    "done": [{"pjId": "pj1", "nodeId": "n1"}]
+  },
+  "kanbanIndex": {},
+  "projects": {
+    "pj1": {"id": "pj1", "name": "Work", "nodes": [
+      {"id": "n1", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "final", "isDone": true, "comments": [], "parentId": null}},
+      {"id": "n2", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "review", "isDone": false, "comments": [], "parentId": null}}
+    ], "edges": []}
+  }
+}`
+
+func decode(t *testing.T, s string) *repository.Minkan {
+	t.Helper()
+	m, err := minkan.Decode(json.RawMessage(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestDiff(t *testing.T) {
+	evs := Diff(decode(t, beforeState), decode(t, afterState))
+
+	want := []struct {
+		typ, pjID, nodeID string
+	}{
+		{NodeRenamed, "pj1", "n1"},
+		{NodeCompleted, "pj1", "n1"},
+		{ProjectDeleted, "pj2", ""},
+		{CardMoved, "pj1", "n1"},
+		{CardDone, "pj1", "n1"},
+		{CardMoved, "pj1", "n2"},
+	}
+	if len(evs) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(evs), len(want), evs)
+	}
+	for i, w := range want {
+		if evs[i].Type != w.typ || evs[i].PjID != w.pjID || evs[i].NodeID != w.nodeID {
+			t.Errorf("event %d = %+v, want %+v", i, evs[i], w)
+		}
+	}
+	if evs[0].PrevLabel != "draft" || evs[0].Label != "final" {
+		t.Errorf("renamed = %+v", evs[0])
+	}
+	if *evs[3].From != minkan.ColumnTodo || *evs[3].To != minkan.ColumnDone {
+		t.Errorf("card moved = %s -> %s", *evs[3].From, *evs[3].To)
+	}
+}
+
+func TestCardMoves(t *testing.T) {
+	moves := CardMoves(decode(t, beforeState), decode(t, afterState))
+
+	// 削除したプロジェクトのカードもカンバンからの除外として含む（イベントには含まない）
+	want := []CardMove{
+		{Ref: repository.KanbanCardRef{PjId: "pj1", NodeId: "n1"}, From: minkan.ColumnTodo, To: minkan.ColumnDone},
+		{Ref: repository.KanbanCardRef{PjId: "pj1", NodeId: "n2"}, From: minkan.ColumnDoing, To: ""},
+		{Ref: repository.KanbanCardRef{PjId: "pj2", NodeId: "m1"}, From: minkan.ColumnTodo, To: ""},
+	}
+	if len(moves) != len(want) {
+		t.Fatalf("moves = %+v", moves)
+	}
+	for i := range want {
+		if moves[i] != want[i] {
+			t.Errorf("move %d = %+v, want %+v", i, moves[i], want[i])
+		}
+	}
+}
+
+func TestHooksShareChanges(t *testing.T) {
+	repo := fakestate.New()
+	repo.Put(1, json.RawMessage(beforeState))
+	st := minkan.NewStore(repo, nil)
+
+	var got []*Changes
+	record := func(_ context.Context, c *minkan.Change, d *Changes) {
+		if c.Before == nil || c.After == nil {
+			t.Error("change not decoded")
+		}
+		got = append(got, d)
+	}
+	st.OnChange(Hooks(record, record))
+
+	if _, err := st.Replace(context.Background(), 1, json.RawMessage(afterState), 1, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != got[1] {
+		t.Fatalf("hooks did not share the changes: %v", got)
+	}
+	if len(got[0].Events) != 6 || len(got[0].CardMoves) != 3 {
+		t.Errorf("changes = %+v", got[0])
+	}
+
+	// 変換できないstateはフックを呼ばない
+	got = nil
+	repo.Put(1, json.RawMessage(`not json`))
+	if _, err := st.Replace(context.Background(), 1, json.RawMessage(afterState), 1, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("hooks called for an undecodable state")
+	}
+}
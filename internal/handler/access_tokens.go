@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/accesstoken"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// ユーザーごとのパーソナルアクセストークンの上限
+	maxAccessTokens = 20
+
+	// パーソナルアクセストークンの名前の最大文字数
+	maxAccessTokenNameLen = 64
+)
+
+// パーソナルアクセストークンの一覧を取得
+func (s *Server) GetUsersMeTokens(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeTokens")
+
+	// 念のための nil ガード
+	if s.AccessTokenRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAccessTokenRepository", s.AccessTokenRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// トークンの管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("access token management with access token", "userID", userID)
+		return
+	}
+
+	tokens, err := s.AccessTokenRepository.ListAccessTokens(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list access tokens error", "err", err)
+		return
+	}
+
+	res := make([]api.AccessToken, 0, len(tokens))
+	for _, t := range tokens {
+		res = append(res, api.AccessToken{
+			Id:         t.ID,
+			Name:       t.Name,
+			Scope:      api.AccessTokenScope(t.Scope),
+			ExpiresAt:  t.ExpiresAt,
+			CreatedAt:  t.CreatedAt,
+			LastUsedAt: t.LastUsedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode AccessToken", "err", err)
+	}
+}
+
+// パーソナルアクセストークンを発行
+func (s *Server) PostUsersMeTokens(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostUsersMeTokens")
+
+	// 念のための nil ガード
+	if s.AccessTokenRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAccessTokenRepository", s.AccessTokenRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// トークンの管理はブラウザのログインでのみ許可する（漏れたトークンから別のトークンを作れないように）
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("access token management with access token", "userID", userID)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.AccessTokenCreateReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	name := strings.TrimSpace(reqBody.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxAccessTokenNameLen {
+		http.Error(w, "invalid name", http.StatusBadRequest)
+		lg.Warn("invalid access token name")
+		return
+	}
+
+	scope := string(reqBody.Scope)
+	if !accesstoken.IsScope(scope) {
+		http.Error(w, "invalid scope", http.StatusBadRequest)
+		lg.Warn("invalid access token scope", "scope", scope)
+		return
+	}
+
+	// MySQLのTIMESTAMPは秒精度のため、保存する値とレスポンスを揃える
+	var expiresAt *time.Time
+	if reqBody.ExpiresAt != nil {
+		t := reqBody.ExpiresAt.UTC().Truncate(time.Second)
+		if !t.After(time.Now()) {
+			http.Error(w, "expiresAt must be in the future", http.StatusBadRequest)
+			lg.Warn("expiresAt in the past")
+			return
+		}
+		expiresAt = &t
+	}
+
+	tok, err := token.New(accesstoken.TokenPrefix)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to generate access token", "err", err)
+		return
+	}
+
+	id, err := s.AccessTokenRepository.CreateAccessToken(r.Context(), &repository.AccessToken{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: token.Hash(tok),
+		Scope:     scope,
+		ExpiresAt: expiresAt,
+	}, maxAccessTokens)
+
+	if errors.Is(err, repository.ErrLimitExceeded) {
+		http.Error(w, "too many access tokens", http.StatusConflict)
+		lg.Warn("access token limit exceeded", "userID", userID)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to create access token", "err", err)
+		return
+	}
+
+	created, err := s.AccessTokenRepository.FindAccessTokenByHash(r.Context(), token.Hash(tok))
+
+	if err != nil || created == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find access token error", "err", err)
+		return
+	}
+
+	lg.Info("access token created", "userID", userID, "accessTokenID", id, "scope", scope)
+
+	res := api.AccessTokenCreated{
+		Id:        id,
+		Name:      created.Name,
+		Scope:     api.AccessTokenScope(created.Scope),
+		ExpiresAt: created.ExpiresAt,
+		CreatedAt: created.CreatedAt,
+		Token:     tok,
+	}
+
+	// 認証情報を発行したため、セッションIDを再発行する
+	s.rotateSession(w, r)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode AccessTokenCreated", "err", err)
+	}
+}
+
+// パーソナルアクセストークンを無効化
+func (s *Server) DeleteUsersMeTokensTokenId(w http.ResponseWriter, r *http.Request, tokenId int64) {
+	lg := slog.Default().With("handler", "DeleteUsersMeTokensTokenId")
+
+	// 念のための nil ガード
+	if s.AccessTokenRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAccessTokenRepository", s.AccessTokenRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// トークンの管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("access token management with access token", "userID", userID)
+		return
+	}
+
+	deleted, err := s.AccessTokenRepository.DeleteAccessToken(r.Context(), userID, tokenId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to delete access token", "err", err)
+		return
+	}
+
+	if !deleted {
+		http.Error(w, "access token not found", http.StatusNotFound)
+		lg.Warn("access token not found", "accessTokenID", tokenId)
+		return
+	}
+
+	lg.Info("access token revoked", "userID", userID, "accessTokenID", tokenId)
+
+	w.WriteHeader(http.StatusNoContent)
+}
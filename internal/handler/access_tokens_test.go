@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/auth"
+	"github.com/yopi416/mind-kanban-backend/internal/inbound"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/reminder"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+)
+
+// 書き込み権限のアクセストークンとして、どのトークンもuserID 1で通す
+type allowAllBearer struct{}
+
+func (allowAllBearer) AuthenticateBearer(context.Context, string) (int64, bool, bool, error) {
+	return 1, false, true, nil
+}
+
+// 認証情報の管理はアクセストークンでは行えない（漏れたトークンから別の認証情報を作れないように）
+// 拒否はDBに触れる前に行うため、リポジトリは接続の無いものを渡す
+func TestCredentialEndpointsRejectAccessTokens(t *testing.T) {
+	s := &Server{
+		SessionManager:         session.NewSessionManager(session.NewMemoryStore(), time.Hour, 24*time.Hour, "csrf-secret", "token-key"),
+		AuthProviders:          &auth.Registry{},
+		UserRepository:         &repository.UserRepository{},
+		UserIdentityRepository: &repository.UserIdentityRepository{},
+		AccessTokenRepository:  &repository.AccessTokenRepository{},
+		AppPasswordRepository:  &repository.AppPasswordRepository{},
+		CalendarFeedRepository: &repository.CalendarFeedRepository{},
+		WebhookRepository:      &repository.WebhookRepository{},
+		MailLinks:              &reminder.Links{},
+		InboundCapturer:        &inbound.Capturer{},
+	}
+	h := middleware.RequireLogin(api.HandlerWithOptions(s, api.StdHTTPServerOptions{BaseURL: "/v1"}), middleware.RequireLoginOptions{
+		SessionManager:   s.SessionManager,
+		RequireCSRFToken: true,
+		Bearer:           allowAllBearer{},
+	})
+
+	endpoints := []struct {
+		method, path, body string
+	}{
+		{http.MethodGet, "/v1/users/me/tokens", ""},
+		{http.MethodPost, "/v1/users/me/tokens", `{"name": "ci", "scope": "write"}`},
+		{http.MethodDelete, "/v1/users/me/tokens/1", ""},
+		{http.MethodPost, "/v1/users/me/app-passwords", `{"name": "phone"}`},
+		{http.MethodPost, "/v1/users/me/calendar-feed", ""},
+		{http.MethodPost, "/v1/users/me/inbound", ""},
+		{http.MethodGet, "/v1/users/me/identities", ""},
+		{http.MethodPost, "/v1/users/me/identities", `{"provider": "github"}`},
+		{http.MethodPost, "/v1/users/me/identities/pending", ""},
+		{http.MethodDelete, "/v1/users/me/identities/1", ""},
+		{http.MethodGet, "/v1/users/me/sessions", ""},
+		{http.MethodDelete, "/v1/users/me/sessions", ""},
+		{http.MethodDelete, "/v1/users/me/sessions/abc", ""},
+		{http.MethodPost, "/v1/webhooks", `{"url": "https://example.com/hook", "events": ["node.completed"]}`},
+		{http.MethodPut, "/v1/webhooks/1", `{"url": "https://example.com/hook", "events": ["node.completed"]}`},
+		{http.MethodDelete, "/v1/users/me", ""},
+	}
+	for _, e := range endpoints {
+		r := httptest.NewRequest(e.method, e.path, strings.NewReader(e.body))
+		r.Header.Set("Authorization", "Bearer mkpat_test")
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		if w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), "access token") {
+			t.Errorf("%s %s = %d %q, want 403", e.method, e.path, w.Code, strings.TrimSpace(w.Body.String()))
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const (
+	// 操作履歴の1回の取得件数
+	defaultActivityLimit = 50
+	maxActivityLimit     = 200
+)
+
+// 操作履歴を新しい順に取得
+func (s *Server) GetActivity(w http.ResponseWriter, r *http.Request, params api.GetActivityParams) {
+	lg := slog.Default().With("handler", "GetActivity")
+
+	// 念のための nil ガード
+	if s.ActivityRecorder == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasActivityRecorder", s.ActivityRecorder != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	limit := defaultActivityLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxActivityLimit {
+		http.Error(w, "invalid limit", http.StatusBadRequest)
+		lg.Warn("invalid limit", "limit", limit)
+		return
+	}
+
+	f := repository.ActivityFilter{
+		PjID:   params.PjId,
+		NodeID: params.NodeId,
+		From:   params.From,
+		To:     params.To,
+	}
+	if params.Before != nil {
+		f.BeforeID = *params.Before
+	}
+	if f.BeforeID < 0 {
+		http.Error(w, "invalid before", http.StatusBadRequest)
+		lg.Warn("invalid before", "before", f.BeforeID)
+		return
+	}
+
+	// ノードIDはプロジェクト内でのみ一意のため、プロジェクトの指定を必須とする
+	if f.NodeID != nil && f.PjID == nil {
+		http.Error(w, "nodeId requires pjId", http.StatusBadRequest)
+		lg.Warn("nodeId without pjId")
+		return
+	}
+
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		http.Error(w, "invalid time range", http.StatusBadRequest)
+		lg.Warn("invalid time range", "from", *f.From, "to", *f.To)
+		return
+	}
+
+	// 続きの有無を判定するため1件多く取得する
+	evs, err := s.ActivityRecorder.Repo.ListActivityEvents(r.Context(), userID, f, limit+1)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list activity events error", "err", err)
+		return
+	}
+
+	res := api.ActivityRes{
+		Events: make([]api.ActivityEvent, 0, len(evs)),
+	}
+	if len(evs) > limit {
+		evs = evs[:limit]
+		next := evs[limit-1].ID
+		res.NextBefore = &next
+	}
+	for i := range evs {
+		ev, err := toAPIActivityEvent(&evs[i])
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("failed to decode activity data", "err", err, "id", evs[i].ID)
+			return
+		}
+		res.Events = append(res.Events, ev)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode ActivityRes", "err", err)
+	}
+}
+
+func toAPIActivityEvent(e *repository.ActivityEvent) (api.ActivityEvent, error) {
+	data := map[string]interface{}{}
+	if len(e.Data) > 0 {
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return api.ActivityEvent{}, err
+		}
+	}
+	return api.ActivityEvent{
+		Id:        e.ID,
+		Type:      e.Type,
+		PjId:      e.PjID,
+		NodeId:    e.NodeID,
+		Origin:    e.Origin,
+		Version:   e.Version,
+		Data:      data,
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
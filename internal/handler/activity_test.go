@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/activity"
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+)
+
+// fakeActivity はテスト用のactivity_events（ListActivityEventsはリポジトリと同じくid降順）
+type fakeActivity struct {
+	rows []repository.ActivityEvent
+}
+
+func (f *fakeActivity) InsertActivityEvents(_ context.Context, evs []repository.ActivityEvent) error {
+	for _, e := range evs {
+		e.ID = int64(len(f.rows) + 1)
+		f.rows = append(f.rows, e)
+	}
+	return nil
+}
+
+func (f *fakeActivity) ListActivityEvents(_ context.Context, userID int64, af repository.ActivityFilter, limit int) ([]repository.ActivityEvent, error) {
+	res := []repository.ActivityEvent{}
+	for _, e := range f.rows {
+		if e.UserID != userID || (af.BeforeID != 0 && e.ID >= af.BeforeID) {
+			continue
+		}
+		if af.PjID != nil && (e.PjID == nil || *e.PjID != *af.PjID) {
+			continue
+		}
+		res = append(res, e)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID > res[j].ID })
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (f *fakeActivity) DeleteActivityEventsExcept(context.Context, int64, ...string) error {
+	return nil
+}
+
+// 同じ時刻の履歴が続いても、nextBeforeで辿ると重複・欠落なく新しい順に全件取得できる
+// 取得の途中で新しい履歴が追加されても、続きのページはずれない
+func TestGetActivityPagination(t *testing.T) {
+	repo := &fakeActivity{}
+	s := &Server{
+		SessionManager:   session.NewSessionManager(session.NewMemoryStore(), time.Hour, 24*time.Hour, "csrf-secret", "token-key"),
+		Cookies:          cookies.Policy{SessionName: "session_id", CSRFName: "csrf_token"},
+		ActivityRecorder: activity.NewRecorder(repo),
+	}
+	h := middleware.RequireLogin(api.HandlerWithOptions(s, api.StdHTTPServerOptions{BaseURL: "/v1"}), middleware.RequireLoginOptions{
+		SessionManager: s.SessionManager,
+		Cookies:        s.Cookies,
+	})
+	sessID := login(t, s, 1)
+
+	// 一括で記録した履歴は全て同じ時刻になる
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	pj := "pj1"
+	var evs []repository.ActivityEvent
+	for i := 0; i < 7; i++ {
+		evs = append(evs, repository.ActivityEvent{UserID: 1, Type: "node.created", PjID: &pj, CreatedAt: at})
+	}
+	evs = append(evs, repository.ActivityEvent{UserID: 2, Type: "node.created", PjID: &pj, CreatedAt: at})
+	if err := repo.InsertActivityEvents(context.Background(), evs); err != nil {
+		t.Fatal(err)
+	}
+
+	page := func(before *int64) api.ActivityRes {
+		t.Helper()
+		target := "/v1/activity?limit=3&pjId=" + pj
+		if before != nil {
+			target += "&before=" + strconv.FormatInt(*before, 10)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sessionRequest(s, http.MethodGet, target, sessID, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s = %d %s", target, w.Code, w.Body)
+		}
+		var res api.ActivityRes
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	var got []int64
+	var before *int64
+	for i := 0; ; i++ {
+		if i > 5 {
+			t.Fatal("pagination does not terminate")
+		}
+		res := page(before)
+		if len(res.Events) > 3 {
+			t.Fatalf("page size = %d, want <= 3", len(res.Events))
+		}
+		for _, e := range res.Events {
+			got = append(got, e.Id)
+		}
+		if i == 0 {
+			// 1ページ目の取得後に追加された履歴は、続きのページに混ざらない
+			if err := repo.InsertActivityEvents(context.Background(), []repository.ActivityEvent{{UserID: 1, Type: "node.created", PjID: &pj, CreatedAt: at}}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if res.NextBefore == nil {
+			break
+		}
+		before = res.NextBefore
+	}
+
+	want := []int64{7, 6, 5, 4, 3, 2, 1}
+	if len(got) != len(want) {
+		t.Fatalf("ids = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", got, want)
+		}
+	}
+
+	// 件数がちょうど割り切れる場合も、最後のページにnextBeforeは付かない
+	last := int64(4)
+	if res := page(&last); len(res.Events) != 3 || res.NextBefore != nil {
+		t.Errorf("last page = %d events, nextBefore %v", len(res.Events), res.NextBefore)
+	}
+}
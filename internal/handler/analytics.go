@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/analytics"
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+const (
+	// フロー分析で一度に集計できる最大日数
+	maxAnalyticsDays = 366
+
+	// 期間の指定が無い場合の日数（今日までの12週間）
+	defaultAnalyticsDays = 12 * 7
+)
+
+// カンバンのフロー分析（スループット・リードタイム・サイクルタイム・経過時間・累積フロー）
+func (s *Server) GetAnalytics(w http.ResponseWriter, r *http.Request, params api.GetAnalyticsParams) {
+	lg := slog.Default().With("handler", "GetAnalytics")
+
+	// 念のための nil ガード
+	if s.UserRepository == nil || s.MinkanStatesRepository == nil || s.CardTransitionRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserRepository", s.UserRepository != nil,
+			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+			"hasCardTransitionRepository", s.CardTransitionRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	userData, err := s.UserRepository.FindUserByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	if userData == nil {
+		http.Error(w, "user not found", http.StatusNotFound)
+		lg.Warn("userData not found", "userID", userID)
+		return
+	}
+
+	// from・toはユーザーのタイムゾーンの日付として解釈（toの日の終わりまでを含む）
+	now := time.Now()
+	loc := calendar.Location(userData.TimeZone, s.DefaultLocation)
+	to := calendar.StartOfDay(now.In(loc), loc).AddDate(0, 0, 1)
+	if params.To != nil {
+		to = calendar.StartOfDay(params.To.Time, loc).AddDate(0, 0, 1)
+	}
+	from := to.AddDate(0, 0, -defaultAnalyticsDays)
+	if params.From != nil {
+		from = calendar.StartOfDay(params.From.Time, loc)
+	}
+
+	if !from.Before(to) || to.Sub(from) > maxAnalyticsDays*24*time.Hour+time.Hour {
+		http.Error(w, "invalid date range", http.StatusBadRequest)
+		lg.Warn("invalid date range", "from", from, "to", to)
+		return
+	}
+
+	minkanState, err := s.MinkanStatesRepository.FindStateByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find minkan_state error", "err", err)
+		return
+	}
+
+	if minkanState == nil {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
+	m, err := minkan.Decode(minkanState.StateJSON)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("decode minkan_state error", "err", err)
+		return
+	}
+
+	// 期間の開始時点の状態を求めるため、toより前の全ての移動を取得する
+	ts, err := s.CardTransitionRepository.ListCardTransitions(r.Context(), userID, params.PjId, to)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list card transitions error", "err", err)
+		return
+	}
+
+	res := analytics.Compute(ts, m, params.PjId, from, to, now)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode AnalyticsRes", "err", err)
+	}
+}
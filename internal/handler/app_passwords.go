@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// アプリパスワードの接頭辞
+	appPasswordPrefix = "mkapp"
+
+	// ユーザーごとのアプリパスワードの上限
+	maxAppPasswords = 20
+
+	// アプリパスワードの名前の最大文字数
+	maxAppPasswordNameLen = 64
+)
+
+// アプリパスワードの一覧を取得
+func (s *Server) GetUsersMeAppPasswords(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeAppPasswords")
+
+	// 念のための nil ガード
+	if s.AppPasswordRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAppPasswordRepository", s.AppPasswordRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	passwords, err := s.AppPasswordRepository.ListAppPasswords(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list app passwords error", "err", err)
+		return
+	}
+
+	res := make([]api.AppPassword, 0, len(passwords))
+	for _, p := range passwords {
+		res = append(res, api.AppPassword{
+			Id:         p.ID,
+			Name:       p.Name,
+			CreatedAt:  p.CreatedAt,
+			LastUsedAt: p.LastUsedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode AppPassword", "err", err)
+	}
+}
+
+// アプリパスワードを発行
+func (s *Server) PostUsersMeAppPasswords(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostUsersMeAppPasswords")
+
+	// 念のための nil ガード
+	if s.AppPasswordRepository == nil || s.MailLinks == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAppPasswordRepository", s.AppPasswordRepository != nil,
+			"hasMailLinks", s.MailLinks != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// アプリパスワードの発行はブラウザのログインでのみ許可する（漏れたトークンから別の認証情報を作れないように）
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("app password creation with access token", "userID", userID)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.AppPasswordCreateReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	name := strings.TrimSpace(reqBody.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxAppPasswordNameLen {
+		http.Error(w, "invalid name", http.StatusBadRequest)
+		lg.Warn("invalid app password name")
+		return
+	}
+
+	password, err := token.New(appPasswordPrefix)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to generate app password", "err", err)
+		return
+	}
+
+	id, err := s.AppPasswordRepository.CreateAppPassword(r.Context(), userID, name, token.Hash(password), maxAppPasswords)
+
+	if errors.Is(err, repository.ErrLimitExceeded) {
+		http.Error(w, "too many app passwords", http.StatusConflict)
+		lg.Warn("app password limit exceeded", "userID", userID)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to create app password", "err", err)
+		return
+	}
+
+	created, err := s.AppPasswordRepository.FindAppPasswordByHash(r.Context(), token.Hash(password))
+
+	if err != nil || created == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find app password error", "err", err)
+		return
+	}
+
+	lg.Info("app password created", "userID", userID, "appPasswordID", id)
+
+	res := api.AppPasswordCreated{
+		Id:        id,
+		Name:      created.Name,
+		CreatedAt: created.CreatedAt,
+		Password:  password,
+		CaldavUrl: s.MailLinks.CalDAVURL(),
+	}
+
+	// 認証情報を発行したため、セッションIDを再発行する
+	s.rotateSession(w, r)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode AppPasswordCreated", "err", err)
+	}
+}
+
+// アプリパスワードを無効化
+func (s *Server) DeleteUsersMeAppPasswordsAppPasswordId(w http.ResponseWriter, r *http.Request, appPasswordId int64) {
+	lg := slog.Default().With("handler", "DeleteUsersMeAppPasswordsAppPasswordId")
+
+	// 念のための nil ガード
+	if s.AppPasswordRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAppPasswordRepository", s.AppPasswordRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	deleted, err := s.AppPasswordRepository.DeleteAppPassword(r.Context(), userID, appPasswordId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to delete app password", "err", err)
+		return
+	}
+
+	if !deleted {
+		http.Error(w, "app password not found", http.StatusNotFound)
+		lg.Warn("app password not found", "appPasswordID", appPasswordId)
+		return
+	}
+
+	lg.Info("app password revoked", "userID", userID, "appPasswordID", appPasswordId)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -2,41 +2,79 @@ package handler
 
 import (
 	"database/sql"
+	"encoding/json"
 	"log/slog"
 	"net/http"
+	"net/url"
 
-	"github.com/google/uuid"
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/activity"
+	"github.com/yopi416/mind-kanban-backend/internal/auth"
 	"github.com/yopi416/mind-kanban-backend/internal/repository"
-	"github.com/zitadel/oidc/v3/pkg/client/rp"
-	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
 )
 
+// ログインに使えるIdPの一覧（ログイン画面のボタン表示用）
+func (s *Server) GetAuthProviders(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetAuthProviders")
+
+	// 念のための nil ガード
+	if s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	providers := s.AuthProviders.Providers()
+	res := make([]api.AuthProvider, 0, len(providers))
+	for _, p := range providers {
+		res = append(res, api.AuthProvider{Id: p.ID, Name: p.DisplayName})
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode AuthProvider", "err", err)
+	}
+}
+
 // 認可リクエスト URL を生成してユーザーを IdP(Google等)にリダイレクト
-func (s *Server) GetAuthLogin(w http.ResponseWriter, r *http.Request) {
+// providerが未指定の場合は既定のIdP（AUTH_PROVIDERS の先頭）を使う
+func (s *Server) GetAuthLogin(w http.ResponseWriter, r *http.Request, params api.GetAuthLoginParams) {
 	lg := slog.Default().With("handler", "GetAuthLogin")
 
 	// 念のための nil ガード
-	if s.OIDC == nil || s.OIDC.RP == nil {
+	if s.AuthProviders == nil {
 		http.Error(w, "OIDC not initialized", http.StatusInternalServerError)
 		lg.Error("oidc not initialized")
 		return
 	}
 
-	// oidcのgenState生成関数を定義
-	genState := func() string {
-		return uuid.New().String()
+	p := s.AuthProviders.Default()
+	if params.Provider != nil && *params.Provider != "" {
+		p = s.AuthProviders.Get(*params.Provider)
+		if p == nil {
+			http.Error(w, "unknown provider", http.StatusBadRequest)
+			lg.Warn("unknown provider", "provider", *params.Provider)
+			return
+		}
+	}
+
+	// IdPの初期化（discovery）が済むまではログインできない（バックグラウンドで再試行中）
+	if !p.Ready() {
+		auth.WriteNotReady(w)
+		lg.Warn("identity provider not ready", "provider", p.ID)
+		return
 	}
 
-	// http.handlerFuncを返すので、それを実行
 	// stateの生成や、cookieへの保存、認可リクエストURLの生成、http.Redirect(w, r, authURL, 302) を実行
-	rp.AuthURLHandler(
-		genState,
-		s.OIDC.RP,
-		// urlOptions...,
-	)(w, r)
+	p.Login(w, r)
 
 	// 正常時は Info ログを出力
-	lg.Info("login redirect ok", "remote", r.RemoteAddr)
+	lg.Info("login redirect ok", "provider", p.ID, "remote", r.RemoteAddr)
 
 }
 
@@ -47,212 +85,382 @@ func rollback(lg *slog.Logger, tx *sql.Tx) {
 	}
 }
 
-// OIDCのcallback先の処理
+// 既定のIdPのcallback先の処理（従来のURL。IdPに登録済みのコールバックURLを変えずに済むよう残す）
 func (s *Server) GetAuthCallback(w http.ResponseWriter, r *http.Request) {
 	lg := slog.Default().With("handler", "GetAuthCallback")
 
 	// 念のための nil ガード
-	if s.OIDC == nil || s.OIDC.RP == nil || s.SessionManager == nil || s.UserRepository == nil {
+	if s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	s.handleAuthCallback(w, r, lg, s.AuthProviders.Default())
+}
+
+// IdPごとのcallback先の処理
+func (s *Server) GetAuthCallbackProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	lg := slog.Default().With("handler", "GetAuthCallbackProvider")
+
+	// 念のための nil ガード
+	if s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	p := s.AuthProviders.Get(provider)
+	if p == nil {
+		http.Error(w, "unknown provider", http.StatusNotFound)
+		lg.Warn("unknown provider", "provider", provider)
+		return
+	}
+
+	s.handleAuthCallback(w, r, lg, p)
+}
+
+// 認可コードをトークンに交換し、本人確認できたユーザーでログインする
+// インメモリへのセッション登録や、sessionIDをset-Cookie, 302リダイレクトを実施
+func (s *Server) handleAuthCallback(w http.ResponseWriter, r *http.Request, lg *slog.Logger, p *auth.Provider) {
+	// 念のための nil ガード
+	if s.SessionManager == nil || s.UserRepository == nil || s.UserIdentityRepository == nil {
 		http.Error(w, "internal server error", http.StatusInternalServerError)
 		lg.Error("missing dependency",
-			"hasOIDC", s.OIDC != nil,
-			"hasRP", s.OIDC != nil && s.OIDC.RP != nil,
 			"hasSession", s.SessionManager != nil,
+			"hasUserRepository", s.UserRepository != nil,
+			"hasUserIdentityRepository", s.UserIdentityRepository != nil,
 		)
 		return
 	}
 
-	// 後に、トークン取得後に実行するコールバック関数
-	// インメモリへのセッション登録や、sessionIDをset-Cookie, 302リダイレクトを実施
-	callback := func(
-		w http.ResponseWriter,
-		r *http.Request,
-		tokens *oidc.Tokens[*oidc.IDTokenClaims],
-		state string,
-		_ rp.RelyingParty,
-	) {
-		claims := tokens.IDTokenClaims
-		iss := claims.Issuer
-		sub := claims.Subject
-
-		// DisplayNameは name / email の順でフォールバック
-		displayName := claims.Email
-		if claims.Name != "" {
-			displayName = claims.Name
-		}
+	lg = lg.With("provider", p.ID)
+
+	if !p.Ready() {
+		auth.WriteNotReady(w)
+		lg.Warn("identity provider not ready")
+		return
+	}
+
+	// state・PKCEの検証、トークン交換、本人確認はProviderが実施し、成功時に completeLogin を呼ぶ
+	p.Callback(w, r, func(w http.ResponseWriter, r *http.Request, id *auth.Identity) {
+		s.completeLogin(w, r, lg, id)
+	})
+}
+
+// IdPで本人確認できたユーザーを検索（未登録の場合は新規作成）し、セッションを発行してフロントへ302
+func (s *Server) completeLogin(w http.ResponseWriter, r *http.Request, lg *slog.Logger, id *auth.Identity) {
+	iss := id.Issuer
+	sub := id.Subject
+
+	// DisplayNameは name / email の順でフォールバック
+	displayName := id.Email
+	if id.Name != "" {
+		displayName = id.Name
+	}
+
+	email := id.Email
+	emailVerified := id.EmailVerified
+
+	// ログイン中のユーザーが開始した連携の場合は、ログインせずにIdPを紐付ける
+	if intent := s.AuthProviders.TakeLinkIntent(w, r); intent != nil {
+		s.completeLink(w, r, lg, id, intent)
+		return
+	}
+
+	// iss,subから連携済みのIdPを検索
+	identity, err := s.UserIdentityRepository.FindUserIdentity(r.Context(), iss, sub)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user identity error", "err", err)
+		return
+	}
 
-		email := claims.Email
-		emailVerified := claims.EmailVerified
+	var foundUserID int64
 
-		// iss,subからユーザーIDを検索
-		user, err := s.UserRepository.FindUserByOIDC(r.Context(), iss, sub)
+	// 未連携のIdPで、メールアドレスが既存のアカウントと一致する場合は、新規作成せずに連携を保留する
+	// メールアドレスの一致だけでは連携せず、既存のアカウントでログインし直して確定する（POST /users/me/identities/pending）
+	if identity == nil && email != "" {
+		existing, err := s.UserRepository.FindUserByEmail(r.Context(), email)
 		if err != nil {
 			http.Error(w, "internal server error", http.StatusInternalServerError)
-			lg.Error("find user error", "err", err)
+			lg.Error("find user by email error", "err", err)
 			return
 		}
 
-		var foundUserID int64
-
-		// ユーザーがnilの場合（未登録）、ユーザーを新規作成し、初期minkanデータを登録
-		if user == nil {
-			tx, err := s.UserRepository.DB.BeginTx(r.Context(), nil)
-
-			if err != nil {
-				http.Error(w, "internal server error", http.StatusInternalServerError)
-				lg.Error("begin tx error", "err", err)
+		if existing != nil {
+			// IdPで確認されていないメールアドレスは本人のものとは限らないため、連携の対象にしない
+			if !emailVerified {
+				lg.Warn("unverified email already in use", "iss", iss, "sub", sub)
+				s.redirectAfterLogin(w, r, url.Values{"link": {"error"}, "reason": {"email_in_use"}})
 				return
 			}
 
-			// 1. ユーザーの新規作成
-			newUser := repository.User{
-				OIDCIss:       iss,
-				OIDCSub:       sub,
-				DisplayName:   displayName,
-				Email:         email,
-				EmailVerified: bool(emailVerified),
-			}
-			createdUserID, err := s.UserRepository.CreateUser(r.Context(), tx, &newUser)
-
-			if err != nil {
-				rollback(lg, tx)
+			if err := s.AuthProviders.SetPendingLink(w, existing.UserID, id); err != nil {
 				http.Error(w, "internal server error", http.StatusInternalServerError)
-				lg.Error("create user error", "err", err)
+				lg.Error("set pending link failed", "err", err)
 				return
 			}
 
-			// 2. minkan_stateの初期化
-			err = s.MinkanStatesRepository.InitState(r.Context(), tx, createdUserID)
+			lg.Info("identity link pending", "iss", iss, "sub", sub, "userID", existing.UserID)
+			s.redirectAfterLogin(w, r, url.Values{"link": {"pending"}})
+			return
+		}
+	}
 
-			if err != nil {
-				rollback(lg, tx)
-				http.Error(w, "internal server error", http.StatusInternalServerError)
-				lg.Error("init minkan_state error", "err", err)
-				return
-			}
+	// 連携済みのIdPが無い場合（未登録）、ユーザーを新規作成し、初期minkanデータを登録
+	if identity == nil {
+		tx, err := s.UserRepository.DB.BeginTx(r.Context(), nil)
 
-			// 3. 1,2のコミット
-			if err := tx.Commit(); err != nil {
-				rollback(lg, tx)
-				http.Error(w, "internal server error", http.StatusInternalServerError)
-				lg.Error("transaction commit error", "err", err)
-				return
-			}
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("begin tx error", "err", err)
+			return
+		}
 
-			// 新規登録の場合は、作成されたuserのuserIDを取得
-			foundUserID = createdUserID
+		// 1. ユーザーの新規作成
+		newUser := repository.User{
+			OIDCIss:       iss,
+			OIDCSub:       sub,
+			DisplayName:   displayName,
+			Email:         email,
+			EmailVerified: emailVerified,
+		}
+		createdUserID, err := s.UserRepository.CreateUser(r.Context(), tx, &newUser)
 
-		} else {
-			// ユーザーが見つかった場合は、そのユーザーIDを取得
-			foundUserID = user.UserID
+		if err != nil {
+			rollback(lg, tx)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("create user error", "err", err)
+			return
+		}
+
+		// 2. ログインしたIdPの紐付け
+		_, err = s.UserIdentityRepository.CreateUserIdentity(r.Context(), tx, &repository.UserIdentity{
+			UserID:   createdUserID,
+			Provider: id.Provider,
+			OIDCIss:  iss,
+			OIDCSub:  sub,
+			Email:    email,
+		})
+
+		if err != nil {
+			rollback(lg, tx)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("create user identity error", "err", err)
+			return
+		}
+
+		// 3. minkan_stateの初期化
+		err = s.MinkanStatesRepository.InitState(r.Context(), tx, createdUserID)
+
+		if err != nil {
+			rollback(lg, tx)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("init minkan_state error", "err", err)
+			return
 		}
 
-		// ログイン時間の最新化
-		if err := s.UserRepository.UpdateLastLoginAt(r.Context(), foundUserID); err != nil {
-			lg.Warn("update last_login_at failed", "err", err)
+		// 4. 1～3のコミット
+		if err := tx.Commit(); err != nil {
+			rollback(lg, tx)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("transaction commit error", "err", err)
+			return
+		}
+
+		// 新規登録の場合は、作成されたuserのuserIDを取得
+		foundUserID = createdUserID
+
+	} else {
+		// 連携済みのIdPが見つかった場合は、紐付いたユーザーIDを取得
+		foundUserID = identity.UserID
+
+		if err := s.UserIdentityRepository.UpdateLastLoginAt(r.Context(), identity.ID); err != nil {
+			lg.Warn("update identity last_login_at failed", "err", err)
 			// 致命ではないので続行
 		}
+	}
 
-		// ⇒今は暫定でuserIDを0としておく
-		// var userID int64 = 10000 // TODO: 実装後に DB から実IDを取得
-
-		// セッション発行、登録
-		sessionID := uuid.New().String()
-		sessionTTL := s.SessionManager.GetTTL()
-		s.SessionManager.CreateSession(sessionID, foundUserID)
-
-		// クッキー付与（本番は Secure: true）
-		http.SetCookie(w, &http.Cookie{
-			Name:     "session_id",
-			Value:    sessionID,
-			Path:     "/",
-			HttpOnly: true, // jsから読み取れないようにする
-			Secure:   true, // ← HTTPS 運用時は true に
-			SameSite: http.SameSiteNoneMode,
-			MaxAge:   int(sessionTTL.Seconds()), // ブラウザ閉後もCookieをキープ
-		})
+	// ログイン時間の最新化
+	if err := s.UserRepository.UpdateLastLoginAt(r.Context(), foundUserID); err != nil {
+		lg.Warn("update last_login_at failed", "err", err)
+		// 致命ではないので続行
+	}
 
-		// csrfトークンの発行してCookieに格納
-		csrfToken := uuid.New().String()
-
-		http.SetCookie(w, &http.Cookie{
-			Name:     "csrf_token",
-			Value:    csrfToken,
-			Path:     "/",
-			HttpOnly: false,                 // (JS)が読めるように
-			Secure:   true,                  // 本番は true（HTTPS）
-			SameSite: http.SameSiteNoneMode, // 運用に応じて
-			Domain:   "mindmap-kanban.com",  // FEのdocument.Cookieで読めるように
-			MaxAge:   int(sessionTTL.Seconds()),
-		})
+	// ⇒今は暫定でuserIDを0としておく
+	// var userID int64 = 10000 // TODO: 実装後に DB から実IDを取得
+
+	// セッション固定攻撃対策として、ブラウザが持っていたセッションは破棄する
+	if oldID := s.Cookies.SessionID(r); oldID != "" {
+		if err := s.SessionManager.DeleteSession(r.Context(), oldID); err != nil {
+			lg.Warn("delete previous session failed", "err", err)
+		}
+	}
+
+	// セッション発行、登録（IDは毎回新しく生成する）
+	// IdPのトークンはログアウト時の失効・IdPでの定期的な確認のため、暗号化してセッションに保存する
+	meta := session.MetaFromRequest(r)
+	meta.Provider = id.Provider
+	meta.IdPTokens = session.IdPTokens{RefreshToken: id.RefreshToken, IDToken: id.IDToken}
+	sessionID, err := s.SessionManager.CreateSession(r.Context(), foundUserID, meta)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("create session failed", "err", err)
+		return
+	}
+
+	// セッションIDとCSRFトークン（セッションIDのHMAC）をCookieに格納
+	s.Cookies.SetSession(w, sessionID, s.SessionManager.CSRFToken(sessionID), s.SessionManager.GetTTL())
+
+	if s.ActivityRecorder != nil {
+		s.ActivityRecorder.Log(r.Context(), foundUserID, activity.TypeLogin, r)
+	}
+
+	lg.Info("login success", "iss", iss, "sub", sub)
+
+	// 保留中の連携の連携先のアカウントでログインし直した場合は、フロントで確定を促す
+	var params url.Values
+	if pl := s.AuthProviders.PendingLink(r); pl != nil && pl.UserID == foundUserID {
+		params = url.Values{"link": {"pending"}}
+	}
+
+	// フロントエンドへ 302
+	s.redirectAfterLogin(w, r, params)
+}
+
+// ログイン中のユーザーが開始した連携（POST /users/me/identities）を完了し、フロントへ302
+// 連携を開始したユーザーのセッションが確認できない場合（別のブラウザ・ログアウト後等）は連携しない
+func (s *Server) completeLink(w http.ResponseWriter, r *http.Request, lg *slog.Logger, id *auth.Identity, intent *auth.LinkIntent) {
+	var userID int64
+	ok := false
+	if sessID := s.Cookies.SessionID(r); sessID != "" {
+		userID, ok = s.SessionManager.GetSession(r.Context(), sessID)
+	}
+
+	if !ok || userID != intent.UserID || intent.Provider != id.Provider {
+		lg.Warn("link intent without matching session", "userID", intent.UserID)
+		s.redirectAfterLogin(w, r, url.Values{"link": {"error"}, "reason": {"session"}})
+		return
+	}
+
+	existing, err := s.UserIdentityRepository.FindUserIdentity(r.Context(), id.Issuer, id.Subject)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user identity error", "err", err)
+		return
+	}
 
-		lg.Info("login success", "iss", iss, "sub", sub)
+	if existing != nil {
+		// 連携済みの場合は何もしない
+		if existing.UserID == userID {
+			s.redirectAfterLogin(w, r, url.Values{"link": {"linked"}, "provider": {id.Provider}})
+			return
+		}
+
+		// 同じIdPのアカウントは1つのユーザーにのみ紐付ける
+		lg.Warn("identity already linked to another user", "userID", userID)
+		s.redirectAfterLogin(w, r, url.Values{"link": {"error"}, "reason": {"in_use"}})
+		return
+	}
 
-		// フロントエンドへ 302
-		redirectURL := s.RedirectURLAfterLogin
-		http.Redirect(w, r, redirectURL, http.StatusFound)
+	_, err = s.UserIdentityRepository.LinkUserIdentity(r.Context(), &repository.UserIdentity{
+		UserID:   userID,
+		Provider: id.Provider,
+		OIDCIss:  id.Issuer,
+		OIDCSub:  id.Subject,
+		Email:    id.Email,
+	})
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("link user identity error", "err", err)
+		return
+	}
 
+	if s.ActivityRecorder != nil {
+		s.ActivityRecorder.Log(r.Context(), userID, activity.TypeIdentityLinked, r)
 	}
 
-	// CodeExchangeHandlerは、受信した認可レスポンスからトークンリクエストを作成
-	// 取得したトークンを検証し?, その後トークンなどを引数として、コールバック関数を実行
-	// この際にuserInfoEndポイントを叩くこともできるが、今回は実行しない
-	rp.CodeExchangeHandler(callback, s.OIDC.RP)(w, r)
+	// ログイン方法の追加は重要な操作のため、セッションIDを再発行する
+	s.rotateSession(w, r)
+
+	lg.Info("identity linked", "userID", userID)
+	s.redirectAfterLogin(w, r, url.Values{"link": {"linked"}, "provider": {id.Provider}})
+}
+
+// ログイン後のフロントエンドのURLへ302（paramsはクエリに追加する）
+func (s *Server) redirectAfterLogin(w http.ResponseWriter, r *http.Request, params url.Values) {
+	redirectURL := s.RedirectURLAfterLogin
+
+	if len(params) > 0 {
+		if u, err := url.Parse(redirectURL); err == nil {
+			q := u.Query()
+			for k, v := range params {
+				q[k] = v
+			}
+			u.RawQuery = q.Encode()
+			redirectURL = u.String()
+		}
+	}
 
+	http.Redirect(w, r, redirectURL, http.StatusFound)
 }
 
 func (s *Server) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
 	lg := slog.Default().With("handler", "PostAuthLogout")
 
 	// CookieからセッションIDを取得
-	sessCookie, err := r.Cookie("session_id")
-	if err != nil {
+	sessionID := s.Cookies.SessionID(r)
+	if sessionID == "" {
 		http.Error(w, "no session", http.StatusUnauthorized)
 		lg.Warn("logout request without session cookie")
 		return
 	}
 
-	sessionID := sessCookie.Value
-
-	// CSRF: ヘッダとCookieの一致を確認（ダブルサブミット）
+	// CSRF: ヘッダのトークンがセッションIDに紐づくものか確認
 	// - /v1/authはCSRFトークン検証省略対象のため個別で記載
-	csrfCookie, _ := r.Cookie("csrf_token")
-	csrfHeader := r.Header.Get("X-CSRF-Token")
-	if csrfHeader == "" || csrfCookie == nil || csrfHeader != csrfCookie.Value {
+	if s.SessionManager == nil || !s.SessionManager.VerifyCSRF(sessionID, r.Header.Get("X-CSRF-Token")) {
 		http.Error(w, "csrf invalid", http.StatusForbidden)
 		lg.Warn("csrf check failed")
 		return
 	}
 
-	// セッションを削除
-	if s.SessionManager != nil {
-		s.SessionManager.DeleteSession(sessionID)
+	// セッションを削除（削除前にログアウトしたユーザーを操作履歴に記録し、IdPのトークンを取り出す）
+	sess, _ := s.SessionManager.ValidateSession(r.Context(), sessionID)
+	if sess != nil && s.ActivityRecorder != nil {
+		s.ActivityRecorder.Log(r.Context(), sess.UserID, activity.TypeLogout, r)
+	}
+	if err := s.SessionManager.DeleteSession(r.Context(), sessionID); err != nil {
+		lg.Error("delete session failed", "err", err)
 	}
 
 	// Cookieを失効
-	http.SetCookie(w, &http.Cookie{
-		Name:     "session_id",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true, // jsから読み取れないようにする
-		Secure:   true, // ← HTTPS 運用時は true に
-		SameSite: http.SameSiteNoneMode,
-		MaxAge:   -1,
-	})
+	s.Cookies.ClearSession(w)
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "csrf_token",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: false,
-		Secure:   true, // 本番は true
-		SameSite: http.SameSiteNoneMode,
-		Domain:   "mindmap-kanban.com",
-		MaxAge:   -1,
-	})
+	// IdPのトークンを失効させ、IdPからもログアウトするURLを返す（対応していないIdPはnull）
+	res := api.LogoutResult{}
+	if sess != nil && sess.Provider != "" && s.AuthProviders != nil {
+		tokens, err := s.SessionManager.IdPTokens(sess)
+		if err != nil {
+			lg.Warn("decrypt idp tokens failed", "err", err)
+		}
+		if endSessionURL := s.AuthProviders.Logout(r.Context(), sess.Provider, tokens, s.RedirectURLAfterLogout); endSessionURL != "" {
+			res.EndSessionUrl = &endSessionURL
+		}
+	}
+
+	lg.Info("logout success", "endSession", res.EndSessionUrl != nil)
 
-	lg.Info("logout success")
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode LogoutResult", "err", err)
+	}
 	// lg.Info("logout success", "session_id", sessionID)
 
 	// リダイレクト
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+// カレンダーで一度に取得できる最大日数
+const maxCalendarDays = 366
+
+// 期間内に期限・開始日時があるタスクを全プロジェクトから返す
+func (s *Server) GetCalendar(w http.ResponseWriter, r *http.Request, params api.GetCalendarParams) {
+	lg := slog.Default().With("handler", "GetCalendar")
+
+	// 念のための nil ガード
+	if s.UserRepository == nil || s.MinkanStatesRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserRepository", s.UserRepository != nil,
+			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	userData, err := s.UserRepository.FindUserByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	if userData == nil {
+		http.Error(w, "user not found", http.StatusNotFound)
+		lg.Warn("userData not found", "userID", userID)
+		return
+	}
+
+	// from・toはユーザーのタイムゾーンの日付として解釈（toの日の終わりまでを含む）
+	loc := calendar.Location(userData.TimeZone, s.DefaultLocation)
+	from := calendar.StartOfDay(params.From.Time, loc)
+	to := calendar.StartOfDay(params.To.Time, loc).AddDate(0, 0, 1)
+
+	if !from.Before(to) || to.Sub(from) > maxCalendarDays*24*time.Hour+time.Hour {
+		http.Error(w, "invalid date range", http.StatusBadRequest)
+		lg.Warn("invalid date range", "from", params.From.String(), "to", params.To.String())
+		return
+	}
+
+	minkanState, err := s.MinkanStatesRepository.FindStateByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find minkan_state error", "err", err)
+		return
+	}
+
+	if minkanState == nil {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
+	m, err := minkan.Decode(minkanState.StateJSON)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("decode minkan_state error", "err", err)
+		return
+	}
+
+	res := calendar.Result{
+		TimeZone: loc.String(),
+		From:     params.From.String(),
+		To:       params.To.String(),
+		Entries:  calendar.Query(m, from, to, time.Now()),
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode CalendarRes", "err", err)
+	}
+}
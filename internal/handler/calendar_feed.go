@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// フィードのトークンの接頭辞
+	calendarFeedTokenPrefix = "mkcal"
+
+	// フィードに含める期間（現在からの日数）
+	calendarFeedPastDays   = 90
+	calendarFeedFutureDays = 366
+)
+
+// FeedRepository はiCalendarフィードの購読トークンの保存先（*repository.CalendarFeedRepository）
+type FeedRepository interface {
+	FindFeedByUserID(ctx context.Context, userID int64) (*repository.CalendarFeed, error)
+	FindFeedByTokenHash(ctx context.Context, tokenHash []byte) (*repository.CalendarFeed, error)
+	UpsertFeed(ctx context.Context, userID int64, tokenHash []byte) error
+	DeleteFeed(ctx context.Context, userID int64) error
+	TouchFeed(ctx context.Context, userID int64, at time.Time) error
+}
+
+// iCalendarフィードの発行状況を取得
+func (s *Server) GetUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeCalendarFeed")
+
+	// 念のための nil ガード
+	if s.CalendarFeedRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasCalendarFeedRepository", s.CalendarFeedRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	feed, err := s.CalendarFeedRepository.FindFeedByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find calendar feed error", "err", err)
+		return
+	}
+
+	res := api.CalendarFeedStatus{Enabled: feed != nil}
+	if feed != nil {
+		res.CreatedAt = &feed.CreatedAt
+		res.LastAccessedAt = feed.LastAccessedAt
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode CalendarFeedStatus", "err", err)
+	}
+}
+
+// iCalendarフィードのURLを発行（発行済みの場合は置き換え）
+func (s *Server) PostUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostUsersMeCalendarFeed")
+
+	// 念のための nil ガード
+	if s.CalendarFeedRepository == nil || s.MailLinks == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasCalendarFeedRepository", s.CalendarFeedRepository != nil,
+			"hasMailLinks", s.MailLinks != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// 購読URLの発行はブラウザのログインでのみ許可する（漏れたトークンから別の認証情報を作れないように）
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("calendar feed issuance with access token", "userID", userID)
+		return
+	}
+
+	tok, err := token.New(calendarFeedTokenPrefix)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to generate token", "err", err)
+		return
+	}
+
+	if err := s.CalendarFeedRepository.UpsertFeed(r.Context(), userID, token.Hash(tok)); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to save calendar feed", "err", err)
+		return
+	}
+
+	feed, err := s.CalendarFeedRepository.FindFeedByUserID(r.Context(), userID)
+
+	if err != nil || feed == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find calendar feed error", "err", err)
+		return
+	}
+
+	lg.Info("calendar feed issued", "userID", userID)
+
+	res := api.CalendarFeedToken{
+		Url:       s.MailLinks.CalendarFeedURL(tok),
+		CreatedAt: feed.CreatedAt,
+	}
+
+	// 認証情報を発行したため、セッションIDを再発行する
+	s.rotateSession(w, r)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode CalendarFeedToken", "err", err)
+	}
+}
+
+// iCalendarフィードのURLを無効化
+func (s *Server) DeleteUsersMeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "DeleteUsersMeCalendarFeed")
+
+	// 念のための nil ガード
+	if s.CalendarFeedRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasCalendarFeedRepository", s.CalendarFeedRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	if err := s.CalendarFeedRepository.DeleteFeed(r.Context(), userID); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to delete calendar feed", "err", err)
+		return
+	}
+
+	lg.Info("calendar feed revoked", "userID", userID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// カレンダーアプリから購読するiCalendarフィード（セッションは使わず、URLのトークンのみで認証）
+func (s *Server) GetCalendarFeedFeedFile(w http.ResponseWriter, r *http.Request, feedFile string) {
+	lg := slog.Default().With("handler", "GetCalendarFeedFeedFile")
+
+	// 念のための nil ガード
+	if s.CalendarFeedRepository == nil || s.UserRepository == nil || s.MinkanStatesRepository == nil || s.MailLinks == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasCalendarFeedRepository", s.CalendarFeedRepository != nil,
+			"hasUserRepository", s.UserRepository != nil,
+			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+			"hasMailLinks", s.MailLinks != nil,
+		)
+		return
+	}
+
+	// 不正・無効化済みのトークンは存在しないURLとして扱う
+	tok, ok := strings.CutSuffix(feedFile, ".ics")
+	if !ok || !strings.HasPrefix(tok, calendarFeedTokenPrefix+"_") {
+		http.NotFound(w, r)
+		return
+	}
+
+	feed, err := s.CalendarFeedRepository.FindFeedByTokenHash(r.Context(), token.Hash(tok))
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find calendar feed error", "err", err)
+		return
+	}
+
+	if feed == nil {
+		http.NotFound(w, r)
+		lg.Warn("calendar feed not found")
+		return
+	}
+
+	userID := feed.UserID
+	lg = lg.With("userID", userID)
+
+	userData, err := s.UserRepository.FindUserByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	if userData == nil {
+		http.NotFound(w, r)
+		lg.Warn("userData not found")
+		return
+	}
+
+	minkanState, err := s.MinkanStatesRepository.FindStateByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find minkan_state error", "err", err)
+		return
+	}
+
+	// 未登録の場合は空のカレンダーを返す
+	m := &repository.Minkan{}
+	if minkanState != nil {
+		m, err = minkan.Decode(minkanState.StateJSON)
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("decode minkan_state error", "err", err)
+			return
+		}
+	}
+
+	now := time.Now()
+	loc := calendar.Location(userData.TimeZone, s.DefaultLocation)
+	today := calendar.StartOfDay(now.In(loc), loc)
+
+	ics := (&calendar.Feed{
+		Name:     "Minkan",
+		Location: loc,
+		Entries:  calendar.Query(m, today.AddDate(0, 0, -calendarFeedPastDays), today.AddDate(0, 0, calendarFeedFutureDays), now),
+		NodeURL:  s.MailLinks.NodeURL,
+		Now:      now,
+	}).ICS()
+
+	if err := s.CalendarFeedRepository.TouchFeed(r.Context(), userID, now); err != nil {
+		lg.Warn("failed to update calendar feed access time", "err", err)
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="minkan.ics"`)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if err := ics.Encode(w); err != nil {
+		lg.Error("failed to write calendar feed", "err", err)
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/reminder"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+// fakeFeeds はテスト用のcalendar_feeds（トークンのハッシュで引く）
+type fakeFeeds struct {
+	byHash  map[string]*repository.CalendarFeed
+	lookups [][]byte
+	touched int
+}
+
+func (f *fakeFeeds) FindFeedByUserID(_ context.Context, userID int64) (*repository.CalendarFeed, error) {
+	for _, feed := range f.byHash {
+		if feed.UserID == userID {
+			return feed, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeFeeds) FindFeedByTokenHash(_ context.Context, tokenHash []byte) (*repository.CalendarFeed, error) {
+	f.lookups = append(f.lookups, tokenHash)
+	return f.byHash[string(tokenHash)], nil
+}
+
+func (f *fakeFeeds) UpsertFeed(_ context.Context, userID int64, tokenHash []byte) error {
+	f.byHash[string(tokenHash)] = &repository.CalendarFeed{UserID: userID}
+	return nil
+}
+
+func (f *fakeFeeds) DeleteFeed(_ context.Context, userID int64) error {
+	for h, feed := range f.byHash {
+		if feed.UserID == userID {
+			delete(f.byHash, h)
+		}
+	}
+	return nil
+}
+
+func (f *fakeFeeds) TouchFeed(context.Context, int64, time.Time) error {
+	f.touched++
+	return nil
+}
+
+// 不正な形式・未発行・再発行前のトークンはDBのユーザー等を読む前に404を返す
+// （ユーザー・ミンカンのリポジトリは接続の無いものを渡すため、照合を通ると落ちる）
+func TestCalendarFeedRejectsInvalidTokens(t *testing.T) {
+	feeds := &fakeFeeds{byHash: map[string]*repository.CalendarFeed{}}
+	s := &Server{
+		CalendarFeedRepository: feeds,
+		UserRepository:         &repository.UserRepository{},
+		MinkanStatesRepository: &repository.MinkanStatesRepository{},
+		MailLinks:              &reminder.Links{},
+	}
+	h := api.HandlerWithOptions(s, api.StdHTTPServerOptions{BaseURL: "/v1"})
+
+	old, err := token.New(calendarFeedTokenPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := feeds.UpsertFeed(context.Background(), 1, token.Hash(old)); err != nil {
+		t.Fatal(err)
+	}
+	// 再発行すると古いトークンのハッシュは残らない
+	if err := feeds.DeleteFeed(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	current, err := token.New(calendarFeedTokenPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := feeds.UpsertFeed(context.Background(), 1, token.Hash(current)); err != nil {
+		t.Fatal(err)
+	}
+	unknown, err := token.New(calendarFeedTokenPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	malformed := []string{
+		current,                          // 拡張子なし
+		current + ".ical",                // 拡張子違い
+		"mkpat_abc.ics",                  // 別用途のトークン
+		calendarFeedTokenPrefix + ".ics", // 区切りなし
+	}
+	for _, name := range malformed {
+		t.Run(name, func(t *testing.T) {
+			feeds.lookups = nil
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/calendar/feed/"+name, nil))
+			if rec.Code != http.StatusNotFound {
+				t.Fatalf("status = %d, want 404", rec.Code)
+			}
+			if len(feeds.lookups) != 0 {
+				t.Errorf("malformed token looked up: %d", len(feeds.lookups))
+			}
+		})
+	}
+
+	for name, tok := range map[string]string{"unknown": unknown, "reissued": old} {
+		t.Run(name, func(t *testing.T) {
+			feeds.lookups = nil
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/calendar/feed/"+tok+".ics", nil))
+			if rec.Code != http.StatusNotFound {
+				t.Fatalf("status = %d, want 404", rec.Code)
+			}
+			// 照合は平文ではなくハッシュで行う
+			if len(feeds.lookups) != 1 || !bytes.Equal(feeds.lookups[0], token.Hash(tok)) {
+				t.Errorf("lookups = %x, want hash of token", feeds.lookups)
+			}
+		})
+	}
+
+	if feeds.touched != 0 {
+		t.Errorf("TouchFeed called %d times for rejected tokens", feeds.touched)
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+// クライアントが保持しているversion以降の変更差分を返す
+func (s *Server) GetMinkanChanges(w http.ResponseWriter, r *http.Request, params api.GetMinkanChangesParams) {
+	lg := slog.Default().With("handler", "GetMinkanChanges")
+
+	// 念のための nil ガード
+	if s.ChangeFeed == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency", "hasChangeFeed", s.ChangeFeed != nil)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	res, err := s.ChangeFeed.Since(r.Context(), userID, params.Since)
+
+	if errors.Is(err, minkan.ErrStateNotFound) {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list minkan changes error", "err", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode MinkanChangesRes", "err", err)
+	}
+}
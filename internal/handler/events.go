@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/pubsub"
+)
+
+// SSEのハートビート間隔（プロキシ/LBのアイドルタイムアウト対策）
+const sseHeartbeatInterval = 25 * time.Second
+
+// ログインユーザーのminkan_state更新をServer-Sent Eventsで配信
+func (s *Server) GetMinkanEvents(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetMinkanEvents")
+
+	// 念のための nil ガード
+	if s.EventHub == nil || s.MinkanStatesRepository == nil || s.SessionManager == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasEventHub", s.EventHub != nil,
+			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+			"hasSession", s.SessionManager != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// http.ServerのRead/WriteTimeoutで長時間接続が切られないように、このリクエストだけ無効化
+	rc := http.NewResponseController(w)
+	if err := rc.SetReadDeadline(time.Time{}); err != nil {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		lg.Error("failed to clear read deadline", "err", err)
+		return
+	}
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		lg.Error("failed to clear write deadline", "err", err)
+		return
+	}
+
+	// 初回送信前に購読を開始しておき、取りこぼしを防ぐ
+	events, unsubscribe, err := s.EventHub.Subscribe(userID)
+	if err != nil {
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+		lg.Warn("subscribe failed", "err", err)
+		return
+	}
+	defer unsubscribe()
+
+	// 接続直後に現在のversionを送るため、stateを取得
+	minkanState, err := s.MinkanStatesRepository.FindStateByUserID(r.Context(), userID)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find minkan_state error", "err", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // nginx等のバッファリング無効化
+	w.WriteHeader(http.StatusOK)
+
+	if minkanState != nil {
+		initial := pubsub.Event{
+			Version:   minkanState.Version,
+			UpdatedAt: minkanState.UpdatedAt.UTC(),
+			Origin:    "",
+		}
+		if err := writeMinkanEvent(w, rc, initial); err != nil {
+			lg.Debug("write initial event failed", "err", err)
+			return
+		}
+	} else if err := rc.Flush(); err != nil {
+		lg.Debug("flush failed", "err", err)
+		return
+	}
+
+	// ログアウト・セッションの無効化時はストリームを終了する（アクセストークンの場合はセッションが無い）
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	if !middleware.IsAccessTokenRequest(r.Context()) {
+		defer s.SessionManager.Watch(s.Cookies.SessionID(r), userID, cancel)()
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// クライアント切断・セッションの削除
+			return
+
+		case ev, ok := <-events:
+			// Hubのクローズ（サーバ停止）時はストリームを終了
+			if !ok {
+				return
+			}
+			if err := writeMinkanEvent(w, rc, ev); err != nil {
+				lg.Debug("write event failed", "err", err)
+				return
+			}
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// 1件分のイベントをSSE形式で書き込みflushする
+func writeMinkanEvent(w http.ResponseWriter, rc *http.ResponseController, ev pubsub.Event) error {
+	data, err := json.Marshal(api.MinkanEvent{
+		Version:   ev.Version,
+		UpdatedAt: ev.UpdatedAt,
+		Origin:    ev.Origin,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "id: %d\nevent: minkan\ndata: %s\n\n", ev.Version, data); err != nil {
+		return err
+	}
+
+	return rc.Flush()
+}
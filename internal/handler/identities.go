@@ -0,0 +1,390 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/activity"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// IdentityRepository はアカウントに連携したIdPの保存先（*repository.UserIdentityRepository）
+type IdentityRepository interface {
+	ListUserIdentities(ctx context.Context, userID int64) ([]repository.UserIdentity, error)
+	FindUserIdentity(ctx context.Context, oidcIss, oidcSub string) (*repository.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, tx *sql.Tx, ui *repository.UserIdentity) (int64, error)
+	LinkUserIdentity(ctx context.Context, ui *repository.UserIdentity) (int64, error)
+	DeleteUserIdentity(ctx context.Context, userID, id int64) (bool, error)
+	UpdateLastLoginAt(ctx context.Context, id int64) error
+}
+
+// アカウントに連携したログイン方法（IdP）の一覧を取得
+func (s *Server) GetUsersMeIdentities(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeIdentities")
+
+	// 念のための nil ガード
+	if s.UserIdentityRepository == nil || s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserIdentityRepository", s.UserIdentityRepository != nil,
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// ログイン方法の管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("identity management with access token", "userID", userID)
+		return
+	}
+
+	identities, err := s.UserIdentityRepository.ListUserIdentities(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list user identities error", "err", err)
+		return
+	}
+
+	res := make([]api.UserIdentity, 0, len(identities))
+	for _, ui := range identities {
+		res = append(res, s.userIdentityResponse(&ui))
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode UserIdentity", "err", err)
+	}
+}
+
+// ログイン方法（IdP）の連携を開始し、IdPへのログインURLを返す
+// 連携はIdPのコールバックで、同じユーザーのセッションが確認できた場合に行う（completeLink）
+func (s *Server) PostUsersMeIdentities(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostUsersMeIdentities")
+
+	// 念のための nil ガード
+	if s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// ログイン方法の管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("identity management with access token", "userID", userID)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.UserIdentityLinkReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	p := s.AuthProviders.Get(reqBody.Provider)
+	if p == nil {
+		http.Error(w, "unknown provider", http.StatusBadRequest)
+		lg.Warn("unknown provider", "provider", reqBody.Provider)
+		return
+	}
+
+	if err := s.AuthProviders.SetLinkIntent(w, userID, p.ID); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("set link intent failed", "err", err)
+		return
+	}
+
+	res := api.UserIdentityLinkStarted{
+		LoginUrl: s.PublicBaseURL + "/v1/auth/login?" + url.Values{"provider": {p.ID}}.Encode(),
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode UserIdentityLinkStarted", "err", err)
+	}
+}
+
+// 保留中の連携（メールアドレスが一致した未連携のIdP）を、ログイン中のアカウントに確定する
+func (s *Server) PostUsersMeIdentitiesPending(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostUsersMeIdentitiesPending")
+
+	// 念のための nil ガード
+	if s.UserIdentityRepository == nil || s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserIdentityRepository", s.UserIdentityRepository != nil,
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// ログイン方法の管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("identity management with access token", "userID", userID)
+		return
+	}
+
+	pl := s.AuthProviders.PendingLink(r)
+	if pl == nil {
+		http.Error(w, "no pending link", http.StatusNotFound)
+		return
+	}
+
+	// 保留した時点でメールアドレスが一致したアカウントでのみ確定できる
+	if pl.UserID != userID {
+		http.Error(w, "pending link for another account", http.StatusForbidden)
+		lg.Warn("pending link for another account", "userID", userID)
+		return
+	}
+
+	existing, err := s.UserIdentityRepository.FindUserIdentity(r.Context(), pl.Identity.Issuer, pl.Identity.Subject)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user identity error", "err", err)
+		return
+	}
+
+	if existing != nil && existing.UserID != userID {
+		s.AuthProviders.ClearPendingLink(w)
+		http.Error(w, "identity already linked", http.StatusConflict)
+		lg.Warn("identity already linked to another user", "userID", userID)
+		return
+	}
+
+	// 連携済みでない場合のみ追加する（確定の二重送信は成功として扱う）
+	if existing == nil {
+		_, err := s.UserIdentityRepository.LinkUserIdentity(r.Context(), &repository.UserIdentity{
+			UserID:   userID,
+			Provider: pl.Identity.Provider,
+			OIDCIss:  pl.Identity.Issuer,
+			OIDCSub:  pl.Identity.Subject,
+			Email:    pl.Identity.Email,
+		})
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("link user identity error", "err", err)
+			return
+		}
+
+		existing, err = s.UserIdentityRepository.FindUserIdentity(r.Context(), pl.Identity.Issuer, pl.Identity.Subject)
+		if err != nil || existing == nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("find linked identity error", "err", err)
+			return
+		}
+
+		if s.ActivityRecorder != nil {
+			s.ActivityRecorder.Log(r.Context(), userID, activity.TypeIdentityLinked, r)
+		}
+	}
+
+	s.AuthProviders.ClearPendingLink(w)
+
+	// ログイン方法の追加は重要な操作のため、セッションIDを再発行する
+	s.rotateSession(w, r)
+
+	lg.Info("pending identity linked", "userID", userID)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(s.userIdentityResponse(existing)); err != nil {
+		lg.Error("failed to encode UserIdentity", "err", err)
+	}
+}
+
+// ログイン方法（IdP）の連携を解除
+func (s *Server) DeleteUsersMeIdentitiesIdentityId(w http.ResponseWriter, r *http.Request, identityId int64) {
+	lg := slog.Default().With("handler", "DeleteUsersMeIdentitiesIdentityId")
+
+	// 念のための nil ガード
+	if s.UserIdentityRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserIdentityRepository", s.UserIdentityRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// ログイン方法の管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("identity management with access token", "userID", userID)
+		return
+	}
+
+	// 他のユーザーの連携は該当なしとして扱う
+	deleted, err := s.UserIdentityRepository.DeleteUserIdentity(r.Context(), userID, identityId)
+
+	if errors.Is(err, repository.ErrLastIdentity) {
+		http.Error(w, "cannot unlink the last identity", http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("delete user identity error", "err", err)
+		return
+	}
+
+	if !deleted {
+		http.Error(w, "identity not found", http.StatusNotFound)
+		return
+	}
+
+	if s.ActivityRecorder != nil {
+		s.ActivityRecorder.Log(r.Context(), userID, activity.TypeIdentityUnlinked, r)
+	}
+
+	// 成功だが返すデータなし(204レスポンス)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// 保留中のアカウント連携を取得（連携先のアカウントにログインし直す前に表示する）
+func (s *Server) GetAuthLink(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetAuthLink")
+
+	// 念のための nil ガード
+	if s.UserIdentityRepository == nil || s.AuthProviders == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserIdentityRepository", s.UserIdentityRepository != nil,
+			"hasAuthProviders", s.AuthProviders != nil,
+		)
+		return
+	}
+
+	pl := s.AuthProviders.PendingLink(r)
+	if pl == nil {
+		http.Error(w, "no pending link", http.StatusNotFound)
+		return
+	}
+
+	identities, err := s.UserIdentityRepository.ListUserIdentities(r.Context(), pl.UserID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list user identities error", "err", err)
+		return
+	}
+
+	// 既存のアカウントにログインできるIdP（設定から外したIdPは除く）
+	signInWith := []api.AuthProvider{}
+	seen := make(map[string]bool)
+	for _, ui := range identities {
+		p := s.AuthProviders.Get(ui.Provider)
+		if p == nil || seen[p.ID] {
+			continue
+		}
+		seen[p.ID] = true
+		signInWith = append(signInWith, api.AuthProvider{Id: p.ID, Name: p.DisplayName})
+	}
+
+	res := api.PendingIdentityLink{
+		Provider:   api.AuthProvider{Id: pl.Identity.Provider, Name: s.providerName(pl.Identity.Provider)},
+		Email:      pl.Identity.Email,
+		SignInWith: signInWith,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode PendingIdentityLink", "err", err)
+	}
+}
+
+// 保留中のアカウント連携を破棄
+func (s *Server) DeleteAuthLink(w http.ResponseWriter, r *http.Request) {
+	if s.AuthProviders != nil {
+		s.AuthProviders.ClearPendingLink(w)
+	}
+
+	// 成功だが返すデータなし(204レスポンス)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) userIdentityResponse(ui *repository.UserIdentity) api.UserIdentity {
+	var email *string
+	if ui.Email != "" {
+		email = &ui.Email
+	}
+
+	return api.UserIdentity{
+		Id:           ui.ID,
+		Provider:     ui.Provider,
+		ProviderName: s.providerName(ui.Provider),
+		Email:        email,
+		CreatedAt:    ui.CreatedAt,
+		LastLoginAt:  ui.LastLoginAt,
+	}
+}
+
+// IdPの表示名（設定から外したIdPは識別子）
+func (s *Server) providerName(id string) string {
+	if s.AuthProviders != nil {
+		if p := s.AuthProviders.Get(id); p != nil {
+			return p.DisplayName
+		}
+	}
+	return id
+}
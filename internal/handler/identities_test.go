@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/auth"
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+)
+
+// fakeIdentities はテスト用のuser_identities
+type fakeIdentities struct {
+	rows   []repository.UserIdentity
+	nextID int64
+}
+
+func (f *fakeIdentities) ListUserIdentities(_ context.Context, userID int64) ([]repository.UserIdentity, error) {
+	var res []repository.UserIdentity
+	for _, ui := range f.rows {
+		if ui.UserID == userID {
+			res = append(res, ui)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeIdentities) FindUserIdentity(_ context.Context, oidcIss, oidcSub string) (*repository.UserIdentity, error) {
+	for _, ui := range f.rows {
+		if ui.OIDCIss == oidcIss && ui.OIDCSub == oidcSub {
+			return &ui, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeIdentities) CreateUserIdentity(ctx context.Context, _ *sql.Tx, ui *repository.UserIdentity) (int64, error) {
+	return f.LinkUserIdentity(ctx, ui)
+}
+
+func (f *fakeIdentities) LinkUserIdentity(_ context.Context, ui *repository.UserIdentity) (int64, error) {
+	f.nextID++
+	row := *ui
+	row.ID = f.nextID
+	row.CreatedAt = time.Now()
+	f.rows = append(f.rows, row)
+	return row.ID, nil
+}
+
+func (f *fakeIdentities) DeleteUserIdentity(_ context.Context, userID, id int64) (bool, error) {
+	count, found := 0, -1
+	for i, ui := range f.rows {
+		if ui.UserID == userID {
+			count++
+			if ui.ID == id {
+				found = i
+			}
+		}
+	}
+	if found < 0 {
+		return false, nil
+	}
+	if count <= 1 {
+		return false, repository.ErrLastIdentity
+	}
+	f.rows = append(f.rows[:found], f.rows[found+1:]...)
+	return true, nil
+}
+
+func (f *fakeIdentities) UpdateLastLoginAt(context.Context, int64) error {
+	return nil
+}
+
+func (f *fakeIdentities) add(userID int64, provider, iss, sub string) int64 {
+	id, _ := f.LinkUserIdentity(context.Background(), &repository.UserIdentity{UserID: userID, Provider: provider, OIDCIss: iss, OIDCSub: sub})
+	return id
+}
+
+func (f *fakeIdentities) linked(userID int64, sub string) bool {
+	for _, ui := range f.rows {
+		if ui.UserID == userID && ui.OIDCSub == sub {
+			return true
+		}
+	}
+	return false
+}
+
+// ログイン方法の連携を試すServer（IdPはGoogleとGitHub。IdPとの通信は行わない）
+func newIdentityServer(t *testing.T) (*Server, *fakeIdentities, http.Handler) {
+	t.Helper()
+	cfg := &configs.ConfigList{
+		AuthProviders: []configs.AuthProviderConfig{
+			{ID: "google", Type: configs.AuthProviderTypeOIDC, DisplayName: "Google", Issuer: "https://accounts.google.test"},
+			{ID: "github", Type: configs.AuthProviderTypeGitHub, DisplayName: "GitHub"},
+		},
+		OIDCCookieKey:     base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		CookieSessionName: "session_id",
+		CookieCSRFName:    "csrf_token",
+		CookiePath:        "/",
+		CookieSameSite:    http.SameSiteLaxMode,
+	}
+	reg, err := auth.NewRegistry(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := &fakeIdentities{}
+	s := &Server{
+		AuthProviders:          reg,
+		SessionManager:         session.NewSessionManager(session.NewMemoryStore(), time.Hour, 24*time.Hour, "csrf-secret", "token-key"),
+		Cookies:                cookies.NewPolicy(cfg),
+		RedirectURLAfterLogin:  "https://app.test/app",
+		UserIdentityRepository: ids,
+		PublicBaseURL:          "https://api.test",
+	}
+	h := middleware.RequireLogin(api.HandlerWithOptions(s, api.StdHTTPServerOptions{BaseURL: "/v1"}), middleware.RequireLoginOptions{
+		SessionManager:   s.SessionManager,
+		Cookies:          s.Cookies,
+		RequireCSRFToken: true,
+	})
+	return s, ids, h
+}
+
+func login(t *testing.T, s *Server, userID int64) string {
+	t.Helper()
+	sessID, err := s.SessionManager.CreateSession(context.Background(), userID, session.Meta{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sessID
+}
+
+// セッションのCookie・CSRFトークンとextraのCookieを付けたリクエスト
+func sessionRequest(s *Server, method, target, sessID string, extra []*http.Cookie) *http.Request {
+	r := httptest.NewRequest(method, target, nil)
+	if sessID != "" {
+		r.AddCookie(&http.Cookie{Name: s.Cookies.SessionName, Value: sessID})
+		r.Header.Set("X-CSRF-Token", s.SessionManager.CSRFToken(sessID))
+	}
+	for _, c := range extra {
+		r.AddCookie(c)
+	}
+	return r
+}
+
+func cookieNamed(res *http.Response, name string) *http.Cookie {
+	for _, c := range res.Cookies() {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+var githubAlice = &auth.Identity{Provider: "github", Issuer: "https://github.com", Subject: "42", Email: "alice@example.com", EmailVerified: true}
+
+// ログイン中のユーザーが開始した連携は、コールバックで同じユーザーのセッションが確認できた場合のみ行う
+func TestLinkIdentity(t *testing.T) {
+	s, ids, h := newIdentityServer(t)
+	ids.add(1, "google", "https://accounts.google.test", "g-1")
+	sessID := login(t, s, 1)
+	otherSessID := login(t, s, 2)
+
+	// 連携の開始: IdPへのログインURLと、連携の開始を記録したCookie
+	start := func(provider string) *http.Cookie {
+		t.Helper()
+		r := sessionRequest(s, http.MethodPost, "/v1/users/me/identities", sessID, nil)
+		r.Body = io.NopCloser(strings.NewReader(`{"provider": "` + provider + `"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		var res api.UserIdentityLinkStarted
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || w.Code != http.StatusOK {
+			t.Fatalf("start link = %d %s", w.Code, w.Body)
+		}
+		if res.LoginUrl != "https://api.test/v1/auth/login?provider="+provider {
+			t.Errorf("login url = %s", res.LoginUrl)
+		}
+		intent := cookieNamed(w.Result(), "link_intent")
+		if intent == nil {
+			t.Fatal("link intent cookie missing")
+		}
+		return intent
+	}
+
+	// IdPからのコールバック（ログインの完了）
+	callback := func(sessID string, intent *http.Cookie, id *auth.Identity) (url.Values, *http.Response) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		r := sessionRequest(s, http.MethodGet, "/v1/auth/callback/"+id.Provider, sessID, []*http.Cookie{intent})
+		s.completeLogin(w, r, slog.Default(), id)
+		res := w.Result()
+		loc, err := res.Location()
+		if err != nil || res.StatusCode != http.StatusFound || !strings.HasPrefix(loc.String(), s.RedirectURLAfterLogin+"?") {
+			t.Fatalf("callback = %d %v", res.StatusCode, loc)
+		}
+		return loc.Query(), res
+	}
+
+	// 別のユーザーのセッション・開始したIdPと異なるIdPでのコールバックでは連携しない
+	if q, _ := callback(otherSessID, start("github"), githubAlice); q.Get("link") != "error" || q.Get("reason") != "session" {
+		t.Errorf("callback with another session = %v", q)
+	}
+	if q, _ := callback(sessID, start("google"), githubAlice); q.Get("link") != "error" || q.Get("reason") != "session" {
+		t.Errorf("callback with another provider = %v", q)
+	}
+	if ids.linked(1, "42") || ids.linked(2, "42") {
+		t.Fatal("linked without a matching session")
+	}
+
+	q, res := callback(sessID, start("github"), githubAlice)
+	if q.Get("link") != "linked" || q.Get("provider") != "github" || !ids.linked(1, "42") {
+		t.Fatalf("callback = %v, identities %+v", q, ids.rows)
+	}
+	// 重要な操作のため、セッションIDを再発行する
+	if c := cookieNamed(res, s.Cookies.SessionName); c == nil || c.Value == sessID {
+		t.Errorf("session not rotated: %v", c)
+	}
+
+	// 他のユーザーに連携済みのIdPのアカウントは連携しない
+	otherGitHub := *githubAlice
+	otherGitHub.Subject = "43"
+	ids.add(2, "github", "https://github.com", "43")
+	sessID = login(t, s, 1)
+	if q, _ := callback(sessID, start("github"), &otherGitHub); q.Get("link") != "error" || q.Get("reason") != "in_use" || ids.linked(1, "43") {
+		t.Errorf("callback with identity of another user = %v", q)
+	}
+}
+
+// メールアドレスが一致して保留した連携は、連携先のアカウントでログインし直した場合のみ確定できる
+func TestConfirmPendingLink(t *testing.T) {
+	s, ids, h := newIdentityServer(t)
+	pending := func(userID int64, id *auth.Identity) *http.Cookie {
+		t.Helper()
+		w := httptest.NewRecorder()
+		if err := s.AuthProviders.SetPendingLink(w, userID, id); err != nil {
+			t.Fatal(err)
+		}
+		return cookieNamed(w.Result(), "link_pending")
+	}
+	confirm := func(sessID string, c *http.Cookie) *httptest.ResponseRecorder {
+		var extra []*http.Cookie
+		if c != nil {
+			extra = append(extra, c)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sessionRequest(s, http.MethodPost, "/v1/users/me/identities/pending", sessID, extra))
+		return w
+	}
+
+	alice, bob := login(t, s, 1), login(t, s, 2)
+	c := pending(1, githubAlice)
+
+	if w := confirm(alice, nil); w.Code != http.StatusNotFound {
+		t.Errorf("confirm without pending link = %d", w.Code)
+	}
+	if w := confirm(bob, c); w.Code != http.StatusForbidden || ids.linked(2, "42") {
+		t.Errorf("confirm by another account = %d", w.Code)
+	}
+
+	w := confirm(alice, c)
+	var res api.UserIdentity
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || w.Code != http.StatusCreated {
+		t.Fatalf("confirm = %d %s", w.Code, w.Body)
+	}
+	if res.Provider != "github" || res.ProviderName != "GitHub" || res.Email == nil || *res.Email != "alice@example.com" || !ids.linked(1, "42") {
+		t.Errorf("confirmed identity = %+v", res)
+	}
+	if cleared := cookieNamed(w.Result(), "link_pending"); cleared == nil || cleared.MaxAge >= 0 {
+		t.Errorf("pending link cookie not cleared: %v", cleared)
+	}
+
+	// 二重送信は成功として扱い、重複して連携しない
+	alice = login(t, s, 1)
+	if w := confirm(alice, c); w.Code != http.StatusCreated || len(ids.rows) != 1 {
+		t.Errorf("resubmitted confirm = %d, identities %+v", w.Code, ids.rows)
+	}
+
+	// 保留中に他のユーザーが連携したIdPのアカウントは確定できない
+	if w := confirm(bob, pending(2, githubAlice)); w.Code != http.StatusConflict || ids.linked(2, "42") {
+		t.Errorf("confirm identity linked to another user = %d", w.Code)
+	}
+}
+
+// 最後のログイン方法は解除できない（ログインできなくなるため）
+func TestUnlinkIdentity(t *testing.T) {
+	s, ids, h := newIdentityServer(t)
+	googleID := ids.add(1, "google", "https://accounts.google.test", "g-1")
+	githubID := ids.add(1, "github", "https://github.com", "42")
+	otherID := ids.add(2, "github", "https://github.com", "43")
+	sessID := login(t, s, 1)
+
+	unlink := func(id int64) int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sessionRequest(s, http.MethodDelete, "/v1/users/me/identities/"+strconv.FormatInt(id, 10), sessID, nil))
+		return w.Code
+	}
+
+	if code := unlink(otherID); code != http.StatusNotFound || !ids.linked(2, "43") {
+		t.Errorf("unlink identity of another user = %d", code)
+	}
+	if code := unlink(githubID); code != http.StatusNoContent || ids.linked(1, "42") {
+		t.Errorf("unlink = %d", code)
+	}
+	if code := unlink(googleID); code != http.StatusConflict || !ids.linked(1, "g-1") {
+		t.Errorf("unlink last identity = %d", code)
+	}
+}
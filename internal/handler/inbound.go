@@ -0,0 +1,366 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/inbound"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// 取り込みリクエストのボディの最大サイズ
+	maxInboundBodyBytes = 64 << 10
+
+	// 秘密URLから取り込む場合のminkan更新元
+	inboundOriginHTTP = "inbound:http"
+
+	// プロジェクトIDの最大長（inbound_tokens.pj_id）
+	maxPjIDLen = 64
+)
+
+// タスク取り込み用URLの発行状況を取得
+func (s *Server) GetUsersMeInbound(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeInbound")
+
+	// 念のための nil ガード
+	if s.InboundCapturer == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasInboundCapturer", s.InboundCapturer != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	t, err := s.InboundCapturer.Tokens.FindInboundTokenByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find inbound token error", "err", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(s.inboundStatus(t)); err != nil {
+		lg.Error("failed to encode InboundStatus", "err", err)
+	}
+}
+
+// タスク取り込み用URL・メールアドレスを発行（発行済みの場合は置き換え）
+func (s *Server) PostUsersMeInbound(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostUsersMeInbound")
+
+	// 念のための nil ガード
+	if s.InboundCapturer == nil || s.MailLinks == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasInboundCapturer", s.InboundCapturer != nil,
+			"hasMailLinks", s.MailLinks != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// 取り込み用トークンの発行はブラウザのログインでのみ許可する（漏れたトークンから別の認証情報を作れないように）
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("inbound token issuance with access token", "userID", userID)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.InboundSettingsReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	if !validInboundPjID(reqBody.PjId) {
+		http.Error(w, "invalid pjId", http.StatusBadRequest)
+		lg.Warn("invalid pjId")
+		return
+	}
+
+	tok, err := inbound.NewToken()
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to generate token", "err", err)
+		return
+	}
+
+	if err := s.InboundCapturer.Tokens.UpsertInboundToken(r.Context(), userID, token.Hash(tok), reqBody.PjId); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to save inbound token", "err", err)
+		return
+	}
+
+	t, err := s.InboundCapturer.Tokens.FindInboundTokenByUserID(r.Context(), userID)
+
+	if err != nil || t == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find inbound token error", "err", err)
+		return
+	}
+
+	lg.Info("inbound token issued", "userID", userID)
+
+	res := api.InboundToken{
+		Url:       s.MailLinks.InboundURL(tok),
+		PjId:      t.PjID,
+		CreatedAt: t.CreatedAt,
+	}
+	if s.inboundMailEnabled() {
+		email := tok + "@" + s.InboundMailDomain
+		res.Email = &email
+	}
+
+	// 認証情報を発行したため、セッションIDを再発行する
+	s.rotateSession(w, r)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode InboundToken", "err", err)
+	}
+}
+
+// 取り込み先のプロジェクトを変更
+func (s *Server) PutUsersMeInbound(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PutUsersMeInbound")
+
+	// 念のための nil ガード
+	if s.InboundCapturer == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasInboundCapturer", s.InboundCapturer != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.InboundSettingsReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	if !validInboundPjID(reqBody.PjId) {
+		http.Error(w, "invalid pjId", http.StatusBadRequest)
+		lg.Warn("invalid pjId")
+		return
+	}
+
+	found, err := s.InboundCapturer.Tokens.UpdateInboundPjID(r.Context(), userID, reqBody.PjId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to update inbound token", "err", err)
+		return
+	}
+
+	if !found {
+		http.Error(w, "inbound token not found", http.StatusNotFound)
+		lg.Warn("inbound token not found", "userID", userID)
+		return
+	}
+
+	t, err := s.InboundCapturer.Tokens.FindInboundTokenByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find inbound token error", "err", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(s.inboundStatus(t)); err != nil {
+		lg.Error("failed to encode InboundStatus", "err", err)
+	}
+}
+
+// タスク取り込み用URL・メールアドレスを無効化
+func (s *Server) DeleteUsersMeInbound(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "DeleteUsersMeInbound")
+
+	// 念のための nil ガード
+	if s.InboundCapturer == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasInboundCapturer", s.InboundCapturer != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	if err := s.InboundCapturer.Tokens.DeleteInboundToken(r.Context(), userID); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to delete inbound token", "err", err)
+		return
+	}
+
+	lg.Info("inbound token revoked", "userID", userID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// 秘密URLからタスクを取り込む（セッションは使わず、URLのトークンのみで認証）
+func (s *Server) PostInboundInboundToken(w http.ResponseWriter, r *http.Request, inboundToken string) {
+	lg := slog.Default().With("handler", "PostInboundInboundToken")
+
+	// 念のための nil ガード
+	if s.InboundCapturer == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasInboundCapturer", s.InboundCapturer != nil,
+		)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxInboundBodyBytes)
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	// 不正・無効化済みのトークンは存在しないURLとして扱う
+	t, err := s.InboundCapturer.Lookup(r.Context(), inboundToken)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find inbound token error", "err", err)
+		return
+	}
+
+	if t == nil {
+		http.NotFound(w, r)
+		lg.Warn("inbound token not found")
+		return
+	}
+
+	lg = lg.With("userID", t.UserID)
+
+	var reqBody api.InboundCaptureReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	item := inbound.Item{}
+	if reqBody.Title != nil {
+		item.Title = *reqBody.Title
+	}
+	if reqBody.Body != nil {
+		item.Body = *reqBody.Body
+	}
+
+	res, err := s.InboundCapturer.Capture(r.Context(), t, item, inboundOriginHTTP)
+
+	if errors.Is(err, inbound.ErrEmptyItem) {
+		http.Error(w, "title or body is required", http.StatusBadRequest)
+		lg.Warn("empty inbound item")
+		return
+	}
+
+	if errors.Is(err, inbound.ErrNoProject) || errors.Is(err, minkan.ErrStateNotFound) {
+		http.Error(w, "no project to capture into", http.StatusConflict)
+		lg.Warn("no project to capture into", "err", err)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("capture inbound item error", "err", err)
+		return
+	}
+
+	lg.Info("inbound item captured", "pjID", res.PjID, "nodeID", res.NodeID, "version", res.Version)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(api.InboundCaptureRes{
+		PjId:    res.PjID,
+		NodeId:  res.NodeID,
+		Version: res.Version,
+	}); err != nil {
+		lg.Error("failed to encode InboundCaptureRes", "err", err)
+	}
+}
+
+func (s *Server) inboundMailEnabled() bool {
+	return s.InboundSMTP != nil && s.InboundMailDomain != ""
+}
+
+func (s *Server) inboundStatus(t *repository.InboundToken) api.InboundStatus {
+	res := api.InboundStatus{Enabled: t != nil, MailEnabled: s.inboundMailEnabled()}
+	if t != nil {
+		res.PjId = t.PjID
+		res.CreatedAt = &t.CreatedAt
+		res.LastUsedAt = t.LastUsedAt
+	}
+	return res
+}
+
+func validInboundPjID(pjID *string) bool {
+	return pjID == nil || (*pjID != "" && len(*pjID) <= maxPjIDLen)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/livesync"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+)
+
+// WebSocketへアップグレードし、ライブ同期を開始
+func (s *Server) GetMinkanLive(w http.ResponseWriter, r *http.Request, params api.GetMinkanLiveParams) {
+	lg := slog.Default().With("handler", "GetMinkanLive")
+
+	// 念のための nil ガード
+	if s.LiveHub == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency", "hasLiveHub", s.LiveHub != nil)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// 接続後に更新を受け付けるため、参照のみのアクセストークンは拒否する
+	if middleware.IsReadOnlyAccessToken(r.Context()) {
+		http.Error(w, "insufficient scope", http.StatusForbidden)
+		lg.Warn("read-only access token used for live sync", "userID", userID)
+		return
+	}
+
+	// CSRF: クエリのトークンがセッションIDに紐づくものか確認
+	// - GETのためRequireLoginでは検証されない & WebSocketはヘッダを付与できないのでクエリで受け取る
+	// - アクセストークンで認証した場合はブラウザが自動で送るものではないため検証しない
+	if !middleware.IsAccessTokenRequest(r.Context()) {
+		if s.SessionManager == nil || !s.SessionManager.VerifyCSRF(s.Cookies.SessionID(r), params.CsrfToken) {
+			http.Error(w, "csrf invalid", http.StatusForbidden)
+			lg.Warn("csrf check failed")
+			return
+		}
+	}
+
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		// クロスサイトWebSocketハイジャック対策として、CORS許可オリジンからの接続のみ受け付ける
+		// - Originヘッダが無い（ブラウザ以外の）クライアントはCSRFトークンで判定済み
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			return origin == "" || origin == s.AllowedOrigin
+		},
+	}
+
+	info := livesync.ClientInfo{}
+	if params.DeviceId != nil {
+		info.DeviceID = *params.DeviceId
+	}
+	if params.DeviceName != nil {
+		info.DeviceName = *params.DeviceName
+	}
+
+	// Upgrade失敗時は upgrader がエラーレスポンスを返す
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		lg.Warn("websocket upgrade failed", "err", err, "origin", r.Header.Get("Origin"))
+		return
+	}
+
+	lg.Info("live connection opened", "userID", userID, "deviceID", info.DeviceID)
+
+	// ハイジャック後はリクエストのcontextがキャンセルされないことがあるため、切断検知はHub側のread/pingで行う
+	// ログアウト・セッションの無効化時はcontextを終了させて切断する（アクセストークンの場合はセッションが無い）
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	if !middleware.IsAccessTokenRequest(r.Context()) {
+		defer s.SessionManager.Watch(s.Cookies.SessionID(r), userID, cancel)()
+	}
+
+	err = s.LiveHub.Serve(ctx, ws, userID, info)
+	if err != nil && !errors.Is(err, livesync.ErrHubClosed) {
+		lg.Error("live connection error", "err", err)
+	}
+}
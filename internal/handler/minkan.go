@@ -8,11 +8,12 @@ import (
 
 	"github.com/yopi416/mind-kanban-backend/api"
 	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
 	"github.com/yopi416/mind-kanban-backend/internal/repository"
 )
 
 // あるユーザーのminkan_statesのjsonとversion(楽観ロック用）を取得しレスポンス
-func (s *Server) GetMinkan(w http.ResponseWriter, r *http.Request) {
+func (s *Server) GetMinkan(w http.ResponseWriter, r *http.Request, params api.GetMinkanParams) {
 	lg := slog.Default().With("handler", "GetMinkan")
 
 	// 念のための nil ガード
@@ -42,10 +43,36 @@ func (s *Server) GetMinkan(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if minkanState == nil {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
 	// DBデータ用いてをレスポンス用Go構造体を作成
 	response := api.MinkanGetRes{
-		Minkan:  minkanState.StateJSON, // json.RawMessage
-		Version: minkanState.Version,
+		Minkan:        minkanState.StateJSON, // json.RawMessage
+		SchemaVersion: minkanState.SchemaVersion,
+		Version:       minkanState.Version,
+	}
+
+	// include=progressの場合は全プロジェクトの進捗を添える
+	if params.Include != nil && *params.Include == api.Progress && s.ProgressCache != nil {
+		projects, err := s.ProgressCache.Projects(userID, minkanState)
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("compute progress error", "err", err)
+			return
+		}
+
+		b, err := json.Marshal(projects)
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("failed to encode progress", "err", err)
+			return
+		}
+		progress := json.RawMessage(b)
+		response.Progress = &progress
 	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -58,14 +85,14 @@ func (s *Server) GetMinkan(w http.ResponseWriter, r *http.Request) {
 }
 
 // あるユーザーのminkan_statesを置き換え
-func (s *Server) PutMinkan(w http.ResponseWriter, r *http.Request) {
+func (s *Server) PutMinkan(w http.ResponseWriter, r *http.Request, params api.PutMinkanParams) {
 	lg := slog.Default().With("handler", "PutMinkan")
 
 	// 念のための nil ガード
-	if s.MinkanStatesRepository == nil {
+	if s.MinkanStore == nil {
 		http.Error(w, "internal server error", http.StatusInternalServerError)
 		lg.Error("missing dependency",
-			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+			"hasMinkanStore", s.MinkanStore != nil,
 		)
 		return
 	}
@@ -95,8 +122,28 @@ func (s *Server) PutMinkan(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// minkanデータとversion + 1をDBに登録
-	err = s.MinkanStatesRepository.UpdateStateByUserID(r.Context(), reqBody.Minkan, userID, reqBody.Version)
+	// 期限・開始日時・優先度を検証し、日時はUTCに揃えて保存する
+	stateJSON, err := minkan.PrepareState(reqBody.Minkan)
+	if errors.Is(err, minkan.ErrInvalidSchedule) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lg.Warn("invalid schedule", "err", err)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "invalid minkan", http.StatusBadRequest)
+		lg.Warn("decode minkan error", "err", err)
+		return
+	}
+
+	// 同一ユーザーの他端末への更新通知に載せる更新元
+	origin := ""
+	if params.XMinkanOrigin != nil {
+		origin = *params.XMinkanOrigin
+	}
+
+	// minkanデータとversion + 1をDBに登録（Store経由で他端末へも通知される）
+	newVersion, err := s.MinkanStore.Replace(r.Context(), userID, stateJSON, reqBody.Version, origin)
 
 	if errors.Is(err, repository.ErrOptimisticLock) {
 		http.Error(w, "version conflict", http.StatusConflict)
@@ -104,6 +151,12 @@ func (s *Server) PutMinkan(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if errors.Is(err, minkan.ErrStateNotFound) {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
 	if err != nil {
 		http.Error(w, "internal server error", http.StatusInternalServerError)
 		lg.Error("update state error", "err", err)
@@ -112,7 +165,7 @@ func (s *Server) PutMinkan(w http.ResponseWriter, r *http.Request) {
 
 	// 置き換えた後のversionを返す
 	resBody := api.MinkanPutRes{
-		Version: reqBody.Version + 1,
+		Version: newVersion,
 	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
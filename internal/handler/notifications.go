@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"encoding/json"
+	"html/template"
+	"log/slog"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// 通知設定を取得
+func (s *Server) GetUsersMeNotifications(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeNotifications")
+
+	// 念のための nil ガード
+	if s.NotificationSettingsRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasNotificationSettingsRepository", s.NotificationSettingsRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	settings, err := s.NotificationSettingsRepository.FindSettingsByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find notification settings error", "err", err)
+		return
+	}
+
+	writeNotificationSettings(w, lg, settings)
+}
+
+// 通知設定を更新
+func (s *Server) PutUsersMeNotifications(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PutUsersMeNotifications")
+
+	// 念のための nil ガード
+	if s.NotificationSettingsRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasNotificationSettingsRepository", s.NotificationSettingsRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.NotificationSettings
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	settings := &repository.NotificationSettings{
+		UserID:         userID,
+		EmailReminders: reqBody.EmailReminders,
+		EmailOverdue:   reqBody.EmailOverdue,
+	}
+
+	if err := s.NotificationSettingsRepository.UpsertSettings(r.Context(), settings); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to update notification settings", "err", err)
+		return
+	}
+
+	writeNotificationSettings(w, lg, settings)
+}
+
+func writeNotificationSettings(w http.ResponseWriter, lg *slog.Logger, settings *repository.NotificationSettings) {
+	res := api.NotificationSettings{
+		EmailReminders: settings.EmailReminders,
+		EmailOverdue:   settings.EmailOverdue,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode NotificationSettings", "err", err)
+	}
+}
+
+// 配信停止ページ（ログイン不要のため、メール内のリンクから直接開かれる）
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Minkan 通知メールの配信停止</title>
+</head>
+<body>
+<h1>通知メールの配信停止</h1>
+{{if .Done}}
+<p>通知メール(リマインダー・期限切れ通知)の配信を停止しました。</p>
+<p>再開する場合は、Minkanのユーザー設定から変更してください。</p>
+{{else}}
+<p>Minkanからの通知メール(リマインダー・期限切れ通知)の配信を停止します。</p>
+<form method="post" action="">
+<button type="submit">配信を停止する</button>
+</form>
+{{end}}
+</body>
+</html>
+`))
+
+// メール内の配信停止リンクから開く確認ページ（設定は変更しない）
+func (s *Server) GetNotificationsUnsubscribe(w http.ResponseWriter, r *http.Request, params api.GetNotificationsUnsubscribeParams) {
+	lg := slog.Default().With("handler", "GetNotificationsUnsubscribe")
+
+	// 念のための nil ガード
+	if s.MailLinks == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency", "hasMailLinks", s.MailLinks != nil)
+		return
+	}
+
+	if _, ok := s.MailLinks.ParseUnsubscribeToken(params.Token); !ok {
+		http.Error(w, "invalid token", http.StatusBadRequest)
+		lg.Warn("invalid unsubscribe token")
+		return
+	}
+
+	writeUnsubscribePage(w, lg, false)
+}
+
+// 全ての通知メールを停止する（ワンクリック配信停止にも対応）
+func (s *Server) PostNotificationsUnsubscribe(w http.ResponseWriter, r *http.Request, params api.PostNotificationsUnsubscribeParams) {
+	lg := slog.Default().With("handler", "PostNotificationsUnsubscribe")
+
+	// 念のための nil ガード
+	if s.MailLinks == nil || s.NotificationSettingsRepository == nil || s.UserRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasMailLinks", s.MailLinks != nil,
+			"hasNotificationSettingsRepository", s.NotificationSettingsRepository != nil,
+			"hasUserRepository", s.UserRepository != nil,
+		)
+		return
+	}
+
+	userID, ok := s.MailLinks.ParseUnsubscribeToken(params.Token)
+	if !ok {
+		http.Error(w, "invalid token", http.StatusBadRequest)
+		lg.Warn("invalid unsubscribe token")
+		return
+	}
+
+	userData, err := s.UserRepository.FindUserByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	// 退会済みの場合は送信対象も無いため、停止済みとして扱う
+	if userData == nil {
+		writeUnsubscribePage(w, lg, true)
+		return
+	}
+
+	settings := &repository.NotificationSettings{
+		UserID:         userID,
+		EmailReminders: false,
+		EmailOverdue:   false,
+	}
+
+	if err := s.NotificationSettingsRepository.UpsertSettings(r.Context(), settings); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to unsubscribe", "userID", userID, "err", err)
+		return
+	}
+
+	lg.Info("unsubscribed from notification emails", "userID", userID)
+	writeUnsubscribePage(w, lg, true)
+}
+
+func writeUnsubscribePage(w http.ResponseWriter, lg *slog.Logger, done bool) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	if err := unsubscribePage.Execute(w, struct{ Done bool }{done}); err != nil {
+		lg.Error("failed to render unsubscribe page", "err", err)
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+)
+
+// プロジェクトの各ノードの部分木の進捗（末端タスクの完了数）を返す
+func (s *Server) GetProjectsPjIdProgress(w http.ResponseWriter, r *http.Request, pjId string) {
+	lg := slog.Default().With("handler", "GetProjectsPjIdProgress")
+
+	// 念のための nil ガード
+	if s.MinkanStatesRepository == nil || s.ProgressCache == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+			"hasProgressCache", s.ProgressCache != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	minkanState, err := s.MinkanStatesRepository.FindStateByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find minkan_state error", "err", err)
+		return
+	}
+
+	if minkanState == nil {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
+	res, err := s.ProgressCache.Project(userID, minkanState, pjId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("compute progress error", "err", err)
+		return
+	}
+
+	if res == nil {
+		http.Error(w, "project not found", http.StatusNotFound)
+		lg.Warn("project not found", "pjID", pjId)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode ProjectProgress", "err", err)
+	}
+}
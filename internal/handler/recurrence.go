@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/recurrence"
+)
+
+// 発生予定のプレビューの既定件数
+const defaultPreviewCount = 5
+
+// 保存済みノードの繰り返し設定から、今後の発生日時を返す
+func (s *Server) GetProjectsPjIdNodesNodeIdOccurrences(w http.ResponseWriter, r *http.Request, pjId string, nodeId string, params api.GetProjectsPjIdNodesNodeIdOccurrencesParams) {
+	lg := slog.Default().With("handler", "GetProjectsPjIdNodesNodeIdOccurrences")
+
+	// 念のための nil ガード
+	if s.UserRepository == nil || s.MinkanStatesRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserRepository", s.UserRepository != nil,
+			"hasMinkanRepository", s.MinkanStatesRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	count, ok := previewCount(params.Count)
+	if !ok {
+		http.Error(w, "invalid count", http.StatusBadRequest)
+		lg.Warn("invalid count", "count", *params.Count)
+		return
+	}
+
+	minkanState, err := s.MinkanStatesRepository.FindStateByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find minkan_state error", "err", err)
+		return
+	}
+
+	if minkanState == nil {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
+	m, err := minkan.Decode(minkanState.StateJSON)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("decode minkan_state error", "err", err)
+		return
+	}
+
+	pj, ok := m.Projects[pjId]
+	idx := -1
+	if ok {
+		idx = minkan.FindNode(&pj, nodeId)
+	}
+	if idx < 0 || pj.Nodes[idx].Data.Recurrence == nil || pj.Nodes[idx].Data.DueAt == nil {
+		http.Error(w, "recurring node not found", http.StatusNotFound)
+		lg.Warn("recurring node not found", "pjID", pjId, "nodeID", nodeId)
+		return
+	}
+
+	loc, err := s.userLocation(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	rule, occurrences, err := recurrence.PreviewNode(&pj.Nodes[idx], count, loc)
+
+	if err != nil {
+		// 保存済みのルールは検証済みのため、ここで失敗するのはデータ不整合
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("invalid stored recurrence", "err", err)
+		return
+	}
+
+	writePreview(w, lg, rule.String(), loc, occurrences)
+}
+
+// 保存前の繰り返しルールの発生日時を返す
+func (s *Server) PostRecurrencePreview(w http.ResponseWriter, r *http.Request, params api.PostRecurrencePreviewParams) {
+	lg := slog.Default().With("handler", "PostRecurrencePreview")
+
+	// 念のための nil ガード
+	if s.UserRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserRepository", s.UserRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	count, ok := previewCount(params.Count)
+	if !ok {
+		http.Error(w, "invalid count", http.StatusBadRequest)
+		lg.Warn("invalid count", "count", *params.Count)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.RecurrencePreviewReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	loc, err := s.userLocation(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	rule, occurrences, err := recurrence.Preview(reqBody.Rule, nil, reqBody.DueAt, count, loc)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lg.Warn("invalid rrule", "err", err)
+		return
+	}
+
+	writePreview(w, lg, rule.String(), loc, occurrences)
+}
+
+// ユーザーのタイムゾーン（未設定・ユーザーが無い場合はデフォルト）
+func (s *Server) userLocation(ctx context.Context, userID int64) (*time.Location, error) {
+	userData, err := s.UserRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if userData == nil {
+		return s.DefaultLocation, nil
+	}
+	return calendar.Location(userData.TimeZone, s.DefaultLocation), nil
+}
+
+func previewCount(count *int) (int, bool) {
+	if count == nil {
+		return defaultPreviewCount, true
+	}
+	if *count < 1 || *count > recurrence.MaxPreviewCount {
+		return 0, false
+	}
+	return *count, true
+}
+
+func writePreview(w http.ResponseWriter, lg *slog.Logger, rule string, loc *time.Location, occurrences []time.Time) {
+	res := api.RecurrencePreviewRes{
+		Rule:        rule,
+		TimeZone:    loc.String(),
+		Occurrences: occurrences,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode RecurrencePreviewRes", "err", err)
+	}
+}
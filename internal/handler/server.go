@@ -2,39 +2,225 @@ package handler
 
 import (
 	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/yopi416/mind-kanban-backend/configs"
+	"github.com/yopi416/mind-kanban-backend/internal/accesstoken"
+	"github.com/yopi416/mind-kanban-backend/internal/activity"
+	"github.com/yopi416/mind-kanban-backend/internal/analytics"
 	"github.com/yopi416/mind-kanban-backend/internal/auth"
+	"github.com/yopi416/mind-kanban-backend/internal/caldav"
+	"github.com/yopi416/mind-kanban-backend/internal/changefeed"
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
+	"github.com/yopi416/mind-kanban-backend/internal/crdt"
+	"github.com/yopi416/mind-kanban-backend/internal/devoidc"
+	"github.com/yopi416/mind-kanban-backend/internal/events"
+	"github.com/yopi416/mind-kanban-backend/internal/inbound"
+	"github.com/yopi416/mind-kanban-backend/internal/livesync"
+	"github.com/yopi416/mind-kanban-backend/internal/mail"
+	"github.com/yopi416/mind-kanban-backend/internal/metrics"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/progress"
+	"github.com/yopi416/mind-kanban-backend/internal/pubsub"
+	"github.com/yopi416/mind-kanban-backend/internal/recurrence"
+	"github.com/yopi416/mind-kanban-backend/internal/reminder"
 	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/scheduler"
 	"github.com/yopi416/mind-kanban-backend/internal/session"
+	"github.com/yopi416/mind-kanban-backend/internal/webhook"
 )
 
+// CalDAVのマウント先（APIのBaseURLの外。クライアントの自動検出は /.well-known/caldav から転送する）
+const CalDAVPrefix = "/caldav"
+
 // Server は api.ServerInterface を実装する
 type Server struct {
-	OIDC                   *auth.OIDC
-	SessionManager         *session.SessionManager
-	RedirectURLAfterLogin  string
-	RedirectURLAfterLogout string
-	UserRepository         *repository.UserRepository
-	MinkanStatesRepository *repository.MinkanStatesRepository
+	AuthProviders                  *auth.Registry    // ログインに使うIdP（AUTH_PROVIDERS）
+	DevOIDC                        *devoidc.Provider // 開発用のOpenID Provider（configs.DevOIDCPath にマウント。無効の場合はnil）
+	SessionManager                 *session.SessionManager
+	Cookies                        cookies.Policy // セッション・CSRFトークンのCookieの名前と属性
+	RedirectURLAfterLogin          string
+	RedirectURLAfterLogout         string
+	UserRepository                 *repository.UserRepository
+	UserIdentityRepository         IdentityRepository // アカウントに連携したIdPのログイン
+	PublicBaseURL                  string             // APIの公開URL（IdPへのログインURLの組み立てに使う）
+	MinkanStatesRepository         *repository.MinkanStatesRepository
+	EventHub                       pubsub.Hub           // minkan更新通知の配信
+	MinkanStore                    *minkan.Store        // minkan_statesへの書き込み窓口
+	LiveHub                        *livesync.Hub        // ライブ同期(WebSocket)の接続管理
+	AllowedOrigin                  string               // WebSocket接続を許可するオリジン(CORSと共通)
+	CRDTSyncer                     *crdt.Syncer         // オフライン同期(CRDT)のマージ
+	ChangeFeed                     *changefeed.Feed     // 更新差分の記録と取得
+	DefaultLocation                *time.Location       // タイムゾーン未設定ユーザーの日付の解釈に使う
+	Scheduler                      *scheduler.Scheduler // 繰り返しタスク等の発火予定の実行
+	NotificationSettingsRepository *repository.NotificationSettingsRepository
+	MailLinks                      *reminder.Links // メール内リンク（配信停止トークンの検証にも使う）
+	CalendarFeedRepository         FeedRepository
+	AppPasswordRepository          *repository.AppPasswordRepository
+	CalDAV                         *caldav.Handler // /caldav/ 以下（api.ServerInterfaceとは別にマウントする）
+	WebhookRepository              *repository.WebhookRepository
+	WebhookDispatcher              *webhook.Dispatcher // Webhookの配信キューの送信
+	InboundCapturer                *inbound.Capturer   // 秘密URL・メールからのタスク取り込み
+	InboundMailDomain              string              // 取り込み用メールアドレスのドメイン（空の場合はアドレスを返さない）
+	InboundSMTP                    *inbound.SMTPServer // 組み込みSMTPサーバ（未設定の場合はnil）
+	ActivityRecorder               *activity.Recorder  // 操作履歴の記録と取得
+	CardTransitionRepository       *repository.CardTransitionRepository
+	ProgressCache                  *progress.Cache // stateのversionごとのプロジェクトの進捗
+	AccessTokenRepository          *repository.AccessTokenRepository
+	AccessTokenAuth                *accesstoken.Authenticator // Authorization: Bearer の照合（RequireLoginで使う）
+	SessionSweeper                 *session.Sweeper           // 期限切れのセッションの削除（mainで起動）
+	SessionRevalidator             *session.Revalidator       // IdPで無効になったアカウントのセッションの削除（mainで起動）
+	Metrics                        *metrics.Registry          // METRICS_ADDR の GET /metrics（Prometheus形式）
 }
 
 func NewServer(cfg *configs.ConfigList, db *sql.DB) (*Server, error) {
-	oidc, err := auth.NewOIDCFromEnv(cfg)
+	// 開発用のIdPはこのサーバ内のOPで処理する（起動時のdiscoveryもネットワークを通さない）
+	var devOIDC *devoidc.Provider
+	var authTransport http.RoundTripper
+	if pc, ok := cfg.DevAuthProvider(); ok && cfg.IsDevelopment() {
+		var err error
+		devOIDC, err = devoidc.New(devoidc.Config{
+			Issuer:       pc.Issuer,
+			ClientID:     pc.ClientID,
+			ClientSecret: pc.ClientSecret,
+			RedirectURIs: []string{pc.RedirectURL},
+
+			PostLogoutRedirectURIs: []string{cfg.RedirectURLAfterLogout},
+		})
+		if err != nil {
+			return nil, err
+		}
+		authTransport = devOIDC.Transport(nil)
+	}
+
+	authProviders, err := auth.NewRegistry(cfg, authTransport)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultLocation, err := time.LoadLocation(cfg.DefaultTimeZone)
 	if err != nil {
 		return nil, err
 	}
 
-	sm := session.NewSessionManager(cfg.SessionTTL)
+	sessionStore, err := newSessionStore(cfg, db)
+	if err != nil {
+		return nil, err
+	}
+	sm := session.NewSessionManager(sessionStore, cfg.SessionTTL, cfg.SessionMaxLifetime, cfg.CSRFSecret, cfg.SessionTokenKey)
+	metricsRegistry := metrics.NewRegistry()
 	userRepo := repository.NewUserRepository(db)
 	minkanStateRepo := repository.NewMinkanStatesRepository(db)
+	eventHub := pubsub.NewMemoryHub()
+	minkanStore := minkan.NewStore(minkanStateRepo, eventHub)
+	liveHub := livesync.NewHub(minkanStore)
+	crdtSyncer := crdt.NewSyncer(db, minkanStore, cfg.CRDTCompactEvery)
+	changeFeed := changefeed.NewFeed(repository.NewMinkanRevisionsRepository(db), minkanStateRepo, cfg.MinkanRevisionKeep)
+	minkanStore.OnChange(changeFeed.Record)
+
+	mailSender, err := newMailSender(cfg)
+	if err != nil {
+		return nil, err
+	}
+	notificationSettingsRepo := repository.NewNotificationSettingsRepository(db)
+	mailLinks := reminder.NewLinks(cfg.PublicBaseURL, cfg.RedirectURLAfterLogin, cfg.MailLinkSecret)
+	notifier := &reminder.Notifier{
+		StateRepo:       minkanStateRepo,
+		UserRepo:        userRepo,
+		SettingsRepo:    notificationSettingsRepo,
+		Sender:          mailSender,
+		Links:           mailLinks,
+		DefaultLocation: defaultLocation,
+	}
+
+	sched := scheduler.New(repository.NewScheduleRepository(db), minkanStateRepo, cfg.SchedulerInterval)
+	sched.Register(recurrence.Kind, recurrence.NewTask(minkanStore, userRepo, defaultLocation))
+	sched.Register(reminder.KindReminder, &reminder.ReminderTask{Notifier: notifier})
+	sched.Register(reminder.KindOverdue, &reminder.OverdueTask{Notifier: notifier})
+	minkanStore.OnChange(sched.Index)
+
+	appPasswordRepo := repository.NewAppPasswordRepository(db)
+	calDAV := caldav.NewHandler(CalDAVPrefix, minkanStore, minkanStateRepo, userRepo, appPasswordRepo, mailLinks.NodeURL)
+
+	webhookRepo := repository.NewWebhookRepository(db)
+	activityRecorder := activity.NewRecorder(repository.NewActivityRepository(db))
+	cardTransitionRepo := repository.NewCardTransitionRepository(db)
+	// Webhookの配信はstateの更新と同じトランザクションで登録する（コミット後の登録では、失敗・停止時に配信が漏れるため）
+	minkanStore.InTx(webhook.NewEnqueuer(webhookRepo).Record)
+	// 更新前後の比較は1度だけ行い、操作履歴・分析で共有する
+	minkanStore.OnChange(events.Hooks(
+		activityRecorder.Record,
+		analytics.NewRecorder(cardTransitionRepo).Record,
+	))
+	webhookDispatcher := webhook.NewDispatcher(webhookRepo, cfg.WebhookInterval, cfg.WebhookAllowPrivateNetworks)
+
+	inboundCapturer := inbound.NewCapturer(repository.NewInboundTokenRepository(db), minkanStore)
+	var inboundSMTP *inbound.SMTPServer
+	if cfg.InboundSMTPAddr != "" {
+		inboundSMTP = inbound.NewSMTPServer(cfg.InboundSMTPAddr, cfg.InboundMailDomain, inboundCapturer)
+	}
+
+	accessTokenRepo := repository.NewAccessTokenRepository(db)
 
 	return &Server{
-		OIDC:                   oidc,
-		SessionManager:         sm,
-		RedirectURLAfterLogin:  cfg.RedirectURLAfterLogin,
-		RedirectURLAfterLogout: cfg.RedirectURLAfterLogout,
-		UserRepository:         userRepo,
-		MinkanStatesRepository: minkanStateRepo,
+		AuthProviders:                  authProviders,
+		DevOIDC:                        devOIDC,
+		SessionManager:                 sm,
+		Cookies:                        cookies.NewPolicy(cfg),
+		RedirectURLAfterLogin:          cfg.RedirectURLAfterLogin,
+		RedirectURLAfterLogout:         cfg.RedirectURLAfterLogout,
+		UserRepository:                 userRepo,
+		UserIdentityRepository:         repository.NewUserIdentityRepository(db),
+		PublicBaseURL:                  cfg.PublicBaseURL,
+		MinkanStatesRepository:         minkanStateRepo,
+		EventHub:                       eventHub,
+		MinkanStore:                    minkanStore,
+		LiveHub:                        liveHub,
+		AllowedOrigin:                  cfg.CorsAllowOrigins,
+		CRDTSyncer:                     crdtSyncer,
+		ChangeFeed:                     changeFeed,
+		DefaultLocation:                defaultLocation,
+		Scheduler:                      sched,
+		NotificationSettingsRepository: notificationSettingsRepo,
+		MailLinks:                      mailLinks,
+		CalendarFeedRepository:         repository.NewCalendarFeedRepository(db),
+		AppPasswordRepository:          appPasswordRepo,
+		CalDAV:                         calDAV,
+		WebhookRepository:              webhookRepo,
+		WebhookDispatcher:              webhookDispatcher,
+		InboundCapturer:                inboundCapturer,
+		InboundMailDomain:              cfg.InboundMailDomain,
+		InboundSMTP:                    inboundSMTP,
+		ActivityRecorder:               activityRecorder,
+		CardTransitionRepository:       cardTransitionRepo,
+		ProgressCache:                  progress.NewCache(),
+		AccessTokenRepository:          accessTokenRepo,
+		AccessTokenAuth:                accesstoken.NewAuthenticator(accessTokenRepo),
+		SessionSweeper:                 session.NewSweeper(sm, cfg.SessionSweepInterval, metricsRegistry),
+		SessionRevalidator:             session.NewRevalidator(sm, authProviders, cfg.SessionRevalidateInterval, metricsRegistry),
+		Metrics:                        metricsRegistry,
 	}, nil
 }
+
+// SMTP未設定の場合はログ出力のみのSenderを使う
+func newMailSender(cfg *configs.ConfigList) (mail.Sender, error) {
+	if cfg.SMTPHost == "" {
+		return mail.LogSender{}, nil
+	}
+	return mail.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom, cfg.SMTPTLS)
+}
+
+// SESSION_STORE に応じてセッションの保存先を選ぶ
+func newSessionStore(cfg *configs.ConfigList, db *sql.DB) (session.Store, error) {
+	switch cfg.SessionStore {
+	case "memory":
+		return session.NewMemoryStore(), nil
+	case "mysql":
+		return session.NewMySQLStore(db), nil
+	case "redis":
+		return session.NewRedisStore(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB), nil
+	}
+	return nil, fmt.Errorf("unknown session store: %q", cfg.SessionStore)
+}
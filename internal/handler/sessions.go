@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+)
+
+// ログイン中のセッションの一覧を取得
+func (s *Server) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetUsersMeSessions")
+
+	// 念のための nil ガード
+	if s.SessionManager == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasSessionManager", s.SessionManager != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// セッションの管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("session management with access token", "userID", userID)
+		return
+	}
+
+	sessions, err := s.SessionManager.ListSessions(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list sessions error", "err", err)
+		return
+	}
+
+	current := s.currentSessionKey(r)
+	res := make([]api.UserSession, 0, len(sessions))
+	for _, sess := range sessions {
+		res = append(res, api.UserSession{
+			Id:         sess.IDHash,
+			Current:    sess.IDHash == current,
+			Ip:         sess.IP,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt,
+			LastSeenAt: sess.LastSeenAt,
+			ExpiresAt:  sess.ExpiresAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode UserSession", "err", err)
+	}
+}
+
+// 現在のセッション以外を全てログアウト
+func (s *Server) DeleteUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "DeleteUsersMeSessions")
+
+	// 念のための nil ガード
+	if s.SessionManager == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasSessionManager", s.SessionManager != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// セッションの管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("session management with access token", "userID", userID)
+		return
+	}
+
+	// 現在のセッションもIDを再発行し、新しいID以外を全て削除する
+	// （現在のIDが漏れていた場合も、以降は使えなくなる）
+	sessID := s.rotateSession(w, r)
+	if sessID == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("no session cookie")
+		return
+	}
+
+	if err := s.SessionManager.RevokeOtherSessions(r.Context(), userID, sessID); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("revoke other sessions error", "err", err)
+		return
+	}
+
+	// 成功だが返すデータなし(204レスポンス)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// セッションを指定してログアウト
+func (s *Server) DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request, sessionId string) {
+	lg := slog.Default().With("handler", "DeleteUsersMeSessionsSessionId")
+
+	// 念のための nil ガード
+	if s.SessionManager == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasSessionManager", s.SessionManager != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// セッションの管理はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("session management with access token", "userID", userID)
+		return
+	}
+
+	// 他のユーザーのセッションは該当なしとして扱う
+	revoked, err := s.SessionManager.RevokeSession(r.Context(), userID, sessionId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("revoke session error", "err", err)
+		return
+	}
+
+	if !revoked {
+		http.Error(w, "session not found", http.StatusNotFound)
+		return
+	}
+
+	// 成功だが返すデータなし(204レスポンス)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// セッションIDを再発行し、Cookieを差し替える（セッション固定・流出したIDの悪用対策）
+// 認証情報の発行等の重要な操作の後、レスポンスのWriteHeaderより前に呼ぶ
+// 新しいセッションIDを返す。Cookieが無い・アクセストークンでの利用の場合は空、
+// 再発行に失敗した場合は元のセッションIDを返す（操作自体は成功しているため中断しない）
+func (s *Server) rotateSession(w http.ResponseWriter, r *http.Request) string {
+	lg := slog.Default().With("handler", "rotateSession")
+
+	sessID := s.Cookies.SessionID(r)
+	if sessID == "" || s.SessionManager == nil || middleware.IsAccessTokenRequest(r.Context()) {
+		return ""
+	}
+
+	newID, sess, err := s.SessionManager.RotateSession(r.Context(), sessID)
+	if err != nil {
+		lg.Error("rotate session failed", "err", err)
+		return sessID
+	}
+	if sess == nil {
+		return sessID
+	}
+
+	s.Cookies.SetSession(w, newID, s.SessionManager.CSRFToken(newID), time.Until(sess.ExpiresAt))
+	return newID
+}
+
+// リクエストのセッションの識別子（Cookieが無い場合は空）
+func (s *Server) currentSessionKey(r *http.Request) string {
+	sessID := s.Cookies.SessionID(r)
+	if sessID == "" {
+		return ""
+	}
+	return session.HashID(sessID)
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/yopi416/mind-kanban-backend/internal/crdt"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+// オフライン端末の操作ログをマージし、端末が未受信の操作を返す
+func (s *Server) PostMinkanSync(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostMinkanSync")
+
+	// 念のための nil ガード
+	if s.CRDTSyncer == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency", "hasCRDTSyncer", s.CRDTSyncer != nil)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	// api.MinkanSyncReqと同じ形式だが、操作はCRDTの型で直接受け取る
+	var reqBody crdt.SyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	res, err := s.CRDTSyncer.Sync(r.Context(), userID, &reqBody)
+
+	if errors.Is(err, crdt.ErrInvalidOp) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lg.Warn("invalid sync request", "err", err)
+		return
+	}
+
+	if errors.Is(err, minkan.ErrStateNotFound) {
+		http.Error(w, "minkan not found", http.StatusNotFound)
+		lg.Warn("minkan_state not found", "userID", userID)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("sync error", "err", err)
+		return
+	}
+
+	lg.Info("sync ok",
+		"replicaID", reqBody.ReplicaID,
+		"received", len(reqBody.Ops),
+		"rejected", len(res.Rejected),
+		"sent", len(res.Ops),
+		"reset", res.Reset,
+	)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode sync result", "err", err)
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/activity"
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
 	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
 )
 
 func (s *Server) GetUsersMe(w http.ResponseWriter, r *http.Request) {
@@ -47,12 +51,82 @@ func (s *Server) GetUsersMe(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// openapi.ymlで指定したスキーマを基にレスポンスするJSONを作成
-	response := api.User{
-		DisplayName: &userData.DisplayName,
-		Email:       &userData.Email,
-		// UserId:
+	response := s.userResponse(userData)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		lg.Error("failed to encode userData", "err", err)
+	}
+}
+
+// ユーザー設定（タイムゾーン）を更新
+func (s *Server) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PatchUsersMe")
+
+	// 念のための nil ガード
+	if s.UserRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasUserRepository", s.UserRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.UserPatchReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	// IANAタイムゾーン名のみ受け付ける（"Local"はサーバ依存のため不可）
+	if reqBody.TimeZone != "" {
+		if _, err := time.LoadLocation(reqBody.TimeZone); err != nil || reqBody.TimeZone == "Local" {
+			http.Error(w, "unknown time zone", http.StatusBadRequest)
+			lg.Warn("unknown time zone", "timeZone", reqBody.TimeZone)
+			return
+		}
 	}
 
+	if err := s.UserRepository.UpdateTimeZone(r.Context(), userID, reqBody.TimeZone); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to update time zone", "err", err)
+		return
+	}
+
+	userData, err := s.UserRepository.FindUserByUserID(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find user error", "err", err)
+		return
+	}
+
+	if userData == nil {
+		http.Error(w, "user not found", http.StatusNotFound)
+		lg.Warn("userData not found", "userID", userID)
+		return
+	}
+
+	response := s.userResponse(userData)
+
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 
@@ -61,6 +135,17 @@ func (s *Server) GetUsersMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ユーザー情報をレスポンス用の構造体に変換（タイムゾーン未設定時はデフォルトを返す）
+func (s *Server) userResponse(userData *repository.User) api.User {
+	return api.User{
+		DisplayName:       &userData.DisplayName,
+		Email:             &userData.Email,
+		TimeZone:          calendar.Location(userData.TimeZone, s.DefaultLocation).String(),
+		TimeZoneIsDefault: userData.TimeZone == "",
+		// UserId:
+	}
+}
+
 func (s *Server) DeleteUsersMe(w http.ResponseWriter, r *http.Request) {
 	lg := slog.Default().With("handler", "DeleteUsersMe")
 
@@ -82,6 +167,18 @@ func (s *Server) DeleteUsersMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 退会はブラウザのログインでのみ許可する
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("account deletion with access token", "userID", userID)
+		return
+	}
+
+	// 退会申請を操作履歴に記録（ユーザー削除後も残す）
+	if s.ActivityRecorder != nil {
+		s.ActivityRecorder.Log(r.Context(), userID, activity.TypeAccountDeletionRequested, r)
+	}
+
 	// userIDに該当するuserデータをDBから削除
 	err := s.UserRepository.DeleteUser(r.Context(), userID)
 
@@ -92,6 +189,23 @@ func (s *Server) DeleteUsersMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 全ての端末のセッションを削除（MySQL以外の保存先はusersの削除に連動しない）
+	if s.SessionManager != nil {
+		if err := s.SessionManager.DeleteUserSessions(r.Context(), userID); err != nil {
+			lg.Error("failed to delete sessions", "err", err)
+		}
+	}
+
+	// 退会申請以外の操作履歴を削除（activity_eventsはusersの削除に連動しない）
+	if s.ActivityRecorder != nil {
+		if err := s.ActivityRecorder.Repo.DeleteActivityEventsExcept(r.Context(), userID, activity.TypeAccountDeletionRequested); err != nil {
+			lg.Error("failed to delete activity events", "err", err)
+		}
+	}
+
+	// このブラウザのセッションIDとCSRFトークンのCookieを失効させる
+	s.Cookies.ClearSession(w)
+
 	// 成功だが返すデータなし(204レスポンス)
 	w.WriteHeader(http.StatusNoContent)
 }
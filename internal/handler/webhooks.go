@@ -0,0 +1,554 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/api"
+	"github.com/yopi416/mind-kanban-backend/internal/events"
+	"github.com/yopi416/mind-kanban-backend/internal/middleware"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+	"github.com/yopi416/mind-kanban-backend/internal/webhook"
+)
+
+const (
+	// 生成するsecretの接頭辞
+	webhookSecretPrefix = "whsec"
+
+	// ユーザーごとのWebhookの上限
+	maxWebhooks = 10
+
+	// 送信先URLの最大長
+	maxWebhookURLLen = 2048
+
+	// secretの長さ（ユーザー指定の場合）
+	minWebhookSecretLen = 16
+	maxWebhookSecretLen = 255
+
+	// 配信記録の取得件数
+	defaultDeliveriesLimit = 20
+	maxDeliveriesLimit     = 100
+)
+
+// Webhookの一覧を取得
+func (s *Server) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "GetWebhooks")
+
+	// 念のための nil ガード
+	if s.WebhookRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasWebhookRepository", s.WebhookRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	hooks, err := s.WebhookRepository.ListWebhooks(r.Context(), userID, false)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list webhooks error", "err", err)
+		return
+	}
+
+	res := make([]api.Webhook, 0, len(hooks))
+	for i := range hooks {
+		res = append(res, toAPIWebhook(&hooks[i]))
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode Webhook", "err", err)
+	}
+}
+
+// Webhookを登録
+func (s *Server) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	lg := slog.Default().With("handler", "PostWebhooks")
+
+	// 念のための nil ガード
+	if s.WebhookRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasWebhookRepository", s.WebhookRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// Webhookの登録はブラウザのログインでのみ許可する（漏れたトークンから外部へデータを送らせないように）
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("webhook creation with access token", "userID", userID)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.WebhookReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	hook, msg := webhookFromReq(&reqBody)
+	if msg != "" {
+		http.Error(w, msg, http.StatusBadRequest)
+		lg.Warn("invalid webhook request", "reason", msg)
+		return
+	}
+	hook.UserID = userID
+
+	// secret未指定の場合はサーバで生成する
+	if hook.Secret == "" {
+		secret, err := token.New(webhookSecretPrefix)
+		if err != nil {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			lg.Error("failed to generate webhook secret", "err", err)
+			return
+		}
+		hook.Secret = secret
+	}
+
+	id, err := s.WebhookRepository.CreateWebhook(r.Context(), hook, maxWebhooks)
+
+	if errors.Is(err, repository.ErrLimitExceeded) {
+		http.Error(w, "too many webhooks", http.StatusConflict)
+		lg.Warn("webhook limit exceeded", "userID", userID)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to create webhook", "err", err)
+		return
+	}
+
+	created, err := s.WebhookRepository.FindWebhook(r.Context(), userID, id)
+
+	if err != nil || created == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find webhook error", "err", err)
+		return
+	}
+
+	lg.Info("webhook created", "userID", userID, "webhookID", id)
+
+	res := api.WebhookCreated{
+		Id:        created.ID,
+		Url:       created.URL,
+		Events:    toAPIEventTypes(created.EventTypes),
+		Active:    created.Active,
+		CreatedAt: created.CreatedAt,
+		UpdatedAt: created.UpdatedAt,
+		Secret:    created.Secret,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode WebhookCreated", "err", err)
+	}
+}
+
+// Webhookを更新
+func (s *Server) PutWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId int64) {
+	lg := slog.Default().With("handler", "PutWebhooksWebhookId")
+
+	// 念のための nil ガード
+	if s.WebhookRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasWebhookRepository", s.WebhookRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	// Webhookの変更はブラウザのログインでのみ許可する（漏れたトークンから送信先を差し替えられないように）
+	if middleware.IsAccessTokenRequest(r.Context()) {
+		http.Error(w, "not allowed with access token", http.StatusForbidden)
+		lg.Warn("webhook update with access token", "userID", userID)
+		return
+	}
+
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			lg.Error("failed to close request body", "err", err)
+		}
+	}()
+
+	var reqBody api.WebhookReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		lg.Warn("decode error", "err", err)
+		return
+	}
+
+	hook, msg := webhookFromReq(&reqBody)
+	if msg != "" {
+		http.Error(w, msg, http.StatusBadRequest)
+		lg.Warn("invalid webhook request", "reason", msg)
+		return
+	}
+	hook.ID = webhookId
+	hook.UserID = userID
+
+	found, err := s.WebhookRepository.UpdateWebhook(r.Context(), hook)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to update webhook", "err", err)
+		return
+	}
+
+	if !found {
+		http.Error(w, "webhook not found", http.StatusNotFound)
+		lg.Warn("webhook not found", "webhookID", webhookId)
+		return
+	}
+
+	updated, err := s.WebhookRepository.FindWebhook(r.Context(), userID, webhookId)
+
+	if err != nil || updated == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find webhook error", "err", err)
+		return
+	}
+
+	lg.Info("webhook updated", "userID", userID, "webhookID", webhookId)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(toAPIWebhook(updated)); err != nil {
+		lg.Error("failed to encode Webhook", "err", err)
+	}
+}
+
+// Webhookを削除
+func (s *Server) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId int64) {
+	lg := slog.Default().With("handler", "DeleteWebhooksWebhookId")
+
+	// 念のための nil ガード
+	if s.WebhookRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasWebhookRepository", s.WebhookRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	deleted, err := s.WebhookRepository.DeleteWebhook(r.Context(), userID, webhookId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to delete webhook", "err", err)
+		return
+	}
+
+	if !deleted {
+		http.Error(w, "webhook not found", http.StatusNotFound)
+		lg.Warn("webhook not found", "webhookID", webhookId)
+		return
+	}
+
+	lg.Info("webhook deleted", "userID", userID, "webhookID", webhookId)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Webhookの配信記録を取得
+func (s *Server) GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId int64, params api.GetWebhooksWebhookIdDeliveriesParams) {
+	lg := slog.Default().With("handler", "GetWebhooksWebhookIdDeliveries")
+
+	// 念のための nil ガード
+	if s.WebhookRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasWebhookRepository", s.WebhookRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	limit := defaultDeliveriesLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxDeliveriesLimit {
+		http.Error(w, "invalid limit", http.StatusBadRequest)
+		lg.Warn("invalid limit", "limit", limit)
+		return
+	}
+
+	var before int64
+	if params.Before != nil {
+		before = *params.Before
+	}
+	if before < 0 {
+		http.Error(w, "invalid before", http.StatusBadRequest)
+		lg.Warn("invalid before", "before", before)
+		return
+	}
+
+	hook, err := s.WebhookRepository.FindWebhook(r.Context(), userID, webhookId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find webhook error", "err", err)
+		return
+	}
+
+	if hook == nil {
+		http.Error(w, "webhook not found", http.StatusNotFound)
+		lg.Warn("webhook not found", "webhookID", webhookId)
+		return
+	}
+
+	// 続きの有無を判定するため1件多く取得する
+	deliveries, err := s.WebhookRepository.ListDeliveries(r.Context(), userID, webhookId, before, limit+1)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("list webhook deliveries error", "err", err)
+		return
+	}
+
+	res := api.WebhookDeliveriesRes{
+		Deliveries: make([]api.WebhookDelivery, 0, len(deliveries)),
+	}
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+		next := deliveries[limit-1].ID
+		res.NextBefore = &next
+	}
+	for i := range deliveries {
+		res.Deliveries = append(res.Deliveries, toAPIWebhookDelivery(&deliveries[i]))
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		lg.Error("failed to encode WebhookDeliveriesRes", "err", err)
+	}
+}
+
+// 疎通確認のイベントを配信キューに登録
+func (s *Server) PostWebhooksWebhookIdPing(w http.ResponseWriter, r *http.Request, webhookId int64) {
+	lg := slog.Default().With("handler", "PostWebhooksWebhookIdPing")
+
+	// 念のための nil ガード
+	if s.WebhookRepository == nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("missing dependency",
+			"hasWebhookRepository", s.WebhookRepository != nil,
+		)
+		return
+	}
+
+	// ContextからUserIDを取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		lg.Warn("userID not found in context")
+		return
+	}
+
+	hook, err := s.WebhookRepository.FindWebhook(r.Context(), userID, webhookId)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("find webhook error", "err", err)
+		return
+	}
+
+	if hook == nil {
+		http.Error(w, "webhook not found", http.StatusNotFound)
+		lg.Warn("webhook not found", "webhookID", webhookId)
+		return
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	eventID := webhook.NewEventID()
+	payload, err := json.Marshal(&webhook.Payload{
+		ID:        eventID,
+		Type:      webhook.TypePing,
+		CreatedAt: now,
+		Data:      map[string]int64{"webhookId": hook.ID},
+	})
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to marshal ping payload", "err", err)
+		return
+	}
+
+	d := &repository.WebhookDelivery{
+		WebhookID:     hook.ID,
+		UserID:        userID,
+		EventID:       eventID,
+		EventType:     webhook.TypePing,
+		Payload:       payload,
+		Status:        repository.DeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	d.ID, err = s.WebhookRepository.InsertDelivery(r.Context(), d)
+
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		lg.Error("failed to insert ping delivery", "err", err)
+		return
+	}
+
+	lg.Info("webhook ping queued", "userID", userID, "webhookID", hook.ID, "deliveryID", d.ID)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusAccepted)
+
+	if err := json.NewEncoder(w).Encode(toAPIWebhookDelivery(d)); err != nil {
+		lg.Error("failed to encode WebhookDelivery", "err", err)
+	}
+}
+
+// リクエストを検証してWebhookに変換する（不正な場合は理由を返す）
+func webhookFromReq(req *api.WebhookReq) (*repository.Webhook, string) {
+	if len(req.Url) > maxWebhookURLLen {
+		return nil, "url is too long"
+	}
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, "invalid url"
+	}
+
+	if len(req.Events) == 0 {
+		return nil, "events is required"
+	}
+	eventTypes := []string{}
+	for _, t := range req.Events {
+		if !events.IsType(string(t)) {
+			return nil, "invalid event type: " + string(t)
+		}
+		if !slices.Contains(eventTypes, string(t)) {
+			eventTypes = append(eventTypes, string(t))
+		}
+	}
+
+	hook := &repository.Webhook{
+		URL:        u.String(),
+		EventTypes: eventTypes,
+		Active:     true,
+	}
+	if req.Active != nil {
+		hook.Active = *req.Active
+	}
+	if req.Secret != nil {
+		if len(*req.Secret) < minWebhookSecretLen || len(*req.Secret) > maxWebhookSecretLen {
+			return nil, "invalid secret length"
+		}
+		hook.Secret = *req.Secret
+	}
+	return hook, ""
+}
+
+func toAPIEventTypes(types []string) []api.WebhookEventType {
+	res := make([]api.WebhookEventType, 0, len(types))
+	for _, t := range types {
+		res = append(res, api.WebhookEventType(t))
+	}
+	return res
+}
+
+func toAPIWebhook(hook *repository.Webhook) api.Webhook {
+	return api.Webhook{
+		Id:        hook.ID,
+		Url:       hook.URL,
+		Events:    toAPIEventTypes(hook.EventTypes),
+		Active:    hook.Active,
+		CreatedAt: hook.CreatedAt,
+		UpdatedAt: hook.UpdatedAt,
+	}
+}
+
+func toAPIWebhookDelivery(d *repository.WebhookDelivery) api.WebhookDelivery {
+	res := api.WebhookDelivery{
+		Id:             d.ID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Status:         api.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		CreatedAt:      d.CreatedAt,
+		FinishedAt:     d.FinishedAt,
+		Payload:        d.Payload,
+	}
+	if d.Status == repository.DeliveryPending {
+		next := d.NextAttemptAt
+		res.NextAttemptAt = &next
+	}
+	if d.LastError != "" {
+		lastError := d.LastError
+		res.LastError = &lastError
+	}
+	return res
+}
@@ -0,0 +1,157 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrInvalidCalendar = errors.New("invalid iCalendar data")
+
+// 1つのコンポーネントに含められる最大行数（巨大な入力の防止）
+const maxLines = 10000
+
+// Decode はiCalendar(RFC 5545)のテキストを読み込み、最上位のコンポーネントを返す
+// 折り返しの解除とプロパティの分割のみを行い、値のエスケープは解除しない（TEXT型はUnescapeTextで解除する）
+func Decode(r io.Reader) (*Component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var root *Component
+	stack := []*Component{}
+
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		p, err := parseLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch p.Name {
+		case "BEGIN":
+			if root != nil && len(stack) == 0 {
+				return nil, fmt.Errorf("%w: multiple top-level components", ErrInvalidCalendar)
+			}
+			c := NewComponent(strings.ToUpper(p.Value))
+			if len(stack) > 0 {
+				stack[len(stack)-1].AddComponent(c)
+			} else {
+				root = c
+			}
+			stack = append(stack, c)
+
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(p.Value) {
+				return nil, fmt.Errorf("%w: unexpected END:%s", ErrInvalidCalendar, p.Value)
+			}
+			stack = stack[:len(stack)-1]
+
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("%w: property %s outside of component", ErrInvalidCalendar, p.Name)
+			}
+			c := stack[len(stack)-1]
+			c.Props = append(c.Props, p)
+		}
+	}
+
+	if root == nil || len(stack) > 0 {
+		return nil, fmt.Errorf("%w: incomplete component", ErrInvalidCalendar)
+	}
+	return root, nil
+}
+
+// 継続行（空白・タブで始まる行）を前の行に連結する
+func unfold(r io.Reader) ([]string, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 4096), 1<<20)
+
+	lines := []string{}
+	for sc.Scan() {
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if len(lines) >= maxLines {
+			return nil, fmt.Errorf("%w: too many lines", ErrInvalidCalendar)
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// "NAME;PARAM=VALUE:値" を分割する（パラメータ値の引用符内の ; : は区切りとして扱わない）
+func parseLine(line string) (Prop, error) {
+	inQuote := false
+	start := 0
+	var parts []string
+
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			inQuote = !inQuote
+		case ';':
+			if !inQuote {
+				parts = append(parts, line[start:i])
+				start = i + 1
+			}
+		case ':':
+			if !inQuote {
+				parts = append(parts, line[start:i])
+				if parts[0] == "" {
+					return Prop{}, fmt.Errorf("%w: empty property name", ErrInvalidCalendar)
+				}
+				return Prop{
+					Name:   strings.ToUpper(parts[0]),
+					Params: parts[1:],
+					Value:  line[i+1:],
+				}, nil
+			}
+		}
+	}
+	return Prop{}, fmt.Errorf("%w: malformed content line", ErrInvalidCalendar)
+}
+
+// 名前が一致する最初のプロパティを返す（無い場合はnil）
+func (c *Component) Prop(name string) *Prop {
+	for i := range c.Props {
+		if c.Props[i].Name == name {
+			return &c.Props[i]
+		}
+	}
+	return nil
+}
+
+// 名前が一致する子コンポーネントを返す
+func (c *Component) Children(name string) []*Component {
+	children := []*Component{}
+	for _, child := range c.Components {
+		if child.Name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, `;`,
+	`\,`, `,`,
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+// TEXT型の値のエスケープを解除する
+func UnescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
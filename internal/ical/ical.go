@@ -0,0 +1,106 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 1行の最大オクテット数（改行を除く。超える場合は折り返す）
+const maxLineOctets = 75
+
+// Component はVCALENDAR・VEVENT・VTODO等のコンポーネント（RFC 5545）
+type Component struct {
+	Name       string
+	Props      []Prop
+	Components []*Component
+}
+
+// Prop はコンポーネントのプロパティ1行
+type Prop struct {
+	Name   string
+	Params []string // "VALUE=DURATION" 形式のパラメータ
+	Value  string   // エスケープ済みの値
+}
+
+func NewComponent(name string) *Component {
+	return &Component{Name: name}
+}
+
+// 値をそのまま追加する（エスケープ済み、またはTEXT以外の値）
+func (c *Component) Add(name, value string, params ...string) {
+	c.Props = append(c.Props, Prop{Name: name, Params: params, Value: value})
+}
+
+// TEXT型の値をエスケープして追加する
+func (c *Component) AddText(name, text string) {
+	c.Add(name, EscapeText(text))
+}
+
+// 日時をUTCのDATE-TIME型で追加する
+func (c *Component) AddTime(name string, t time.Time) {
+	c.Add(name, FormatTime(t))
+}
+
+// 子コンポーネントを追加する
+func (c *Component) AddComponent(child *Component) {
+	c.Components = append(c.Components, child)
+}
+
+// RFC 5545形式（CRLF改行・75オクテットで折り返し）で書き出す
+func (c *Component) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	c.encode(bw)
+	return bw.Flush()
+}
+
+func (c *Component) encode(w *bufio.Writer) {
+	writeLine(w, "BEGIN:"+c.Name)
+	for _, p := range c.Props {
+		line := p.Name
+		for _, param := range p.Params {
+			line += ";" + param
+		}
+		writeLine(w, line+":"+p.Value)
+	}
+	for _, child := range c.Components {
+		child.encode(w)
+	}
+	writeLine(w, "END:"+c.Name)
+}
+
+// 長い行はUTF-8の文字の途中で切らないように折り返す（継続行は空白1文字で始まる）
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // 継続行の先頭の空白の分
+	}
+	_, _ = w.WriteString(line + "\r\n")
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// TEXT型の値のエスケープ
+func EscapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// UTCのDATE-TIME型（例 20250102T030405Z）
+func FormatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
@@ -0,0 +1,288 @@
+package inbound
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// 取り込み用トークンの接頭辞
+	TokenPrefix = "mkin"
+
+	// 取り込み先ノードのラベル（プロジェクトのルート直下に作る）
+	InboxLabel = "Inbox"
+
+	// タイトル・本文の最大文字数（超えた分は切り詰める）
+	MaxTitleLen = 200
+	MaxBodyLen  = 10000
+
+	// ノードの配置（親ノードからの相対位置）
+	childOffsetX = 250
+	childOffsetY = 80
+)
+
+var (
+	// ErrNoProject は取り込み先のプロジェクトが1つも無い場合のエラー
+	ErrNoProject = errors.New("inbound: no project to capture into")
+
+	// ErrEmptyItem はタイトル・本文がどちらも空の場合のエラー
+	ErrEmptyItem = errors.New("inbound: empty item")
+
+	// Keyが同じタスクを取り込み済みの場合（Mutateで書き込まずに抜けるために使う）
+	errAlreadyCaptured = errors.New("inbound: already captured")
+)
+
+// Item は取り込むタスク
+type Item struct {
+	Title string
+	Body  string // ノードのコメントとして追加する（空の場合は追加しない）
+
+	// 重複取り込み防止のキー（メールのMessage-ID等）。空でない場合、同じキーのタスクは1度だけ取り込む
+	// 送信元の再送で同じタスクが増えないよう、ノードIDをキーから決める
+	Key string
+}
+
+// Result は取り込み結果
+type Result struct {
+	PjID    string
+	NodeID  string
+	Version int32 // 取り込み後のstateのversion（Duplicateの場合は0）
+
+	// Keyが同じタスクを取り込み済みだった場合はtrue（PjID・NodeIDは取り込み済みのノード）
+	Duplicate bool
+}
+
+// TokenRepository はinbound_tokensの読み書き（*repository.InboundTokenRepository）
+type TokenRepository interface {
+	FindInboundTokenByUserID(ctx context.Context, userID int64) (*repository.InboundToken, error)
+	FindInboundTokenByHash(ctx context.Context, tokenHash []byte) (*repository.InboundToken, error)
+	UpsertInboundToken(ctx context.Context, userID int64, tokenHash []byte, pjID *string) error
+	UpdateInboundPjID(ctx context.Context, userID int64, pjID *string) (bool, error)
+	DeleteInboundToken(ctx context.Context, userID int64) error
+	TouchInboundToken(ctx context.Context, userID int64, at time.Time) error
+}
+
+// Capturer は秘密URL・メールで受け取ったタスクを、プロジェクトの "Inbox" ノードの子として追加する
+// stateの更新はminkan.Store.Mutateで行うため、同時更新はversionの楽観ロックで検出して再適用される
+type Capturer struct {
+	Tokens TokenRepository
+	Store  *minkan.Store
+}
+
+func NewCapturer(tokens TokenRepository, store *minkan.Store) *Capturer {
+	return &Capturer{Tokens: tokens, Store: store}
+}
+
+// NewToken は取り込み用トークンを発行する
+// メールアドレスのローカル部として使うため、大文字小文字を区別しない経路でも照合できるよう小文字で発行する
+func NewToken() (string, error) {
+	tok, err := token.New(TokenPrefix)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(tok), nil
+}
+
+// トークンを照合する（不正・無効化済みの場合はnil, nil）
+func (c *Capturer) Lookup(ctx context.Context, tok string) (*repository.InboundToken, error) {
+	if !strings.HasPrefix(tok, TokenPrefix+"_") {
+		return nil, nil
+	}
+	return c.Tokens.FindInboundTokenByHash(ctx, token.Hash(tok))
+}
+
+// タスクを取り込む
+// - 取り込み先は、トークンに設定したプロジェクト → 作業中のプロジェクト → 最も古いプロジェクトの順
+// - ルート直下に "Inbox" ノードが無い場合は作る
+// - 追加したノードはカンバンのbacklogに入れる
+// - item.Keyのタスクを取り込み済みの場合は何もせず、取り込み済みのノードをDuplicateとして返す
+func (c *Capturer) Capture(ctx context.Context, t *repository.InboundToken, item Item, origin string) (*Result, error) {
+	item, err := Normalize(item)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{}
+	version, err := c.Store.Mutate(ctx, t.UserID, origin, func(m *repository.Minkan) error {
+		pjID, nodeID, err := addToInbox(m, t.PjID, item, time.Now().UTC())
+		res.PjID, res.NodeID = pjID, nodeID
+		return err
+	})
+	if errors.Is(err, errAlreadyCaptured) {
+		res.Duplicate = true
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Version = version
+
+	if err := c.Tokens.TouchInboundToken(ctx, t.UserID, time.Now()); err != nil {
+		slog.Default().With("module", "inbound", "userID", t.UserID).Warn("failed to update inbound token last used time", "err", err)
+	}
+	return res, nil
+}
+
+// Normalize はタイトル・本文を整形する
+// タイトルが空の場合は本文の1行目をタイトルにする
+func Normalize(item Item) (Item, error) {
+	title := strings.Join(strings.Fields(item.Title), " ")
+	body := strings.TrimSpace(strings.ReplaceAll(item.Body, "\r\n", "\n"))
+
+	if title == "" {
+		first, _, _ := strings.Cut(body, "\n")
+		title = strings.Join(strings.Fields(first), " ")
+	}
+	if title == "" {
+		return Item{}, ErrEmptyItem
+	}
+
+	return Item{Title: truncate(title, MaxTitleLen), Body: truncate(body, MaxBodyLen), Key: strings.TrimSpace(item.Key)}, nil
+}
+
+// 文字数(rune)で切り詰める
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// item.Keyのタスクを取り込み済みの場合は、取り込み済みのノードとerrAlreadyCapturedを返す
+func addToInbox(m *repository.Minkan, pjID *string, item Item, now time.Time) (string, string, error) {
+	nodeID := ""
+	if item.Key != "" {
+		nodeID = keyNodeID(item.Key)
+		for id := range m.Projects {
+			pj := m.Projects[id]
+			if minkan.FindNode(&pj, nodeID) >= 0 {
+				return id, nodeID, errAlreadyCaptured
+			}
+		}
+	}
+
+	pj, ok := targetProject(m, pjID)
+	if !ok {
+		return "", "", ErrNoProject
+	}
+
+	inboxIdx := findInbox(pj)
+	if inboxIdx < 0 {
+		rootIdx := minkan.FindNode(pj, minkan.RootNodeID)
+		if rootIdx < 0 {
+			return "", "", ErrNoProject
+		}
+		inboxID, err := gonanoid.New()
+		if err != nil {
+			return "", "", err
+		}
+		pj.Nodes = append(pj.Nodes, newChild(pj, rootIdx, inboxID, InboxLabel))
+		pj.Edges = append(pj.Edges, minkan.NewEdge(minkan.RootNodeID, inboxID))
+		inboxIdx = len(pj.Nodes) - 1
+	}
+	inboxID := pj.Nodes[inboxIdx].Id
+
+	if nodeID == "" {
+		id, err := gonanoid.New()
+		if err != nil {
+			return "", "", err
+		}
+		nodeID = id
+	}
+	node := newChild(pj, inboxIdx, nodeID, item.Title)
+	if item.Body != "" {
+		commentID, err := gonanoid.New()
+		if err != nil {
+			return "", "", err
+		}
+		node.Data.Comments = append(node.Data.Comments, repository.NodeComment{
+			Id:        commentID,
+			Content:   item.Body,
+			CreatedAt: now,
+		})
+	}
+
+	pj.Nodes = append(pj.Nodes, node)
+	pj.Edges = append(pj.Edges, minkan.NewEdge(inboxID, nodeID))
+	pj.UpdatedAt = now
+	m.Projects[pj.Id] = *pj
+
+	minkan.MoveCard(m, pj.Id, nodeID, minkan.ColumnBacklog, nil)
+	return pj.Id, nodeID, nil
+}
+
+// 重複取り込み防止のキーから決めるノードID（nanoidと同じ21文字のURLセーフな文字列）
+func keyNodeID(key string) string {
+	sum := sha256.Sum256([]byte("inbound:" + key))
+	return base64.RawURLEncoding.EncodeToString(sum[:])[:21]
+}
+
+// 取り込み先のプロジェクト（コピーを返すため、変更後はm.Projectsへ書き戻すこと）
+func targetProject(m *repository.Minkan, pjID *string) (*repository.Project, bool) {
+	for _, id := range []*string{pjID, &m.CurrentPjId} {
+		if id == nil {
+			continue
+		}
+		if pj, ok := m.Projects[*id]; ok {
+			return &pj, true
+		}
+	}
+
+	if len(m.Projects) == 0 {
+		return nil, false
+	}
+	ids := make([]string, 0, len(m.Projects))
+	for id := range m.Projects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := m.Projects[ids[i]], m.Projects[ids[j]]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.Id < b.Id
+	})
+	pj := m.Projects[ids[0]]
+	return &pj, true
+}
+
+// ルート直下の "Inbox" ノードの位置（無い場合は-1）
+func findInbox(pj *repository.Project) int {
+	for i := range pj.Nodes {
+		d := &pj.Nodes[i].Data
+		if d.ParentId != nil && *d.ParentId == minkan.RootNodeID && d.Label == InboxLabel {
+			return i
+		}
+	}
+	return -1
+}
+
+// 親ノードの右側、既存の子ノードの下に並べる
+func newChild(pj *repository.Project, parentIdx int, nodeID, label string) repository.Node {
+	parent := &pj.Nodes[parentIdx]
+
+	children := 0
+	for i := range pj.Nodes {
+		if p := pj.Nodes[i].Data.ParentId; p != nil && *p == parent.Id {
+			children++
+		}
+	}
+
+	node := minkan.NewNode(nodeID, parent.Id, label)
+	node.Position.X = parent.Position.X + childOffsetX
+	node.Position.Y = parent.Position.Y + float32(children*childOffsetY)
+	return node
+}
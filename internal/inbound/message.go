@@ -0,0 +1,147 @@
+package inbound
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// 入れ子のマルチパートをたどる最大の深さ
+const maxPartDepth = 5
+
+// ParseMessage はメール(RFC 5322)から取り込むタスクを取り出す
+// 件名をタイトル、最初のtext/plainパートを本文とする（署名 "-- " 以降は除く）
+// Message-IDは重複取り込み防止のキーとする
+func ParseMessage(r io.Reader) (Item, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return Item{}, err
+	}
+
+	dec := &mime.WordDecoder{CharsetReader: charsetReader}
+	subject, err := dec.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+
+	body, err := textBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body, 0)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return Item{Title: subject, Body: stripSignature(body), Key: msg.Header.Get("Message-Id")}, nil
+}
+
+// text/plainの本文を返す（無い場合は空文字）
+func textBody(contentType, encoding string, r io.Reader, depth int) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// Content-Type無し・不正はtext/plain(US-ASCII)として扱う
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if depth >= maxPartDepth || params["boundary"] == "" {
+			return "", nil
+		}
+		mr := multipart.NewReader(r, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+			// quoted-printableはmultipart.Readerが復号済み（Content-Transfer-Encodingは削除される）
+			body, err := textBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part, depth+1)
+			if err != nil {
+				return "", err
+			}
+			if body != "" {
+				return body, nil
+			}
+		}
+
+	case mediaType == "text/plain":
+		b, err := io.ReadAll(decodeTransfer(encoding, r))
+		if err != nil {
+			return "", err
+		}
+		return decodeCharset(params["charset"], b)
+	}
+
+	return "", nil
+}
+
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, newlineStripper{r})
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	}
+	return r
+}
+
+// base64の本文の改行を読み飛ばす
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (n newlineStripper) Read(p []byte) (int, error) {
+	for {
+		c, err := n.r.Read(p)
+		out := 0
+		for _, b := range p[:c] {
+			if b != '\r' && b != '\n' {
+				p[out] = b
+				out++
+			}
+		}
+		if out > 0 || err != nil {
+			return out, err
+		}
+	}
+}
+
+func decodeCharset(charset string, b []byte) (string, error) {
+	if charset == "" || strings.EqualFold(charset, "utf-8") || strings.EqualFold(charset, "us-ascii") {
+		return string(b), nil
+	}
+	r, err := charsetReader(charset, bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// ISO-2022-JP・Shift_JIS等、UTF-8以外の文字コードの変換
+func charsetReader(charset string, r io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported charset %q", charset)
+	}
+	return enc.NewDecoder().Reader(r), nil
+}
+
+func stripSignature(body string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	if i := strings.Index(body, "\n-- \n"); i >= 0 {
+		body = body[:i]
+	}
+	return strings.TrimSpace(body)
+}
@@ -0,0 +1,350 @@
+package inbound
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const (
+	// 1通のメールの最大サイズ
+	maxMessageBytes = 1 << 20
+
+	// 1コマンドの最大長（RFC 5321の上限より余裕を持たせる）
+	maxLineBytes = 4096
+
+	// 1通あたりの最大宛先数
+	maxRecipients = 10
+
+	// 同時接続数の上限
+	maxConns = 32
+
+	// コマンド・データ受信のタイムアウト
+	commandTimeout = 5 * time.Minute
+
+	// メールから取り込む場合のminkan更新元
+	originSMTP = "inbound:smtp"
+)
+
+// SMTPServer はメールを受信し、宛先のトークンのユーザーにタスクとして取り込む
+// 宛先は "<トークン>@<Domain>" とし、トークンが秘密URLと共通のため、アドレスを知っている送信者のみが取り込める
+// 認証・TLSは行わないため、公開する場合は前段のMTAから転送する構成を想定する
+type SMTPServer struct {
+	Addr     string // 待ち受けアドレス（例 ":2525"）
+	Domain   string // 受け付ける宛先ドメイン（空の場合はドメインを問わない）
+	Hostname string // 挨拶で名乗るホスト名
+	Capturer *Capturer
+}
+
+func NewSMTPServer(addr, domain string, capturer *Capturer) *SMTPServer {
+	hostname := domain
+	if hostname == "" {
+		hostname = "localhost"
+	}
+	return &SMTPServer{Addr: addr, Domain: domain, Hostname: hostname, Capturer: capturer}
+}
+
+// ctxが終了するまで接続を受け付ける
+func (s *SMTPServer) ListenAndServe(ctx context.Context) error {
+	lg := slog.Default().With("module", "inbound")
+
+	ln, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	lg.Info("inbound SMTP server started", "addr", ln.Addr().String(), "domain", s.Domain)
+
+	var wg sync.WaitGroup
+	stop := context.AfterFunc(ctx, func() { _ = ln.Close() })
+	defer stop()
+
+	sem := make(chan struct{}, maxConns)
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			wg.Wait()
+			if ctx.Err() != nil {
+				lg.Info("inbound SMTP server stopped")
+				return nil
+			}
+			return err
+		}
+
+		select {
+		case sem <- struct{}{}:
+		default:
+			_, _ = conn.Write([]byte("421 4.3.2 too many connections\r\n"))
+			_ = conn.Close()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.serve(ctx, conn)
+		}()
+	}
+}
+
+// session は1接続分のSMTPの状態
+type session struct {
+	s      *SMTPServer
+	conn   net.Conn
+	r      *bufio.Reader
+	w      *textproto.Writer
+	lg     *slog.Logger
+	helo   bool
+	from   bool
+	tokens []*repository.InboundToken
+}
+
+func (s *SMTPServer) serve(ctx context.Context, conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	// 停止時は受信途中の接続も閉じる（タイムアウトまで停止を待たせないように）
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	ss := &session{
+		s:    s,
+		conn: conn,
+		r:    bufio.NewReaderSize(conn, maxLineBytes),
+		w:    textproto.NewWriter(bufio.NewWriter(conn)),
+		lg:   slog.Default().With("module", "inbound", "remote", conn.RemoteAddr().String()),
+	}
+
+	ss.reply(220, s.Hostname+" Minkan inbound ESMTP")
+	for ctx.Err() == nil {
+		_ = conn.SetDeadline(time.Now().Add(commandTimeout))
+
+		line, err := ss.readLine()
+		if errors.Is(err, errLineTooLong) {
+			ss.reply(500, "5.5.2 line too long")
+			continue
+		}
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		if !ss.handle(ctx, strings.ToUpper(verb), strings.TrimSpace(arg)) {
+			return
+		}
+	}
+	ss.reply(421, "4.3.2 shutting down")
+}
+
+var errLineTooLong = errors.New("line too long")
+
+// CRLFを除いた1行を読む（長すぎる行は読み捨ててエラーにする）
+func (ss *session) readLine() (string, error) {
+	line, isPrefix, err := ss.r.ReadLine()
+	if err != nil {
+		return "", err
+	}
+	if isPrefix {
+		for isPrefix && err == nil {
+			_, isPrefix, err = ss.r.ReadLine()
+		}
+		if err != nil {
+			return "", err
+		}
+		return "", errLineTooLong
+	}
+	return string(line), nil
+}
+
+func (ss *session) reply(code int, lines ...string) {
+	for i, l := range lines {
+		sep := " "
+		if i < len(lines)-1 {
+			sep = "-"
+		}
+		_ = ss.w.PrintfLine("%d%s%s", code, sep, l)
+	}
+}
+
+func (ss *session) reset() {
+	ss.from = false
+	ss.tokens = nil
+}
+
+// コマンドを処理する（接続を閉じる場合はfalse）
+func (ss *session) handle(ctx context.Context, verb, arg string) bool {
+	switch verb {
+	case "HELO":
+		ss.helo = true
+		ss.reset()
+		ss.reply(250, ss.s.Hostname)
+	case "EHLO":
+		ss.helo = true
+		ss.reset()
+		ss.reply(250, ss.s.Hostname, "SIZE "+strconv.Itoa(maxMessageBytes), "8BITMIME", "ENHANCEDSTATUSCODES")
+	case "MAIL":
+		if !ss.helo {
+			ss.reply(503, "5.5.1 send HELO/EHLO first")
+			return true
+		}
+		if _, ok := cutPath(arg, "FROM:"); !ok {
+			ss.reply(501, "5.5.4 syntax: MAIL FROM:<address>")
+			return true
+		}
+		ss.reset()
+		ss.from = true
+		ss.reply(250, "2.1.0 OK")
+	case "RCPT":
+		ss.rcpt(ctx, arg)
+	case "DATA":
+		return ss.data(ctx)
+	case "RSET":
+		ss.reset()
+		ss.reply(250, "2.0.0 OK")
+	case "NOOP":
+		ss.reply(250, "2.0.0 OK")
+	case "VRFY":
+		ss.reply(252, "2.5.0 cannot verify")
+	case "QUIT":
+		ss.reply(221, "2.0.0 bye")
+		return false
+	default:
+		ss.reply(502, "5.5.1 command not implemented")
+	}
+	return true
+}
+
+func (ss *session) rcpt(ctx context.Context, arg string) {
+	if !ss.from {
+		ss.reply(503, "5.5.1 send MAIL first")
+		return
+	}
+	addr, ok := cutPath(arg, "TO:")
+	if !ok {
+		ss.reply(501, "5.5.4 syntax: RCPT TO:<address>")
+		return
+	}
+	if len(ss.tokens) >= maxRecipients {
+		ss.reply(452, "4.5.3 too many recipients")
+		return
+	}
+
+	local, domain, found := strings.Cut(addr, "@")
+	if !found || (ss.s.Domain != "" && !strings.EqualFold(domain, ss.s.Domain)) {
+		ss.reply(550, "5.1.1 mailbox unavailable")
+		return
+	}
+
+	// 送信側・中継のMTAがローカル部の大文字小文字を変える場合があるため、小文字で照合する（トークンは小文字で発行）
+	t, err := ss.s.Capturer.Lookup(ctx, strings.ToLower(local))
+	if err == nil && t == nil && local != strings.ToLower(local) {
+		// 小文字で発行する前の大文字を含むトークン
+		t, err = ss.s.Capturer.Lookup(ctx, local)
+	}
+	if err != nil {
+		ss.lg.Error("lookup inbound token failed", "err", err)
+		ss.reply(451, "4.3.0 temporary failure")
+		return
+	}
+	if t == nil {
+		ss.reply(550, "5.1.1 mailbox unavailable")
+		return
+	}
+
+	ss.tokens = append(ss.tokens, t)
+	ss.reply(250, "2.1.5 OK")
+}
+
+func (ss *session) data(ctx context.Context) bool {
+	if len(ss.tokens) == 0 {
+		ss.reply(503, "5.5.1 send RCPT first")
+		return true
+	}
+	ss.reply(354, "end data with <CR><LF>.<CR><LF>")
+
+	// 上限を超えた分も終端まで読み捨てる（途中で応答すると以降のコマンドとずれる）
+	dot := textproto.NewReader(ss.r).DotReader()
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(dot, maxMessageBytes+1))
+	if err == nil && n > maxMessageBytes {
+		_, err = io.Copy(io.Discard, dot)
+		if err == nil {
+			ss.reset()
+			ss.reply(552, "5.3.4 message too big")
+			return true
+		}
+	}
+	if err != nil {
+		return false
+	}
+
+	tokens := ss.tokens
+	ss.reset()
+
+	// Message-IDが無いメールは内容で重複を判定する（再送は同じ内容のため）
+	sum := sha256.Sum256(buf.Bytes())
+	item, err := ParseMessage(&buf)
+	if err == nil && strings.TrimSpace(item.Key) == "" {
+		item.Key = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	if err == nil {
+		item, err = Normalize(item)
+	}
+	if err != nil {
+		ss.lg.Warn("invalid inbound message", "err", err)
+		ss.reply(554, "5.6.0 message cannot be captured")
+		return true
+	}
+
+	// 宛先ごとに順に取り込むため、途中の宛先で失敗して再送された場合、取り込み済みの宛先はitem.Keyで重複を除く
+	for _, t := range tokens {
+		res, err := ss.s.Capturer.Capture(ctx, t, item, originSMTP)
+		if errors.Is(err, ErrNoProject) || errors.Is(err, minkan.ErrStateNotFound) {
+			// 再送しても取り込めないため、この宛先は諦める
+			ss.lg.Warn("inbound message dropped", "userID", t.UserID, "err", err)
+			continue
+		}
+		if err != nil {
+			ss.lg.Error("capture inbound message failed", "userID", t.UserID, "err", err)
+			ss.reply(451, "4.3.0 temporary failure")
+			return true
+		}
+		if res.Duplicate {
+			ss.lg.Info("inbound message already captured", "userID", t.UserID, "pjID", res.PjID, "nodeID", res.NodeID)
+			continue
+		}
+		ss.lg.Info("inbound message captured", "userID", t.UserID, "pjID", res.PjID, "nodeID", res.NodeID)
+	}
+
+	ss.reply(250, "2.0.0 OK")
+	return true
+}
+
+// "FROM:<addr> パラメータ" からアドレスを取り出す
+func cutPath(arg, prefix string) (string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", false
+	}
+	rest := strings.TrimSpace(arg[len(prefix):])
+	if !strings.HasPrefix(rest, "<") {
+		return "", false
+	}
+	end := strings.Index(rest, ">")
+	if end < 0 {
+		return "", false
+	}
+	return rest[1:end], true
+}
@@ -0,0 +1,206 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan/fakestate"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+// fakeTokens はテスト用のinbound_tokens（トークンのハッシュで引く）
+type fakeTokens struct {
+	byHash map[string]*repository.InboundToken
+}
+
+func (f *fakeTokens) add(t *testing.T, userID int64) string {
+	t.Helper()
+	tok, err := NewToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.byHash[string(token.Hash(tok))] = &repository.InboundToken{UserID: userID}
+	return tok
+}
+
+func (f *fakeTokens) FindInboundTokenByUserID(context.Context, int64) (*repository.InboundToken, error) {
+	return nil, nil
+}
+
+func (f *fakeTokens) FindInboundTokenByHash(_ context.Context, tokenHash []byte) (*repository.InboundToken, error) {
+	return f.byHash[string(tokenHash)], nil
+}
+
+func (f *fakeTokens) UpsertInboundToken(context.Context, int64, []byte, *string) error { return nil }
+
+func (f *fakeTokens) UpdateInboundPjID(context.Context, int64, *string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeTokens) DeleteInboundToken(context.Context, int64) error { return nil }
+
+func (f *fakeTokens) TouchInboundToken(context.Context, int64, time.Time) error { return nil }
+
+// userIDのstateを1プロジェクト（ルートノードのみ）で登録する
+func putState(t *testing.T, repo *fakestate.Repo, userID int64) {
+	t.Helper()
+	m := repository.Minkan{
+		CurrentPjId: "pj1",
+		Projects: repository.Projects{
+			"pj1": {Id: "pj1", Name: "Project", Nodes: []repository.Node{minkan.NewNode(minkan.RootNodeID, "", "root")}, Edges: []repository.Edge{}},
+		},
+	}
+	m.Projects["pj1"].Nodes[0].Data.ParentId = nil
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.Put(userID, b)
+}
+
+// userIDのstateのうちラベルがlabelのノード数
+func countLabel(t *testing.T, repo *fakestate.Repo, userID int64, label string) int {
+	t.Helper()
+	m, err := minkan.Decode(repo.State(userID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, pj := range m.Projects {
+		for _, node := range pj.Nodes {
+			if node.Data.Label == label {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// SMTPServerと1接続分のやり取りをするクライアント
+func dial(t *testing.T, s *SMTPServer) *textproto.Conn {
+	t.Helper()
+	client, server := net.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.serve(ctx, server)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		_ = client.Close()
+		<-done
+	})
+
+	c := textproto.NewConn(client)
+	if _, _, err := c.ReadResponse(220); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func cmd(t *testing.T, c *textproto.Conn, want int, format string, args ...any) {
+	t.Helper()
+	id, err := c.Cmd(format, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StartResponse(id)
+	defer c.EndResponse(id)
+	if code, msg, err := c.ReadResponse(want); err != nil {
+		t.Fatalf("%s: %d %s: %v", format, code, msg, err)
+	}
+}
+
+// 1通のメールを送り、DATAの終端に対する応答コードを返す
+func sendMail(t *testing.T, c *textproto.Conn, rcpts []string, msg string) int {
+	t.Helper()
+	cmd(t, c, 250, "MAIL FROM:<sender@example.net>")
+	for _, r := range rcpts {
+		cmd(t, c, 250, "RCPT TO:<%s>", r)
+	}
+	cmd(t, c, 354, "DATA")
+
+	w := c.DotWriter()
+	if _, err := w.Write([]byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	code, _, err := c.ReadResponse(0)
+	var protoErr *textproto.Error
+	if err != nil && !errors.As(err, &protoErr) {
+		t.Fatal(err)
+	}
+	return code
+}
+
+const testMessage = "From: sender@example.net\r\n" +
+	"Subject: Buy milk\r\n" +
+	"Message-ID: <abc123@example.net>\r\n" +
+	"\r\n" +
+	"2 bottles\r\n"
+
+// 2人目の宛先で一時的に失敗して再送されても、1人目に同じタスクが重複しない
+func TestSMTPRetryAfterPartialFailure(t *testing.T) {
+	states := fakestate.New()
+	putState(t, states, 1)
+	putState(t, states, 2)
+	states.FailUpdates(2, 1)
+	tokens := &fakeTokens{byHash: map[string]*repository.InboundToken{}}
+	tok1, tok2 := tokens.add(t, 1), tokens.add(t, 2)
+
+	s := NewSMTPServer("", "in.example.com", NewCapturer(tokens, minkan.NewStore(states, nil)))
+	c := dial(t, s)
+	cmd(t, c, 250, "EHLO client.example.net")
+
+	// ローカル部の大文字小文字が変わっても照合できる
+	rcpts := []string{strings.ToUpper(tok1) + "@in.example.com", tok2 + "@IN.EXAMPLE.COM"}
+
+	if code := sendMail(t, c, rcpts, testMessage); code != 451 {
+		t.Fatalf("first attempt: %d, want 451", code)
+	}
+	if n := countLabel(t, states, 1, "Buy milk"); n != 1 {
+		t.Fatalf("user 1 has %d tasks after the first attempt, want 1", n)
+	}
+
+	if code := sendMail(t, c, rcpts, testMessage); code != 250 {
+		t.Fatalf("retry: %d, want 250", code)
+	}
+	for _, userID := range []int64{1, 2} {
+		if n := countLabel(t, states, userID, "Buy milk"); n != 1 {
+			t.Errorf("user %d has %d tasks, want 1", userID, n)
+		}
+	}
+
+	// Message-IDが異なるメールは別のタスクとして取り込む
+	other := strings.Replace(testMessage, "abc123", "def456", 1)
+	if code := sendMail(t, c, rcpts[:1], other); code != 250 {
+		t.Fatalf("other message: %d, want 250", code)
+	}
+	if n := countLabel(t, states, 1, "Buy milk"); n != 2 {
+		t.Errorf("user 1 has %d tasks, want 2", n)
+	}
+}
+
+func TestSMTPUnknownRecipient(t *testing.T) {
+	tokens := &fakeTokens{byHash: map[string]*repository.InboundToken{}}
+	tok := tokens.add(t, 1)
+	s := NewSMTPServer("", "in.example.com", NewCapturer(tokens, nil))
+	c := dial(t, s)
+
+	cmd(t, c, 250, "HELO client.example.net")
+	cmd(t, c, 250, "MAIL FROM:<sender@example.net>")
+	cmd(t, c, 550, "RCPT TO:<%s>", TokenPrefix+"_unknown@in.example.com")
+	cmd(t, c, 550, "RCPT TO:<%s>", tok+"@other.example.com")
+	cmd(t, c, 503, "DATA")
+}
@@ -0,0 +1,133 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Operation はRFC 6902(JSON Patch)の1操作
+// 差分生成で使うのは add / remove / replace のみ
+type Operation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// MarshalJSON はremove以外でvalueがnullの場合も"value"を出力する
+func (o Operation) MarshalJSON() ([]byte, error) {
+	if o.Op == "remove" {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+	return json.Marshal(struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value any    `json:"value"`
+	}{o.Op, o.Path, o.Value})
+}
+
+// Diff は2つのJSONドキュメントの差分を、oldに適用するとnewになるJSON Patchとして返す
+func Diff(oldJSON, newJSON []byte) ([]Operation, error) {
+	var oldDoc, newDoc any
+	if err := json.Unmarshal(oldJSON, &oldDoc); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(newJSON, &newDoc); err != nil {
+		return nil, err
+	}
+
+	ops := []Operation{}
+	diff(&ops, "", oldDoc, newDoc)
+	return ops, nil
+}
+
+func diff(ops *[]Operation, path string, oldVal, newVal any) {
+	switch o := oldVal.(type) {
+	case map[string]any:
+		if n, ok := newVal.(map[string]any); ok {
+			diffObject(ops, path, o, n)
+			return
+		}
+	case []any:
+		if n, ok := newVal.([]any); ok {
+			diffArray(ops, path, o, n)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(oldVal, newVal) {
+		*ops = append(*ops, Operation{Op: "replace", Path: path, Value: newVal})
+	}
+}
+
+func diffObject(ops *[]Operation, path string, oldObj, newObj map[string]any) {
+	// 出力順を安定させるためキー順に処理
+	keys := make([]string, 0, len(oldObj)+len(newObj))
+	for k := range oldObj {
+		keys = append(keys, k)
+	}
+	for k := range newObj {
+		if _, ok := oldObj[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := path + "/" + escape(k)
+		oldVal, inOld := oldObj[k]
+		newVal, inNew := newObj[k]
+
+		switch {
+		case inOld && !inNew:
+			*ops = append(*ops, Operation{Op: "remove", Path: p})
+		case !inOld && inNew:
+			*ops = append(*ops, Operation{Op: "add", Path: p, Value: newVal})
+		default:
+			diff(ops, p, oldVal, newVal)
+		}
+	}
+}
+
+// 配列は先頭・末尾の共通部分を除いた中央部分のみを比較する
+// （末尾への追加や途中の1件削除など、よくある変更を小さなパッチにするため）
+func diffArray(ops *[]Operation, path string, oldArr, newArr []any) {
+	prefix := 0
+	for prefix < len(oldArr) && prefix < len(newArr) && reflect.DeepEqual(oldArr[prefix], newArr[prefix]) {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldArr)-prefix && suffix < len(newArr)-prefix &&
+		reflect.DeepEqual(oldArr[len(oldArr)-1-suffix], newArr[len(newArr)-1-suffix]) {
+		suffix++
+	}
+
+	oldMid := oldArr[prefix : len(oldArr)-suffix]
+	newMid := newArr[prefix : len(newArr)-suffix]
+
+	common := min(len(oldMid), len(newMid))
+	for i := 0; i < common; i++ {
+		diff(ops, path+"/"+strconv.Itoa(prefix+i), oldMid[i], newMid[i])
+	}
+
+	// 余った要素を削除（後ろから消すことでindexがずれないようにする）
+	for i := len(oldMid) - 1; i >= common; i-- {
+		*ops = append(*ops, Operation{Op: "remove", Path: path + "/" + strconv.Itoa(prefix+i)})
+	}
+
+	// 足りない要素を追加
+	for i := common; i < len(newMid); i++ {
+		*ops = append(*ops, Operation{Op: "add", Path: path + "/" + strconv.Itoa(prefix+i), Value: newMid[i]})
+	}
+}
+
+// JSON Pointer(RFC 6901)のエスケープ
+func escape(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
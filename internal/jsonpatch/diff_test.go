@@ -0,0 +1,188 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// テスト用のRFC 6902の適用（Diffが出力する add / remove / replace のみ）
+func apply(doc any, ops []Operation) (any, error) {
+	for _, op := range ops {
+		var err error
+		if doc, err = applyOp(doc, op); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", op.Op, op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+func applyOp(doc any, op Operation) (any, error) {
+	if op.Path == "" {
+		if op.Op != "replace" {
+			return nil, fmt.Errorf("unsupported op on the root")
+		}
+		return op.Value, nil
+	}
+	if !strings.HasPrefix(op.Path, "/") {
+		return nil, fmt.Errorf("invalid pointer")
+	}
+
+	tokens := strings.Split(op.Path[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(t)
+	}
+	return applyAt(doc, tokens, op)
+}
+
+// tokensの親までたどり、最後のtokenに操作を適用した値を返す
+func applyAt(parent any, tokens []string, op Operation) (any, error) {
+	key, rest := tokens[0], tokens[1:]
+
+	switch p := parent.(type) {
+	case map[string]any:
+		if len(rest) > 0 {
+			child, ok := p[key]
+			if !ok {
+				return nil, fmt.Errorf("missing key %q", key)
+			}
+			v, err := applyAt(child, rest, op)
+			p[key] = v
+			return p, err
+		}
+		_, exists := p[key]
+		switch {
+		case op.Op == "add":
+			p[key] = op.Value
+		case op.Op == "replace" && exists:
+			p[key] = op.Value
+		case op.Op == "remove" && exists:
+			delete(p, key)
+		default:
+			return nil, fmt.Errorf("cannot %s missing key %q", op.Op, key)
+		}
+		return p, nil
+
+	case []any:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i > len(p) {
+			return nil, fmt.Errorf("invalid index %q", key)
+		}
+		if len(rest) > 0 {
+			if i == len(p) {
+				return nil, fmt.Errorf("index %d out of range", i)
+			}
+			v, err := applyAt(p[i], rest, op)
+			p[i] = v
+			return p, err
+		}
+		switch {
+		case op.Op == "add":
+			return append(p[:i], append([]any{op.Value}, p[i:]...)...), nil
+		case op.Op == "replace" && i < len(p):
+			p[i] = op.Value
+			return p, nil
+		case op.Op == "remove" && i < len(p):
+			return append(p[:i], p[i+1:]...), nil
+		}
+		return nil, fmt.Errorf("index %d out of range", i)
+	}
+	return nil, fmt.Errorf("cannot traverse %T", parent)
+}
+
+func TestDiffRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		maxOps   int // 生成されるパッチの操作数の上限
+	}{
+		{"equal", `{"a": [1, 2], "b": {"c": null}}`, `{"a": [1, 2], "b": {"c": null}}`, 0},
+		{"add key", `{"a": 1}`, `{"a": 1, "b": {"c": [1]}}`, 1},
+		{"remove key", `{"a": 1, "b": 2}`, `{"a": 1}`, 1},
+		{"replace value", `{"a": 1}`, `{"a": "1"}`, 1},
+		{"null value", `{"a": 1}`, `{"a": null}`, 1},
+		{"nested object", `{"a": {"b": {"c": 1, "d": 2}}}`, `{"a": {"b": {"c": 3, "e": 4}}}`, 3},
+		{"object to array", `{"a": {"b": 1}}`, `{"a": [1]}`, 1},
+		{"array to scalar", `{"a": [1, 2]}`, `{"a": 2}`, 1},
+		{"root type", `{"a": 1}`, `[1]`, 1},
+		{"array append", `{"a": [1, 2]}`, `{"a": [1, 2, 3, 4]}`, 2},
+		{"array prepend", `{"a": [1, 2]}`, `{"a": [0, 1, 2]}`, 1},
+		{"array insert", `{"a": [1, 2, 3]}`, `{"a": [1, 9, 2, 3]}`, 1},
+		{"array delete", `{"a": [1, 2, 3, 4]}`, `{"a": [1, 3, 4]}`, 1},
+		{"array delete all", `{"a": [1, 2, 3]}`, `{"a": []}`, 3},
+		{"array mixed", `{"a": [1, 2, 3, 4, 5]}`, `{"a": [1, 7, 8, 9, 4, 6, 5]}`, 5},
+		{"array of objects", `{"a": [{"id": 1, "v": "x"}, {"id": 2}]}`, `{"a": [{"id": 1, "v": "y"}, {"id": 3}, {"id": 2}]}`, 2},
+		{"escaped keys", `{"a/b": 1, "c~d": {"~1": 2}, "e": 3}`, `{"a/b": 2, "c~d": {"~1": 3, "/": 4}}`, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := Diff([]byte(tt.old), []byte(tt.new))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ops) > tt.maxOps {
+				t.Errorf("%d ops, want at most %d: %+v", len(ops), tt.maxOps, ops)
+			}
+
+			// JSONを経由して、クライアントが受け取る形で適用する
+			b, err := json.Marshal(ops)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var patch []Operation
+			if err := json.Unmarshal(b, &patch); err != nil {
+				t.Fatal(err)
+			}
+
+			var doc, want any
+			if err := json.Unmarshal([]byte(tt.old), &doc); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.new), &want); err != nil {
+				t.Fatal(err)
+			}
+			got, err := apply(doc, patch)
+			if err != nil {
+				t.Fatalf("apply %s: %v", b, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("patched = %v, want %v (patch %s)", got, want, b)
+			}
+		})
+	}
+}
+
+func TestDiffEscapesPointer(t *testing.T) {
+	ops, err := Diff([]byte(`{}`), []byte(`{"a/b~c": 1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 1 || ops[0].Path != "/a~1b~0c" {
+		t.Errorf("ops = %+v", ops)
+	}
+}
+
+func TestOperationMarshalJSON(t *testing.T) {
+	tests := []struct {
+		op   Operation
+		want string
+	}{
+		{Operation{Op: "remove", Path: "/a"}, `{"op":"remove","path":"/a"}`},
+		{Operation{Op: "replace", Path: "/a", Value: nil}, `{"op":"replace","path":"/a","value":null}`},
+		{Operation{Op: "add", Path: "/a/0", Value: false}, `{"op":"add","path":"/a/0","value":false}`},
+	}
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.op)
+		if err != nil || string(b) != tt.want {
+			t.Errorf("Marshal(%+v) = %s, %v; want %s", tt.op, b, err, tt.want)
+		}
+	}
+
+	if _, err := Diff([]byte(`{`), []byte(`{}`)); err == nil {
+		t.Error("Diff accepted invalid JSON")
+	}
+}
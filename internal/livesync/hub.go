@@ -0,0 +1,397 @@
+package livesync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// このHubで適用した操作の更新元（Store.Mutate の origin）
+const originPrefix = "live:"
+
+const (
+	writeWait        = 10 * time.Second  // 1メッセージの書き込み待ち上限
+	pongWait         = 60 * time.Second  // pongが届かない場合に切断するまでの時間
+	pingPeriod       = pongWait * 9 / 10 // ping送信間隔（pongWaitより短く）
+	maxMessageSize   = 64 * 1024         // 受信メッセージの最大サイズ
+	sendBufferSize   = 32                // 送信キューのサイズ（溢れた接続は切断）
+	closeGracePeriod = 1 * time.Second   // Close時にクライアントへcloseフレームを送る猶予
+)
+
+var ErrHubClosed = errors.New("livesync hub closed")
+
+// ClientInfo は接続時にクライアントから受け取る端末情報
+type ClientInfo struct {
+	DeviceID   string
+	DeviceName string
+}
+
+// 1つのWebSocket接続
+type client struct {
+	id          string
+	userID      int64
+	info        ClientInfo
+	connectedAt time.Time
+	ws          *websocket.Conn
+	send        chan outMessage
+
+	// 以下はHub.muで保護
+	projectID string
+	closed    bool
+}
+
+// Hub はユーザーごとのWebSocket接続を管理し、操作と在席情報を配信する
+// このHub以外の経路（PUT /minkan・CalDAV・メール取り込み等）での更新は、Store.EventHub を購読してversionを通知する
+type Hub struct {
+	Store *minkan.Store
+
+	mu      sync.Mutex
+	clients map[int64]map[*client]struct{}
+	closed  bool
+}
+
+func NewHub(store *minkan.Store) *Hub {
+	return &Hub{
+		Store:   store,
+		clients: make(map[int64]map[*client]struct{}),
+	}
+}
+
+// アップグレード済みのWebSocket接続を処理する
+// 接続が切れるまでブロックする。ctxが終了した場合（セッションの削除等）は接続を閉じる
+func (h *Hub) Serve(ctx context.Context, ws *websocket.Conn, userID int64, info ClientInfo) error {
+	lg := slog.Default().With("module", "livesync", "userID", userID)
+
+	c := &client{
+		id:          uuid.New().String(),
+		userID:      userID,
+		info:        info,
+		connectedAt: time.Now().UTC(),
+		ws:          ws,
+		send:        make(chan outMessage, sendBufferSize),
+	}
+
+	if err := h.register(c); err != nil {
+		_ = ws.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+			time.Now().Add(writeWait))
+		_ = ws.Close()
+		return err
+	}
+
+	// ハイジャック後の接続はctxの終了で閉じられないため、明示的に閉じる
+	stopClosing := context.AfterFunc(ctx, func() {
+		_ = ws.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "session ended"),
+			time.Now().Add(closeGracePeriod))
+		// readPumpのReadMessageをエラーで抜けさせる
+		_ = ws.Close()
+	})
+	defer stopClosing()
+
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		h.writePump(c)
+	}()
+
+	// 接続直後のversionより後の更新を取りこぼさないよう、stateの取得前に購読する
+	stopRelay := h.subscribe(c, lg)
+
+	// 接続直後に現在のversionと在席一覧を送る
+	state, err := h.Store.Repo.FindStateByUserID(ctx, userID)
+	if err != nil {
+		lg.Error("find minkan_state error", "err", err)
+	}
+	welcome := outMessage{Type: msgWelcome, ConnectionID: c.id, Presence: h.presence(userID)}
+	if state != nil {
+		welcome.Version = state.Version
+	}
+	h.enqueue(c, welcome)
+	h.broadcastPresence(userID)
+
+	h.readPump(ctx, c, lg)
+
+	stopRelay()
+	h.unregister(c)
+	h.broadcastPresence(userID)
+	<-writerDone
+
+	lg.Info("live connection closed", "connectionID", c.id)
+	return nil
+}
+
+// 全接続を終了させる（graceful shutdown用）
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil
+	}
+	h.closed = true
+
+	for _, clients := range h.clients {
+		for c := range clients {
+			_ = c.ws.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+				time.Now().Add(closeGracePeriod))
+			// readPumpのReadMessageをエラーで抜けさせる
+			_ = c.ws.Close()
+		}
+	}
+
+	return nil
+}
+
+func (h *Hub) register(c *client) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return ErrHubClosed
+	}
+
+	if h.clients[c.userID] == nil {
+		h.clients[c.userID] = make(map[*client]struct{})
+	}
+	h.clients[c.userID][c] = struct{}{}
+	return nil
+}
+
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.clients[c.userID], c)
+	if len(h.clients[c.userID]) == 0 {
+		delete(h.clients, c.userID)
+	}
+
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+}
+
+// 送信キューへ積む。詰まっている接続は切断する
+func (h *Hub) enqueue(c *client, msg outMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.enqueueLocked(c, msg)
+}
+
+func (h *Hub) enqueueLocked(c *client, msg outMessage) {
+	if c.closed {
+		return
+	}
+
+	select {
+	case c.send <- msg:
+	default:
+		// 受信が追いつかないクライアントは切断（再接続時にGET /minkanで再同期させる）
+		c.closed = true
+		close(c.send)
+	}
+}
+
+// userIDの全接続（except以外）へ送信
+func (h *Hub) broadcast(userID int64, except *client, msg outMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for c := range h.clients[userID] {
+		if c != except {
+			h.enqueueLocked(c, msg)
+		}
+	}
+}
+
+func (h *Hub) broadcastPresence(userID int64) {
+	h.broadcast(userID, nil, outMessage{Type: msgPresence, Presence: h.presence(userID)})
+}
+
+// userIDの在席一覧（接続順）
+func (h *Hub) presence(userID int64) []Presence {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	list := make([]Presence, 0, len(h.clients[userID]))
+	for c := range h.clients[userID] {
+		list = append(list, Presence{
+			ConnectionID: c.id,
+			DeviceID:     c.info.DeviceID,
+			DeviceName:   c.info.DeviceName,
+			ProjectID:    c.projectID,
+			ConnectedAt:  c.connectedAt,
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].ConnectedAt.Equal(list[j].ConnectedAt) {
+			return list[i].ConnectionID < list[j].ConnectionID
+		}
+		return list[i].ConnectedAt.Before(list[j].ConnectedAt)
+	})
+
+	return list
+}
+
+// クライアントからのメッセージを受信し処理する
+func (h *Hub) readPump(ctx context.Context, c *client, lg *slog.Logger) {
+	c.ws.SetReadLimit(maxMessageSize)
+	_ = c.ws.SetReadDeadline(time.Now().Add(pongWait))
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				lg.Debug("websocket read error", "err", err)
+			}
+			return
+		}
+
+		var msg inMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			h.enqueue(c, outMessage{Type: msgError, Error: "invalid message"})
+			continue
+		}
+
+		switch msg.Type {
+		case msgOp:
+			h.handleOp(ctx, c, msg, lg)
+
+		case msgPresence:
+			h.mu.Lock()
+			c.projectID = msg.ProjectID
+			h.mu.Unlock()
+			h.broadcastPresence(c.userID)
+
+		default:
+			h.enqueue(c, outMessage{Type: msgError, Error: "unknown message type"})
+		}
+	}
+}
+
+// 操作を検証してstateへ適用し、他の接続へ配信する
+func (h *Hub) handleOp(ctx context.Context, c *client, msg inMessage, lg *slog.Logger) {
+	if msg.Op == nil {
+		h.enqueue(c, outMessage{Type: msgReject, OpID: msg.OpID, Error: "op is required"})
+		return
+	}
+
+	version, err := h.Store.Mutate(ctx, c.userID, originPrefix+c.id, func(m *repository.Minkan) error {
+		return minkan.Apply(m, msg.Op)
+	})
+
+	if errors.Is(err, minkan.ErrInvalidOp) {
+		h.enqueue(c, outMessage{Type: msgReject, OpID: msg.OpID, Error: err.Error()})
+		return
+	}
+	if err != nil {
+		h.enqueue(c, outMessage{Type: msgReject, OpID: msg.OpID, Error: "internal server error"})
+		lg.Error("apply op error", "err", err, "opType", msg.Op.Type)
+		return
+	}
+
+	h.enqueue(c, outMessage{Type: msgAck, OpID: msg.OpID, Version: version})
+	h.broadcast(c.userID, c, outMessage{
+		Type:         msgOp,
+		ConnectionID: c.id,
+		Op:           msg.Op,
+		Version:      version,
+	})
+}
+
+// 他の経路での更新を購読し、接続へversionを通知する
+// 返り値の関数で購読を終了する（通知の送信が終わるまで待つ）
+func (h *Hub) subscribe(c *client, lg *slog.Logger) (stop func()) {
+	if h.Store.EventHub == nil {
+		return func() {}
+	}
+
+	events, unsubscribe, err := h.Store.EventHub.Subscribe(c.userID)
+	if err != nil {
+		// 購読できなくても操作の送受信は続ける（他の経路の更新は次回の操作時のversionで検知される）
+		lg.Warn("subscribe failed", "err", err)
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ev := range events {
+			if h.isLocalOrigin(c.userID, ev.Origin) {
+				continue
+			}
+			h.enqueue(c, outMessage{Type: msgVersion, Version: ev.Version, Origin: ev.Origin})
+		}
+	}()
+
+	return func() {
+		unsubscribe()
+		<-done
+	}
+}
+
+// このHubの接続が適用した操作か（opとして配信済みのため、versionの通知は不要）
+// 他のインスタンスのHubで適用した操作はopとして届かないため、通知する
+func (h *Hub) isLocalOrigin(userID int64, origin string) bool {
+	id, ok := strings.CutPrefix(origin, originPrefix)
+	if !ok {
+		return false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for c := range h.clients[userID] {
+		if c.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// 送信キューの内容をWebSocketへ書き込み、定期的にpingを送る
+func (h *Hub) writePump(c *client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.ws.Close()
+	}()
+
+	for {
+		select {
+		case msg, ok := <-c.send:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				// unregister or 送信詰まりで閉じられた
+				_ = c.ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+			if err := c.ws.WriteJSON(msg); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package livesync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan/fakestate"
+	"github.com/yopi416/mind-kanban-backend/internal/pubsub"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const testState = `{
+  "currentPjId": "pj1",
+  "kanbanColumns": {"backlog": [], "todo": [], "doing": [], "done": []},
+  "kanbanIndex": {},
+  "projects": {
+    "pj1": {"id": "pj1", "name": "Project", "nodes": [
+      {"id": "root", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "root", "isDone": false, "comments": [], "parentId": null}},
+      {"id": "n1", "type": "custom", "position": {"x": 0, "y": 0}, "data": {"label": "task", "isDone": false, "comments": [], "parentId": "root"}}
+    ], "edges": []}
+  }
+}`
+
+// userID 1のWebSocket接続を受け付けるHubとサーバ
+func newTestHub(t *testing.T) (*minkan.Store, string) {
+	t.Helper()
+	repo := fakestate.New()
+	repo.Put(1, json.RawMessage(testState))
+	eventHub := pubsub.NewMemoryHub()
+	store := minkan.NewStore(repo, eventHub)
+	hub := NewHub(store)
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		_ = hub.Serve(r.Context(), ws, 1, ClientInfo{})
+	}))
+	t.Cleanup(func() {
+		_ = hub.Close()
+		_ = eventHub.Close()
+		srv.Close()
+	})
+	return store, "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func connect(t *testing.T, url string) *websocket.Conn {
+	t.Helper()
+	ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ws.Close() })
+	if msg := next(t, ws); msg.Type != msgWelcome || msg.Version != 1 {
+		t.Fatalf("first message = %+v", msg)
+	}
+	return ws
+}
+
+// 在席情報以外の次のメッセージ
+func next(t *testing.T, ws *websocket.Conn) outMessage {
+	t.Helper()
+	for {
+		_ = ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var msg outMessage
+		if err := ws.ReadJSON(&msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Type != msgPresence {
+			return msg
+		}
+	}
+}
+
+// 他の経路での更新はversionとして通知し、このHubで適用した操作は重ねて通知しない
+func TestHubRelaysOtherUpdates(t *testing.T) {
+	store, url := newTestHub(t)
+	a, b := connect(t, url), connect(t, url)
+
+	// PUT /minkan 等での更新
+	if _, err := store.Replace(context.Background(), 1, json.RawMessage(testState), 1, "tab-1"); err != nil {
+		t.Fatal(err)
+	}
+	for _, ws := range []*websocket.Conn{a, b} {
+		if msg := next(t, ws); msg.Type != msgVersion || msg.Version != 2 || msg.Origin != "tab-1" {
+			t.Errorf("after PUT = %+v", msg)
+		}
+	}
+
+	// 接続からの操作: 送信元にはack、他の接続にはop
+	label := "renamed"
+	op := inMessage{Type: msgOp, OpID: "op-1", Op: &minkan.Op{Type: minkan.OpNodeRename, PjID: "pj1", NodeID: "n1", Label: &label}}
+	if err := a.WriteJSON(op); err != nil {
+		t.Fatal(err)
+	}
+	if msg := next(t, a); msg.Type != msgAck || msg.OpID != "op-1" || msg.Version != 3 {
+		t.Errorf("sender got %+v", msg)
+	}
+	if msg := next(t, b); msg.Type != msgOp || msg.Version != 3 {
+		t.Errorf("other connection got %+v", msg)
+	}
+
+	// 次のメッセージはversion 3の通知ではなく、その後の更新
+	if _, err := store.Mutate(context.Background(), 1, "scheduler:recurrence", func(*repository.Minkan) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	for _, ws := range []*websocket.Conn{a, b} {
+		if msg := next(t, ws); msg.Type != msgVersion || msg.Version != 4 || msg.Origin != "scheduler:recurrence" {
+			t.Errorf("after scheduler update = %+v", msg)
+		}
+	}
+}
+
+// ctxの終了（セッションの削除）で接続を閉じる
+func TestServeClosesOnContextEnd(t *testing.T) {
+	repo := fakestate.New()
+	repo.Put(1, json.RawMessage(testState))
+	hub := NewHub(minkan.NewStore(repo, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		served <- hub.Serve(ctx, ws, 1, ClientInfo{})
+	}))
+	defer srv.Close()
+
+	ws := connect(t, "ws"+strings.TrimPrefix(srv.URL, "http"))
+	cancel()
+
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("Serve = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after the context ended")
+	}
+
+	for {
+		_ = ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, _, err := ws.ReadMessage(); err != nil {
+			if !websocket.IsCloseError(err, websocket.ClosePolicyViolation) {
+				t.Errorf("read after close = %v", err)
+			}
+			break
+		}
+	}
+}
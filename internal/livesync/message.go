@@ -0,0 +1,50 @@
+package livesync
+
+import (
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+)
+
+// WebSocketで送受信するメッセージ種別
+const (
+	// クライアント → サーバ
+	msgOp       = "op"       // 操作の送信
+	msgPresence = "presence" // 閲覧中プロジェクトの通知（サーバ→クライアントでは在席一覧）
+
+	// サーバ → クライアント
+	msgWelcome = "welcome" // 接続直後の初期情報
+	msgAck     = "ack"     // 自分の操作が適用された
+	msgReject  = "reject"  // 自分の操作が適用できなかった
+	msgError   = "error"   // メッセージ形式の誤りなど
+	msgVersion = "version" // 他の経路（PUT /minkan・CalDAV等）でstateが更新された（GET /minkanで再取得する）
+)
+
+// クライアントから受信するメッセージ
+type inMessage struct {
+	Type      string     `json:"type"`
+	OpID      string     `json:"opId,omitempty"`      // op: クライアント採番の操作ID（ack/rejectで返す）
+	Op        *minkan.Op `json:"op,omitempty"`        // op
+	ProjectID string     `json:"projectId,omitempty"` // presence
+}
+
+// クライアントへ送信するメッセージ
+type outMessage struct {
+	Type         string     `json:"type"`
+	ConnectionID string     `json:"connectionId,omitempty"` // welcome: 自分の接続ID, op: 操作元の接続ID
+	OpID         string     `json:"opId,omitempty"`
+	Op           *minkan.Op `json:"op,omitempty"`
+	Version      int32      `json:"version,omitempty"`
+	Origin       string     `json:"origin,omitempty"` // version: 更新元（X-Minkan-Origin等）
+	Presence     []Presence `json:"presence,omitempty"`
+	Error        string     `json:"error,omitempty"`
+}
+
+// Presence は接続中の端末1つ分の在席情報
+type Presence struct {
+	ConnectionID string    `json:"connectionId"`
+	DeviceID     string    `json:"deviceId"`
+	DeviceName   string    `json:"deviceName"`
+	ProjectID    string    `json:"projectId"` // 閲覧中プロジェクト（未通知の場合は空文字）
+	ConnectedAt  time.Time `json:"connectedAt"`
+}
@@ -0,0 +1,102 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Message は送信するメール（本文はテキストのみ）
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+
+	// ListUnsubscribe 配信停止URL（List-Unsubscribeヘッダ。RFC 8058のワンクリック配信停止に対応）
+	ListUnsubscribe string
+}
+
+// Sender はメール送信の抽象
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// LogSender は送信せずにログへ出力する（SMTP未設定の開発環境用）
+type LogSender struct{}
+
+func (LogSender) Send(_ context.Context, msg *Message) error {
+	slog.Default().With("module", "mail").Info("mail not sent (SMTP is not configured)",
+		"to", msg.To,
+		"subject", msg.Subject,
+	)
+	return nil
+}
+
+// メッセージをRFC 5322形式に組み立てる
+func build(from string, msg *Message, now time.Time) ([]byte, error) {
+	fromAddr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+	toAddr, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
+
+	id, err := messageID(fromAddr.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	header := func(k, v string) {
+		b.WriteString(k + ": " + v + "\r\n")
+	}
+
+	header("From", fromAddr.String())
+	header("To", toAddr.String())
+	header("Subject", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", id)
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "base64")
+	header("Auto-Submitted", "auto-generated")
+	if msg.ListUnsubscribe != "" {
+		header("List-Unsubscribe", "<"+msg.ListUnsubscribe+">")
+		header("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	b.WriteString("\r\n")
+
+	// 本文はbase64で76文字ごとに改行
+	body := strings.ReplaceAll(msg.Body, "\n", "\r\n")
+	encoded := base64.StdEncoding.EncodeToString([]byte(body))
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded + "\r\n")
+
+	return b.Bytes(), nil
+}
+
+func messageID(from string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	domain := "localhost"
+	if _, d, ok := strings.Cut(from, "@"); ok && d != "" {
+		domain = d
+	}
+	return "<" + hex.EncodeToString(buf) + "@" + domain + ">", nil
+}
@@ -0,0 +1,81 @@
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuild(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	msg := &Message{
+		To:              "Taro <taro@example.com>",
+		Subject:         "【Minkan】まもなく期限",
+		Body:            "1行目\n" + strings.Repeat("あ", 60) + "\n",
+		ListUnsubscribe: "https://api.example.com/v1/notifications/unsubscribe?token=x",
+	}
+
+	data, err := build("Minkan <noreply@example.com>", msg, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := parsed.Header
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(h.Get("Subject"))
+	if err != nil || subject != msg.Subject {
+		t.Errorf("subject = %q, %v", subject, err)
+	}
+	if got := h.Get("List-Unsubscribe"); got != "<"+msg.ListUnsubscribe+">" {
+		t.Errorf("List-Unsubscribe = %q", got)
+	}
+	if got := h.Get("List-Unsubscribe-Post"); got != "List-Unsubscribe=One-Click" {
+		t.Errorf("List-Unsubscribe-Post = %q", got)
+	}
+	if id := h.Get("Message-ID"); !strings.HasSuffix(id, "@example.com>") {
+		t.Errorf("Message-ID = %q", id)
+	}
+	if date, err := h.Date(); err != nil || !date.Equal(now) {
+		t.Errorf("Date = %v, %v", date, err)
+	}
+
+	raw, err := io.ReadAll(parsed.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimRight(string(raw), "\r\n"), "\r\n") {
+		if len(line) > 76 {
+			t.Errorf("body line longer than 76 characters: %q", line)
+		}
+	}
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(raw), "\r\n", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.ReplaceAll(msg.Body, "\n", "\r\n"); string(body) != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestBuildWithoutUnsubscribe(t *testing.T) {
+	data, err := build("noreply@example.com", &Message{To: "taro@example.com", Subject: "s", Body: "b"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("List-Unsubscribe")) {
+		t.Errorf("List-Unsubscribe header without URL:\n%s", data)
+	}
+
+	if _, err := build("noreply@example.com", &Message{To: "not an address"}, time.Now()); err == nil {
+		t.Error("invalid recipient accepted")
+	}
+}
@@ -0,0 +1,132 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// SMTPの接続時の暗号化方式
+const (
+	TLSNone     = "none"     // 平文（MailHog・Mailpit等のローカル検証用）
+	TLSStartTLS = "starttls" // 平文で接続後にSTARTTLS（587番ポート等）
+	TLSImplicit = "tls"      // 接続時からTLS（465番ポート等）
+)
+
+// 1通の送信にかける最大時間
+const sendTimeout = 30 * time.Second
+
+// SMTPSender はSMTPサーバ経由でメールを送信する
+type SMTPSender struct {
+	Host     string
+	Port     string
+	Username string // 空の場合は認証しない
+	Password string
+	From     string // 差出人（"Minkan <noreply@example.com>" 形式も可）
+	TLS      string // TLSNone / TLSStartTLS / TLSImplicit
+}
+
+func NewSMTPSender(host, port, username, password, from, tlsMode string) (*SMTPSender, error) {
+	switch tlsMode {
+	case TLSNone, TLSStartTLS, TLSImplicit:
+	default:
+		return nil, fmt.Errorf("invalid SMTP TLS mode %q", tlsMode)
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid SMTP from address: %w", err)
+	}
+
+	return &SMTPSender{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		From:     from,
+		TLS:      tlsMode,
+	}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	data, err := build(s.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	fromAddr, _ := mail.ParseAddress(s.From)
+	toAddr, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	// net/smtpはcontextに対応していないため、期限をコネクションに設定する
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+
+	c, err := smtp.NewClient(conn, s.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() { _ = c.Close() }()
+
+	if s.TLS == TLSStartTLS {
+		if err := c.StartTLS(&tls.Config{ServerName: s.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+
+	if s.Username != "" {
+		// PlainAuthはTLS無しの場合localhost以外への送信を拒否する
+		if err := c.Auth(smtp.PlainAuth("", s.Username, s.Password, s.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(fromAddr.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(toAddr.Address); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		_ = w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+func (s *SMTPSender) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(s.Host, s.Port)
+
+	if s.TLS == TLSImplicit {
+		d := &tls.Dialer{Config: &tls.Config{ServerName: s.Host, MinVersion: tls.VersionTLS12}}
+		return d.DialContext(ctx, "tcp", addr)
+	}
+
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", addr)
+}
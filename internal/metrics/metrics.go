@@ -0,0 +1,87 @@
+// Package metrics はPrometheusのテキスト形式で公開する最小限のメトリクス
+// 使う種類が少ないためクライアントライブラリは使わず、ゲージとカウンタのみを持つ
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Gauge は増減する現在値（アクティブなセッション数等）
+type Gauge struct {
+	v atomic.Int64
+}
+
+func (g *Gauge) Set(v int64) { g.v.Store(v) }
+
+func (g *Gauge) Value() int64 { return g.v.Load() }
+
+// Counter は単調増加する累計値（削除したセッション数等）
+type Counter struct {
+	v atomic.Int64
+}
+
+func (c *Counter) Add(n int64) { c.v.Add(n) }
+
+func (c *Counter) Value() int64 { return c.v.Load() }
+
+type metric struct {
+	name  string
+	help  string
+	kind  string // gauge / counter
+	value func() int64
+}
+
+// Registry はメトリクスを登録順に保持し、GET /metrics に応答する
+type Registry struct {
+	mu      sync.Mutex
+	metrics []metric
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// ゲージを作成して登録する（nameは minkan_ で始まるsnake_case）
+func (r *Registry) Gauge(name, help string) *Gauge {
+	g := &Gauge{}
+	r.register(metric{name: name, help: help, kind: "gauge", value: g.Value})
+	return g
+}
+
+// カウンタを作成して登録する（nameは _total で終える）
+func (r *Registry) Counter(name, help string) *Counter {
+	c := &Counter{}
+	r.register(metric{name: name, help: help, kind: "counter", value: c.Value})
+	return c
+}
+
+func (r *Registry) register(m metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.metrics = append(r.metrics, m)
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.mu.Lock()
+	metrics := append([]metric(nil), r.metrics...)
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if req.Method == http.MethodHead {
+		return
+	}
+
+	for _, m := range metrics {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %d\n", m.name, m.help, m.name, m.kind, m.name, m.value())
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
 	"github.com/yopi416/mind-kanban-backend/internal/session"
 )
 
 type ctxKey string
 
-const ctxKeyUserID ctxKey = "userID"
+const (
+	ctxKeyUserID      ctxKey = "userID"
+	ctxKeyAccessToken ctxKey = "accessToken"
+)
 
 // ミドルウェア内では使用しないが、他関数から呼び出すユーティリティ関数
 func GetUserIDFromContext(ctx context.Context) (int64, bool) {
@@ -23,10 +28,32 @@ func GetUserIDFromContext(ctx context.Context) (int64, bool) {
 	return id, true
 }
 
+// パーソナルアクセストークンで認証したリクエストか
+// トークンの発行等、ブラウザのログインでのみ許可する操作の判定に使う
+func IsAccessTokenRequest(ctx context.Context) bool {
+	_, ok := ctx.Value(ctxKeyAccessToken).(bool)
+	return ok
+}
+
+// 参照のみのパーソナルアクセストークンで認証したリクエストか
+// GETで更新を受け付ける処理（WebSocket等）の判定に使う
+func IsReadOnlyAccessToken(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(ctxKeyAccessToken).(bool)
+	return readOnly
+}
+
+// BearerAuthenticator は Authorization: Bearer のトークンを照合する
+type BearerAuthenticator interface {
+	// ユーザーIDと参照のみの権限かを返す（不正・期限切れの場合はok=false）
+	AuthenticateBearer(ctx context.Context, token string) (userID int64, readOnly bool, ok bool, err error)
+}
+
 type RequireLoginOptions struct {
 	SessionManager   *session.SessionManager                      // 既存の SessionManager を直接利用
+	Cookies          cookies.Policy                               // セッション・CSRFトークンのCookieの名前と属性
 	SkipPaths        []string                                     // ログイン検証を行わないパス
 	RequireCSRFToken bool                                         // CSRF検証を行うかどうか
+	Bearer           BearerAuthenticator                          // パーソナルアクセストークンの照合（nilの場合はCookieのみ）
 	OnUnauthorized   func(w http.ResponseWriter, r *http.Request) // ログイン検証失敗時の処理
 }
 
@@ -56,43 +83,72 @@ func RequireLogin(next http.Handler, opt RequireLoginOptions) http.Handler {
 			}
 		}
 
-		// Cookieからsession_idを取得
-		sessCookie, err := r.Cookie("session_id")
+		// Authorizationヘッダがある場合はトークンで認証する（Cookieは見ない）
+		// ブラウザが自動で送るものではないため、CSRF検証は行わない
+		if authz := r.Header.Get("Authorization"); authz != "" && opt.Bearer != nil {
+			tok, found := strings.CutPrefix(authz, "Bearer ")
+			if !found || tok == "" {
+				lg.Warn("unsupported authorization scheme")
+				opt.OnUnauthorized(w, r)
+				return
+			}
+
+			userID, readOnly, ok, err := opt.Bearer.AuthenticateBearer(r.Context(), strings.TrimSpace(tok))
+			if err != nil {
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				lg.Error("access token lookup failed", "err", err)
+				return
+			}
+			if !ok || userID == 0 {
+				lg.Warn("invalid or expired access token")
+				opt.OnUnauthorized(w, r)
+				return
+			}
+
+			if readOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+				http.Error(w, "insufficient scope", http.StatusForbidden)
+				lg.Warn("read-only access token used for write", "method", r.Method)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), ctxKeyUserID, userID)
+			ctx = context.WithValue(ctx, ctxKeyAccessToken, readOnly)
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		// CookieからセッションIDを取得
+		sessID := opt.Cookies.SessionID(r)
 
-		if err != nil || sessCookie == nil || sessCookie.Value == "" {
+		if sessID == "" {
 			lg.Warn("no session cookie")
 			opt.OnUnauthorized(w, r)
 			return
 		}
 
-		sessID := sessCookie.Value
-
-		// GetSessionにて検証 & UserIDを取得
-		userID, ok := opt.SessionManager.GetSession(sessID)
-		if !ok || userID == 0 {
+		// ValidateSessionにて検証 & UserIDを取得
+		sess, renewed := opt.SessionManager.ValidateSession(r.Context(), sessID)
+		if sess == nil || sess.UserID == 0 {
 			lg.Warn("invalid or expired session")
 			opt.OnUnauthorized(w, r)
 			return
 		}
+		userID := sess.UserID
 
 		// CSRFトークンの検証
 		// - Get, Head, OPTIONSは検証しない
-		// - ダブルサブミット方式で検証
+		// - ヘッダのトークンがセッションIDに紐づく（セッションIDのHMAC）ものか検証する
 		if opt.RequireCSRFToken && r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodOptions {
-			csrfHeader := r.Header.Get("X-CSRF-Token")
-
-			csrfCookie, err := r.Cookie("csrf_token")
-			csrfToken := ""
-			if err == nil {
-				csrfToken = csrfCookie.Value
-			}
-
-			if csrfHeader == "" || csrfToken == "" || csrfHeader != csrfToken {
+			if !opt.SessionManager.VerifyCSRF(sessID, r.Header.Get("X-CSRF-Token")) {
 				http.Error(w, "csrf invalid", http.StatusForbidden)
 				lg.Warn("csrf check failed")
 				return
 			}
+		}
 
+		// 有効期限を延長した場合はCookieのMaxAgeも合わせて延長する
+		if renewed {
+			opt.Cookies.SetSession(w, sessID, opt.SessionManager.CSRFToken(sessID), time.Until(sess.ExpiresAt))
 		}
 
 		// contextに取得したUserIDを保存し、次の処理を実行
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/accesstoken"
+	"github.com/yopi416/mind-kanban-backend/internal/cookies"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/session"
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+// fakeAccessTokens はテスト用のaccess_tokens（トークンのハッシュで引く。削除したトークンは無効）
+type fakeAccessTokens struct {
+	byHash map[string]*repository.AccessToken
+}
+
+func (f *fakeAccessTokens) add(t *testing.T, userID int64, scope string, expiresAt *time.Time) string {
+	t.Helper()
+	tok, err := token.New(accesstoken.TokenPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.byHash[string(token.Hash(tok))] = &repository.AccessToken{ID: int64(len(f.byHash) + 1), UserID: userID, Scope: scope, ExpiresAt: expiresAt}
+	return tok
+}
+
+func (f *fakeAccessTokens) revoke(tok string) {
+	delete(f.byHash, string(token.Hash(tok)))
+}
+
+func (f *fakeAccessTokens) FindAccessTokenByHash(_ context.Context, tokenHash []byte) (*repository.AccessToken, error) {
+	return f.byHash[string(tokenHash)], nil
+}
+
+func (f *fakeAccessTokens) TouchAccessToken(context.Context, int64, time.Time, time.Duration) error {
+	return nil
+}
+
+// 認証後のcontextの内容を記録するハンドラ
+type recorded struct {
+	called   bool
+	userID   int64
+	token    bool
+	readOnly bool
+}
+
+func newTestLogin(t *testing.T) (http.Handler, *fakeAccessTokens, *session.SessionManager, cookies.Policy, *recorded) {
+	t.Helper()
+	tokens := &fakeAccessTokens{byHash: map[string]*repository.AccessToken{}}
+	sm := session.NewSessionManager(session.NewMemoryStore(), time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	policy := cookies.Policy{SessionName: "session_id", CSRFName: "csrf_token", Path: "/", SameSite: http.SameSiteLaxMode}
+
+	rec := &recorded{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.called = true
+		rec.userID, _ = GetUserIDFromContext(r.Context())
+		rec.token = IsAccessTokenRequest(r.Context())
+		rec.readOnly = IsReadOnlyAccessToken(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+	h := RequireLogin(next, RequireLoginOptions{
+		SessionManager:   sm,
+		Cookies:          policy,
+		RequireCSRFToken: true,
+		Bearer:           accesstoken.NewAuthenticator(tokens),
+	})
+	return h, tokens, sm, policy, rec
+}
+
+func serve(h http.Handler, method string, setup func(r *http.Request)) int {
+	r := httptest.NewRequest(method, "/v1/minkan", nil)
+	if setup != nil {
+		setup(r)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func bearer(tok string) func(r *http.Request) {
+	return func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+tok) }
+}
+
+func TestRequireLoginAccessTokenScope(t *testing.T) {
+	h, tokens, _, _, rec := newTestLogin(t)
+	read := tokens.add(t, 1, accesstoken.ScopeRead, nil)
+	write := tokens.add(t, 2, accesstoken.ScopeWrite, nil)
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		*rec = recorded{}
+		if code := serve(h, method, bearer(read)); code != http.StatusNoContent {
+			t.Errorf("read token %s = %d", method, code)
+		}
+		if rec.userID != 1 || !rec.token || !rec.readOnly {
+			t.Errorf("read token %s context = %+v", method, rec)
+		}
+	}
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		*rec = recorded{}
+		if code := serve(h, method, bearer(read)); code != http.StatusForbidden || rec.called {
+			t.Errorf("read token %s = %d (handler called %v), want 403", method, code, rec.called)
+		}
+
+		// 書き込み権限のトークンはCSRFトークン無しで更新できる（ブラウザが自動で送るものではないため）
+		*rec = recorded{}
+		if code := serve(h, method, bearer(write)); code != http.StatusNoContent {
+			t.Errorf("write token %s = %d", method, code)
+		}
+		if rec.userID != 2 || !rec.token || rec.readOnly {
+			t.Errorf("write token %s context = %+v", method, rec)
+		}
+	}
+}
+
+func TestRequireLoginRejectsInvalidAccessTokens(t *testing.T) {
+	h, tokens, _, _, rec := newTestLogin(t)
+	past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+	expired := tokens.add(t, 1, accesstoken.ScopeWrite, &past)
+	valid := tokens.add(t, 1, accesstoken.ScopeWrite, &future)
+	revoked := tokens.add(t, 1, accesstoken.ScopeWrite, nil)
+	tokens.revoke(revoked)
+
+	if code := serve(h, http.MethodGet, bearer(valid)); code != http.StatusNoContent {
+		t.Fatalf("valid token = %d", code)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(r *http.Request)
+	}{
+		{"expired", bearer(expired)},
+		{"revoked", bearer(revoked)},
+		{"unknown", bearer(accesstoken.TokenPrefix + "_unknown")},
+		{"other prefix", bearer("other_" + valid)},
+		{"empty bearer", func(r *http.Request) { r.Header.Set("Authorization", "Bearer ") }},
+		{"basic auth", func(r *http.Request) { r.SetBasicAuth("user", valid) }},
+	}
+	for _, tt := range tests {
+		*rec = recorded{}
+		if code := serve(h, http.MethodGet, tt.setup); code != http.StatusUnauthorized || rec.called {
+			t.Errorf("%s: %d (handler called %v), want 401", tt.name, code, rec.called)
+		}
+	}
+}
+
+// Cookieのセッションでの更新はCSRFトークンが必要
+func TestRequireLoginCookieCSRF(t *testing.T) {
+	h, _, sm, policy, rec := newTestLogin(t)
+	sessID, err := sm.CreateSession(context.Background(), 3, session.Meta{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	withCookie := func(csrf string) func(r *http.Request) {
+		return func(r *http.Request) {
+			r.AddCookie(&http.Cookie{Name: policy.SessionName, Value: sessID})
+			if csrf != "" {
+				r.Header.Set("X-CSRF-Token", csrf)
+			}
+		}
+	}
+
+	if code := serve(h, http.MethodGet, withCookie("")); code != http.StatusNoContent || rec.userID != 3 || rec.token {
+		t.Errorf("cookie GET = %d, %+v", code, rec)
+	}
+	for _, csrf := range []string{"", "forged"} {
+		*rec = recorded{}
+		if code := serve(h, http.MethodPost, withCookie(csrf)); code != http.StatusForbidden || rec.called {
+			t.Errorf("cookie POST with csrf %q = %d (handler called %v), want 403", csrf, code, rec.called)
+		}
+	}
+	if code := serve(h, http.MethodPost, withCookie(sm.CSRFToken(sessID))); code != http.StatusNoContent {
+		t.Errorf("cookie POST with csrf = %d", code)
+	}
+
+	if code := serve(h, http.MethodGet, nil); code != http.StatusUnauthorized {
+		t.Errorf("no credentials = %d", code)
+	}
+}
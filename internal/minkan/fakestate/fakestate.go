@@ -0,0 +1,78 @@
+// Package fakestate はminkan.Storeを使う処理の動作確認用のminkan_states
+// ユーザーごとのstateをプロセス内のmapに保持し、versionによる楽観ロックのみ再現する
+package fakestate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// ErrUnavailable は FailUpdates で失敗させた更新のエラー
+var ErrUnavailable = errors.New("fakestate: database unavailable")
+
+// Repo はテスト用のminkan_states（minkan.StateRepository）
+type Repo struct {
+	mu          sync.Mutex
+	states      map[int64]*repository.MinkanState
+	failUserID  int64
+	failUpdates int
+}
+
+func New() *Repo {
+	return &Repo{states: make(map[int64]*repository.MinkanState)}
+}
+
+// Put はuserIDのstateをversion 1で登録する（既存のstateは置き換える）
+func (r *Repo) Put(userID int64, stateJSON json.RawMessage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[userID] = &repository.MinkanState{UserID: userID, StateJSON: stateJSON, Version: 1}
+}
+
+// State はuserIDの保存中のstate（無い場合はnil）
+func (r *Repo) State(userID int64) json.RawMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if st, ok := r.states[userID]; ok {
+		return st.StateJSON
+	}
+	return nil
+}
+
+// FailUpdates はuserIDの更新をn回だけ ErrUnavailable で失敗させる
+func (r *Repo) FailUpdates(userID int64, n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failUserID, r.failUpdates = userID, n
+}
+
+func (r *Repo) FindStateByUserID(_ context.Context, userID int64) (*repository.MinkanState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	st, ok := r.states[userID]
+	if !ok {
+		return nil, nil
+	}
+	s := *st
+	return &s, nil
+}
+
+func (r *Repo) UpdateStateByUserID(_ context.Context, newStateJSON json.RawMessage, userID int64, version int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if userID == r.failUserID && r.failUpdates > 0 {
+		r.failUpdates--
+		return ErrUnavailable
+	}
+	st, ok := r.states[userID]
+	if !ok || st.Version != version {
+		return repository.ErrOptimisticLock
+	}
+	st.StateJSON = newStateJSON
+	st.Version++
+	return nil
+}
@@ -0,0 +1,328 @@
+package minkan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// ErrInvalidOp はクライアントから受け取った操作が現在のstateに適用できない場合のエラー
+var ErrInvalidOp = errors.New("invalid operation")
+
+func invalidOp(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidOp, fmt.Sprintf(format, args...))
+}
+
+type OpType string
+
+const (
+	OpNodeAdd    OpType = "node.add"    // ノード追加
+	OpNodeMove   OpType = "node.move"   // 親ノードの付け替え・位置変更
+	OpNodeRename OpType = "node.rename" // ラベル変更
+	OpCardMove   OpType = "card.move"   // カンバンのカラム移動（追加・除外を含む）
+)
+
+// カンバンのカラム名（KanbanColumnsのJSONキーと共通）
+const (
+	ColumnBacklog = "backlog"
+	ColumnTodo    = "todo"
+	ColumnDoing   = "doing"
+	ColumnDone    = "done"
+
+	// card.moveでカンバンから外す場合の指定
+	ColumnNone = ""
+)
+
+// カラムの並び順
+var Columns = []string{ColumnBacklog, ColumnTodo, ColumnDoing, ColumnDone}
+
+const (
+	// ルートノードID（フロントと共通）
+	RootNodeID = "root"
+
+	// デフォルトのノード・エッジタイプ
+	defaultNodeType = "custom"
+	defaultEdgeType = "default"
+)
+
+type Position struct {
+	X float32 `json:"x"`
+	Y float32 `json:"y"`
+}
+
+// Op はライブ同期で送受信するきめ細かい操作
+// - 各フィールドの要否はTypeごとに異なる（Validateで確認）
+type Op struct {
+	Type     OpType    `json:"type"`
+	PjID     string    `json:"pjId"`
+	NodeID   string    `json:"nodeId"`
+	ParentID string    `json:"parentId,omitempty"` // node.add, node.move
+	Label    *string   `json:"label,omitempty"`    // node.add, node.rename
+	Position *Position `json:"position,omitempty"` // node.add, node.move
+	Column   string    `json:"column,omitempty"`   // card.move（空文字はカンバンから外す）
+	Index    *int      `json:"index,omitempty"`    // card.move（nilは末尾）
+}
+
+// stateに依存しない形式チェック
+func (op *Op) Validate() error {
+	if op.PjID == "" || op.NodeID == "" {
+		return invalidOp("pjId and nodeId are required")
+	}
+
+	switch op.Type {
+	case OpNodeAdd:
+		if op.ParentID == "" {
+			return invalidOp("parentId is required")
+		}
+		if op.Label == nil {
+			return invalidOp("label is required")
+		}
+	case OpNodeMove:
+		if op.ParentID == "" && op.Position == nil {
+			return invalidOp("parentId or position is required")
+		}
+	case OpNodeRename:
+		if op.Label == nil {
+			return invalidOp("label is required")
+		}
+	case OpCardMove:
+		if op.Column != ColumnNone && !IsColumn(op.Column) {
+			return invalidOp("unknown column %q", op.Column)
+		}
+		if op.Index != nil && *op.Index < 0 {
+			return invalidOp("index must not be negative")
+		}
+	default:
+		return invalidOp("unknown op type %q", op.Type)
+	}
+
+	return nil
+}
+
+// 操作をstateに適用する
+// - 適用できない場合はErrInvalidOpをラップしたエラーを返し、mは変更しない
+func Apply(m *repository.Minkan, op *Op) error {
+	if err := op.Validate(); err != nil {
+		return err
+	}
+
+	pj, ok := m.Projects[op.PjID]
+	if !ok {
+		return invalidOp("project %q not found", op.PjID)
+	}
+
+	switch op.Type {
+	case OpNodeAdd:
+		if FindNode(&pj, op.NodeID) >= 0 {
+			return invalidOp("node %q already exists", op.NodeID)
+		}
+		if FindNode(&pj, op.ParentID) < 0 {
+			return invalidOp("parent node %q not found", op.ParentID)
+		}
+
+		node := NewNode(op.NodeID, op.ParentID, *op.Label)
+		if op.Position != nil {
+			node.Position.X = op.Position.X
+			node.Position.Y = op.Position.Y
+		}
+
+		pj.Nodes = append(pj.Nodes, node)
+		pj.Edges = append(pj.Edges, NewEdge(op.ParentID, op.NodeID))
+
+	case OpNodeMove:
+		idx := FindNode(&pj, op.NodeID)
+		if idx < 0 {
+			return invalidOp("node %q not found", op.NodeID)
+		}
+
+		if op.ParentID != "" {
+			if op.NodeID == RootNodeID {
+				return invalidOp("root node cannot be moved")
+			}
+			if FindNode(&pj, op.ParentID) < 0 {
+				return invalidOp("parent node %q not found", op.ParentID)
+			}
+			// 自分自身や子孫の下には付け替えられない
+			if IsDescendant(&pj, op.ParentID, op.NodeID) {
+				return invalidOp("node %q cannot be moved under its descendant", op.NodeID)
+			}
+
+			parentID := op.ParentID
+			pj.Nodes[idx].Data.ParentId = &parentID
+
+			// 親子エッジを張り替え
+			edges := pj.Edges[:0]
+			for _, e := range pj.Edges {
+				if e.Target != op.NodeID {
+					edges = append(edges, e)
+				}
+			}
+			pj.Edges = append(edges, NewEdge(parentID, op.NodeID))
+		}
+
+		if op.Position != nil {
+			pj.Nodes[idx].Position.X = op.Position.X
+			pj.Nodes[idx].Position.Y = op.Position.Y
+		}
+
+	case OpNodeRename:
+		idx := FindNode(&pj, op.NodeID)
+		if idx < 0 {
+			return invalidOp("node %q not found", op.NodeID)
+		}
+		pj.Nodes[idx].Data.Label = *op.Label
+
+	case OpCardMove:
+		if FindNode(&pj, op.NodeID) < 0 {
+			return invalidOp("node %q not found", op.NodeID)
+		}
+		MoveCard(m, op.PjID, op.NodeID, op.Column, op.Index)
+	}
+
+	pj.UpdatedAt = time.Now().UTC()
+	m.Projects[op.PjID] = pj
+
+	return nil
+}
+
+// ノードのスライス上の位置を返す（見つからない場合は-1）
+func FindNode(pj *repository.Project, nodeID string) int {
+	for i := range pj.Nodes {
+		if pj.Nodes[i].Id == nodeID {
+			return i
+		}
+	}
+	return -1
+}
+
+// nodeIDがancestorIDと同一、またはその子孫であるかを判定
+func IsDescendant(pj *repository.Project, nodeID, ancestorID string) bool {
+	parents := make(map[string]string, len(pj.Nodes))
+	for _, n := range pj.Nodes {
+		if n.Data.ParentId != nil {
+			parents[n.Id] = *n.Data.ParentId
+		}
+	}
+
+	// 循環したデータでも無限ループしないよう、辿る回数をノード数で打ち切る
+	cur := nodeID
+	for i := 0; i <= len(pj.Nodes); i++ {
+		if cur == ancestorID {
+			return true
+		}
+		parent, ok := parents[cur]
+		if !ok {
+			return false
+		}
+		cur = parent
+	}
+	return false
+}
+
+func IsColumn(name string) bool {
+	for _, c := range Columns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// カラム名に対応するカード配列を返す
+func ColumnCards(cols *repository.KanbanColumns, name string) *[]repository.KanbanCardRef {
+	switch name {
+	case ColumnBacklog:
+		return &cols.Backlog
+	case ColumnTodo:
+		return &cols.Todo
+	case ColumnDoing:
+		return &cols.Doing
+	case ColumnDone:
+		return &cols.Done
+	}
+	return nil
+}
+
+// カードが現在置かれているカラム名を返す（カンバンに無い場合はColumnNone）
+func FindCardColumn(cols *repository.KanbanColumns, pjID, nodeID string) string {
+	for _, name := range Columns {
+		for _, ref := range *ColumnCards(cols, name) {
+			if ref.PjId == pjID && ref.NodeId == nodeID {
+				return name
+			}
+		}
+	}
+	return ColumnNone
+}
+
+// カードを指定カラムのindex位置へ移動する
+// - columnがColumnNoneの場合はカンバンから外す
+// - indexがnil、または範囲外の場合は末尾に追加
+// - kanbanIndexも合わせて更新する
+func MoveCard(m *repository.Minkan, pjID, nodeID, column string, index *int) {
+	ref := repository.KanbanCardRef{PjId: pjID, NodeId: nodeID}
+
+	// 全カラムから一旦取り除く
+	for _, name := range Columns {
+		cards := ColumnCards(&m.KanbanColumns, name)
+		kept := (*cards)[:0]
+		for _, c := range *cards {
+			if c != ref {
+				kept = append(kept, c)
+			}
+		}
+		*cards = kept
+	}
+
+	ids := m.KanbanIndex[pjID]
+	kept := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != nodeID {
+			kept = append(kept, id)
+		}
+	}
+
+	if column != ColumnNone {
+		cards := ColumnCards(&m.KanbanColumns, column)
+		if index == nil || *index >= len(*cards) {
+			*cards = append(*cards, ref)
+		} else {
+			*cards = append((*cards)[:*index], append([]repository.KanbanCardRef{ref}, (*cards)[*index:]...)...)
+		}
+		kept = append(kept, nodeID)
+	}
+
+	if m.KanbanIndex == nil {
+		m.KanbanIndex = repository.KanbanIndex{}
+	}
+	m.KanbanIndex[pjID] = kept
+}
+
+// 子ノードを作成する（parentIDが空の場合はルートノード扱い）
+func NewNode(nodeID, parentID, label string) repository.Node {
+	node := repository.Node{
+		Id:   nodeID,
+		Type: defaultNodeType,
+		Data: repository.NodeData{
+			Label:    label,
+			IsDone:   false,
+			Comments: []repository.NodeComment{},
+		},
+	}
+	if parentID != "" {
+		node.Data.ParentId = &parentID
+	}
+	return node
+}
+
+// 親子ノードをつなぐエッジを作成する
+func NewEdge(source, target string) repository.Edge {
+	return repository.Edge{
+		Id:     "e-" + source + "-" + target,
+		Source: source,
+		Target: target,
+		Type:   defaultEdgeType,
+	}
+}
@@ -0,0 +1,233 @@
+package minkan
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/rrule"
+)
+
+// ErrInvalidSchedule はノードの期限・開始日時・優先度が不正な場合のエラー
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// 優先度の範囲（1が最優先）
+const (
+	PriorityHighest = 1
+	PriorityLowest  = 5
+)
+
+// リマインダーの制限（dueAtの何分前か）
+const (
+	MaxReminders       = 5
+	MaxReminderMinutes = 30 * 24 * 60
+)
+
+// 繰り返しの動作
+const (
+	RecurrenceClone = "clone" // 次回分を兄弟ノードとして作成し、繰り返し設定を引き継ぐ
+	RecurrenceReset = "reset" // 同じノードの期限を次回に進め、未完了に戻してtodoへ移動
+)
+
+// ノードの期限・開始日時・優先度・繰り返し設定を検証する
+func validateNodeSchedule(n repository.Node) error {
+	d := n.Data
+	if d.Priority != nil && (*d.Priority < PriorityHighest || *d.Priority > PriorityLowest) {
+		return fmt.Errorf("%w: node %q priority must be between %d and %d", ErrInvalidSchedule, n.Id, PriorityHighest, PriorityLowest)
+	}
+	if d.StartAt != nil && d.DueAt != nil && d.StartAt.After(*d.DueAt) {
+		return fmt.Errorf("%w: node %q startAt is after dueAt", ErrInvalidSchedule, n.Id)
+	}
+	if len(d.Reminders) > 0 {
+		if err := validateReminders(n); err != nil {
+			return fmt.Errorf("%w: node %q %s", ErrInvalidSchedule, n.Id, err)
+		}
+	}
+	if d.Recurrence != nil {
+		if err := validateRecurrence(n); err != nil {
+			return fmt.Errorf("%w: node %q %s", ErrInvalidSchedule, n.Id, err)
+		}
+	}
+	return nil
+}
+
+func validateReminders(n repository.Node) error {
+	if n.Data.DueAt == nil {
+		return errors.New("reminders require dueAt")
+	}
+	if len(n.Data.Reminders) > MaxReminders {
+		return fmt.Errorf("at most %d reminders are allowed", MaxReminders)
+	}
+	seen := map[int]bool{}
+	for _, minutes := range n.Data.Reminders {
+		if minutes < 0 || minutes > MaxReminderMinutes {
+			return fmt.Errorf("reminder must be between 0 and %d minutes before dueAt", MaxReminderMinutes)
+		}
+		if seen[minutes] {
+			return errors.New("duplicate reminder")
+		}
+		seen[minutes] = true
+	}
+	return nil
+}
+
+func validateRecurrence(n repository.Node) error {
+	rec := n.Data.Recurrence
+	if n.Data.DueAt == nil {
+		return errors.New("recurrence requires dueAt")
+	}
+	switch rec.Mode {
+	case RecurrenceClone:
+		if n.Id == RootNodeID {
+			return errors.New("root node cannot use clone recurrence")
+		}
+	case RecurrenceReset:
+	default:
+		return fmt.Errorf("recurrence mode must be %q or %q", RecurrenceClone, RecurrenceReset)
+	}
+	if _, err := rrule.Parse(rec.Rule); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ノードのdataのうち、期限・開始日時・優先度・繰り返し設定の項目
+type scheduleFields struct {
+	DueAt      *time.Time                 `json:"dueAt"`
+	StartAt    *time.Time                 `json:"startAt"`
+	Priority   *int                       `json:"priority"`
+	Recurrence *repository.NodeRecurrence `json:"recurrence"`
+	Reminders  []int                      `json:"reminders"`
+}
+
+// PrepareState はクライアントから受け取ったstateの全ノードの期限・開始日時・優先度・繰り返し設定を検証し、
+// 期限・開始日時をUTCに揃えたstateを返す（オフセット付きで送られてきた場合）
+// 構造体に読み込まずにJSONのまま扱い、UTCに揃えた項目以外は受け取った内容をそのまま残す
+// （形の異なる部分や未知の項目はそのまま保存する）
+func PrepareState(stateJSON json.RawMessage) (json.RawMessage, error) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(stateJSON, &top); err != nil {
+		return nil, err
+	}
+
+	var projects map[string]json.RawMessage
+	if json.Unmarshal(top["projects"], &projects) != nil {
+		return stateJSON, nil
+	}
+
+	// エラーメッセージを安定させるため、プロジェクトをID順に処理する
+	pjIDs := make([]string, 0, len(projects))
+	for id := range projects {
+		pjIDs = append(pjIDs, id)
+	}
+	sort.Strings(pjIDs)
+
+	changed := false
+	for _, pjID := range pjIDs {
+		pj, ok, err := prepareProject(projects[pjID])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			projects[pjID] = pj
+			changed = true
+		}
+	}
+	if !changed {
+		return stateJSON, nil
+	}
+
+	return patchJSON(top, "projects", projects)
+}
+
+// プロジェクトの全ノードを検証し、変更があった場合はそのプロジェクトのJSONを返す
+func prepareProject(raw json.RawMessage) (json.RawMessage, bool, error) {
+	var pj map[string]json.RawMessage
+	if json.Unmarshal(raw, &pj) != nil {
+		return nil, false, nil
+	}
+	var nodes []json.RawMessage
+	if json.Unmarshal(pj["nodes"], &nodes) != nil {
+		return nil, false, nil
+	}
+
+	changed := false
+	for i, rawNode := range nodes {
+		node, ok, err := prepareNode(rawNode)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			nodes[i] = node
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, false, nil
+	}
+
+	b, err := patchJSON(pj, "nodes", nodes)
+	return b, err == nil, err
+}
+
+// ノードを検証し、期限・開始日時をUTCに揃えた場合はそのノードのJSONを返す
+func prepareNode(raw json.RawMessage) (json.RawMessage, bool, error) {
+	var node map[string]json.RawMessage
+	if json.Unmarshal(raw, &node) != nil {
+		return nil, false, nil
+	}
+	var data map[string]json.RawMessage
+	if json.Unmarshal(node["data"], &data) != nil {
+		return nil, false, nil
+	}
+
+	var id string
+	_ = json.Unmarshal(node["id"], &id)
+
+	var f scheduleFields
+	if err := json.Unmarshal(node["data"], &f); err != nil {
+		return nil, false, fmt.Errorf("%w: node %q %s", ErrInvalidSchedule, id, err)
+	}
+	n := repository.Node{Id: id, Data: repository.NodeData{
+		DueAt:      f.DueAt,
+		StartAt:    f.StartAt,
+		Priority:   f.Priority,
+		Recurrence: f.Recurrence,
+		Reminders:  f.Reminders,
+	}}
+	if err := validateNodeSchedule(n); err != nil {
+		return nil, false, err
+	}
+
+	changed := false
+	for key, t := range map[string]*time.Time{"dueAt": f.DueAt, "startAt": f.StartAt} {
+		if t == nil || t.Location() == time.UTC {
+			continue
+		}
+		b, err := json.Marshal(t.UTC())
+		if err != nil {
+			return nil, false, err
+		}
+		data[key] = b
+		changed = true
+	}
+	if !changed {
+		return nil, false, nil
+	}
+
+	b, err := patchJSON(node, "data", data)
+	return b, err == nil, err
+}
+
+// objのkeyをvalueで置き換えたJSONを返す（他の項目は受け取った内容のまま）
+func patchJSON(obj map[string]json.RawMessage, key string, value any) (json.RawMessage, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	obj[key] = b
+	return json.Marshal(obj)
+}
@@ -0,0 +1,107 @@
+package minkan
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestPrepareStateNormalizesToUTC(t *testing.T) {
+	in := json.RawMessage(`{
+  "currentPjId": "pj1",
+  "frontendOnly": {"theme": "dark"},
+  "projects": {
+    "pj1": {
+      "id": "pj1",
+      "viewport": {"zoom": 2},
+      "nodes": [
+        {"id": "n1", "measured": {"width": 120}, "data": {"label": "a", "color": "red", "dueAt": "2025-03-01T09:00:00+09:00", "startAt": "2025-02-28T09:00:00+09:00"}},
+        {"id": "n2", "data": {"label": "b", "dueAt": "2025-03-01T00:00:00Z", "priority": 2}}
+      ]
+    },
+    "pj2": {"id": "pj2", "nodes": "not an array"}
+  }
+}`)
+
+	out, err := PrepareState(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		FrontendOnly map[string]string `json:"frontendOnly"`
+		Projects     map[string]struct {
+			Viewport map[string]float64 `json:"viewport"`
+			Nodes    json.RawMessage    `json:"nodes"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.FrontendOnly["theme"] != "dark" || got.Projects["pj1"].Viewport["zoom"] != 2 {
+		t.Errorf("unknown fields lost: %s", out)
+	}
+	if string(got.Projects["pj2"].Nodes) != `"not an array"` {
+		t.Errorf("project with another shape changed: %s", got.Projects["pj2"].Nodes)
+	}
+
+	var nodes []struct {
+		Measured map[string]float64 `json:"measured"`
+		Data     map[string]any     `json:"data"`
+	}
+	if err := json.Unmarshal(got.Projects["pj1"].Nodes, &nodes); err != nil {
+		t.Fatal(err)
+	}
+	n1 := nodes[0]
+	if n1.Data["dueAt"] != "2025-03-01T00:00:00Z" || n1.Data["startAt"] != "2025-02-28T00:00:00Z" {
+		t.Errorf("dates not normalized to UTC: %v", n1.Data)
+	}
+	if n1.Data["color"] != "red" || n1.Measured["width"] != 120 {
+		t.Errorf("node unknown fields lost: %+v", n1)
+	}
+	if nodes[1].Data["priority"] != float64(2) {
+		t.Errorf("untouched node changed: %v", nodes[1].Data)
+	}
+}
+
+// 変更が無ければ受け取った内容をそのまま返す（形の異なる部分も含む）
+func TestPrepareStatePassesThrough(t *testing.T) {
+	for _, in := range []string{
+		`{"projects": {"pj1": {"nodes": [{"id": "n1", "position": "weird", "data": {"label": 1, "comments": 5, "dueAt": "2025-03-01T00:00:00Z"}}]}}, "kanbanColumns": "x"}`,
+		`{"projects": "not an object"}`,
+		`{"projects": {"pj1": {"nodes": [1, "node", null, {"id": "n1", "data": null}]}}}`,
+		`{}`,
+	} {
+		out, err := PrepareState(json.RawMessage(in))
+		if err != nil {
+			t.Errorf("PrepareState(%s): %v", in, err)
+			continue
+		}
+		if string(out) != in {
+			t.Errorf("PrepareState(%s) = %s, want unchanged", in, out)
+		}
+	}
+}
+
+func TestPrepareStateRejectsInvalidSchedule(t *testing.T) {
+	for _, data := range []string{
+		`{"priority": 9}`,
+		`{"priority": "high"}`,
+		`{"dueAt": "tomorrow"}`,
+		`{"dueAt": "2025-03-01T00:00:00Z", "startAt": "2025-03-02T00:00:00Z"}`,
+		`{"reminders": [10]}`,
+		`{"dueAt": "2025-03-01T00:00:00Z", "reminders": [10, 10]}`,
+		`{"dueAt": "2025-03-01T00:00:00Z", "recurrence": {"rule": "FREQ=DAILY", "mode": "other"}}`,
+		`{"dueAt": "2025-03-01T00:00:00Z", "recurrence": {"rule": "FREQ=SOMETIMES", "mode": "reset"}}`,
+	} {
+		in := `{"projects": {"pj1": {"nodes": [{"id": "n1", "data": ` + data + `}]}}}`
+		_, err := PrepareState(json.RawMessage(in))
+		if !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("data %s: err = %v, want ErrInvalidSchedule", data, err)
+		}
+	}
+
+	if _, err := PrepareState(json.RawMessage(`[]`)); err == nil || errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("non-object state: err = %v", err)
+	}
+}
@@ -0,0 +1,258 @@
+package minkan
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/pubsub"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+var ErrStateNotFound = errors.New("minkan state not found")
+
+// Mutate時の楽観ロック競合リトライ回数
+const maxMutateRetries = 5
+
+// Change はminkan_statesの1回の更新内容
+type Change struct {
+	UserID    int64
+	Version   int32           // 更新後のversion
+	Old       json.RawMessage // 更新前のstate（version-1時点）
+	New       json.RawMessage // 更新後のstate
+	Origin    string          // 更新元（X-Minkan-Origin、"live:<接続ID>" 等）
+	UpdatedAt time.Time
+
+	// Old・NewをGo構造体にしたもの（Committedで1度だけ変換し、変換できなかった場合はnil）
+	// フック間で共有するため、フック内で変更しないこと
+	Before *repository.Minkan
+	After  *repository.Minkan
+}
+
+// ChangeHook はコミット後に呼ばれる更新フック
+// 更新自体は確定済みのため、フック内のエラーはフック側でログに残す
+type ChangeHook func(ctx context.Context, c *Change)
+
+// TxHook はstateの更新と同じトランザクション内で呼ばれる更新フック
+// エラーを返した場合は更新ごとロールバックする（更新と、更新から作る記録を必ず一致させる。transactional outbox）
+type TxHook func(ctx context.Context, tx *sql.Tx, c *Change) error
+
+// StateRepository はStoreが使うminkan_statesの読み書き（*repository.MinkanStatesRepository）
+type StateRepository interface {
+	FindStateByUserID(ctx context.Context, userID int64) (*repository.MinkanState, error)
+	UpdateStateByUserID(ctx context.Context, newStateJSON json.RawMessage, userID int64, version int32) error
+}
+
+// TxStateRepository はトランザクション内で更新できるStateRepository（*repository.MinkanStatesRepository）
+// TxHookはRepoがこれを満たす場合のみ呼ばれる
+type TxStateRepository interface {
+	StateRepository
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	UpdateStateByUserIDTx(ctx context.Context, tx *sql.Tx, newStateJSON json.RawMessage, userID int64, version int32) error
+}
+
+// Store はminkan_statesへの書き込みを一元化する
+// PUT /minkan による全置換と、サーバ側での部分更新(Mutate)の両方をここに集約し、更新通知の発行漏れを防ぐ
+type Store struct {
+	Repo     StateRepository
+	EventHub pubsub.Hub
+	hooks    []ChangeHook
+	txHooks  []TxHook
+}
+
+func NewStore(repo StateRepository, hub pubsub.Hub) *Store {
+	return &Store{Repo: repo, EventHub: hub}
+}
+
+// 更新フックを登録する（起動時にのみ呼び出すこと）
+func (st *Store) OnChange(h ChangeHook) {
+	st.hooks = append(st.hooks, h)
+}
+
+// トランザクション内の更新フックを登録する（起動時にのみ呼び出すこと）
+func (st *Store) InTx(h TxHook) {
+	st.txHooks = append(st.txHooks, h)
+}
+
+// クライアントから受け取ったstateで全置換する（楽観ロックはクライアントのversionで判定）
+// 更新後のversionを返す
+func (st *Store) Replace(ctx context.Context, userID int64, newStateJSON json.RawMessage, version int32, origin string) (int32, error) {
+	// 更新フックに渡すため、置換前のstateを読んでおく
+	current, err := st.Repo.FindStateByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if current == nil {
+		return 0, ErrStateNotFound
+	}
+	if current.Version != version {
+		return 0, repository.ErrOptimisticLock
+	}
+
+	return st.replace(ctx, current, newStateJSON, origin)
+}
+
+// currentのversionを条件にstateを書き換え、コミット後の処理を行う
+// UPDATEもversionで判定するため、currentは必ず直前のstateになる
+func (st *Store) replace(ctx context.Context, current *repository.MinkanState, newStateJSON json.RawMessage, origin string) (int32, error) {
+	c := &Change{
+		UserID:    current.UserID,
+		Version:   current.Version + 1,
+		Old:       current.StateJSON,
+		New:       newStateJSON,
+		Origin:    origin,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	txRepo, ok := st.Repo.(TxStateRepository)
+	if !ok || len(st.txHooks) == 0 {
+		if err := st.Repo.UpdateStateByUserID(ctx, newStateJSON, current.UserID, current.Version); err != nil {
+			return 0, err
+		}
+		st.Committed(ctx, c)
+		return c.Version, nil
+	}
+
+	tx, err := txRepo.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := txRepo.UpdateStateByUserIDTx(ctx, tx, newStateJSON, current.UserID, current.Version); err != nil {
+		return 0, err
+	}
+	if err := st.Committing(ctx, tx, c); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	st.Committed(ctx, c)
+	return c.Version, nil
+}
+
+// 現在のstateを読み込んでfnで変更し、書き戻す
+// - 構造体で扱わない項目（フロントエンドが追加した項目）はそのまま書き戻す（repository.Extra）
+// - 他の更新と競合した場合は最新のstateを読み直してfnを再適用する
+// - fnがエラーを返した場合は書き込まずにそのエラーを返す
+// 更新後のversionを返す
+func (st *Store) Mutate(ctx context.Context, userID int64, origin string, fn func(m *repository.Minkan) error) (int32, error) {
+	for attempt := 0; ; attempt++ {
+		current, err := st.Repo.FindStateByUserID(ctx, userID)
+		if err != nil {
+			return 0, err
+		}
+		if current == nil {
+			return 0, ErrStateNotFound
+		}
+
+		m, err := Decode(current.StateJSON)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := fn(m); err != nil {
+			return 0, err
+		}
+
+		newStateJSON, err := json.Marshal(m)
+		if err != nil {
+			return 0, err
+		}
+
+		newVersion, err := st.replace(ctx, current, newStateJSON, origin)
+		if errors.Is(err, repository.ErrOptimisticLock) && attempt < maxMutateRetries {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		return newVersion, nil
+	}
+}
+
+// Committing はトランザクション内の更新フックを実行する（エラーの場合は呼び出し側でロールバックする）
+// Storeを経由せずにトランザクション内で更新した場合は、コミット前に呼び出す
+func (st *Store) Committing(ctx context.Context, tx *sql.Tx, c *Change) error {
+	if len(st.txHooks) == 0 {
+		return nil
+	}
+
+	c.decode()
+	for _, h := range st.txHooks {
+		if err := h(ctx, tx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 更新通知の発行と更新フックの実行（失敗しても更新自体は成功扱い）
+// Storeを経由せずにトランザクション内で更新した場合は、コミット後に呼び出す
+func (st *Store) Committed(ctx context.Context, c *Change) {
+	// リクエスト終了でフックの書き込みが中断されないよう、キャンセルを切り離す
+	ctx = context.WithoutCancel(ctx)
+	c.decode()
+
+	if st.EventHub != nil {
+		ev := pubsub.Event{
+			Version:   c.Version,
+			UpdatedAt: c.UpdatedAt,
+			Origin:    c.Origin,
+		}
+		if err := st.EventHub.Publish(ctx, c.UserID, ev); err != nil {
+			slog.Default().With("module", "minkan").Warn("publish minkan event failed", "err", err)
+		}
+	}
+
+	for _, h := range st.hooks {
+		h(ctx, c)
+	}
+}
+
+// 更新前後のstateを変換する（フックごとに変換し直さないように）
+func (c *Change) decode() {
+	lg := slog.Default().With("module", "minkan", "userID", c.UserID, "version", c.Version)
+
+	var err error
+	if c.Before == nil {
+		if c.Before, err = Decode(c.Old); err != nil {
+			lg.Error("decode old minkan state failed", "err", err)
+		}
+	}
+	if c.After == nil {
+		if c.After, err = Decode(c.New); err != nil {
+			lg.Error("decode new minkan state failed", "err", err)
+		}
+	}
+}
+
+// stateのJSONをGo構造体に変換する
+func Decode(stateJSON json.RawMessage) (*repository.Minkan, error) {
+	m := &repository.Minkan{}
+	if err := json.Unmarshal(stateJSON, m); err != nil {
+		return nil, err
+	}
+
+	// nilのままだとJSON化した際にnullになりフロントで扱えないため初期化
+	if m.Projects == nil {
+		m.Projects = repository.Projects{}
+	}
+	if m.KanbanIndex == nil {
+		m.KanbanIndex = repository.KanbanIndex{}
+	}
+	cols := &m.KanbanColumns
+	for _, col := range []*[]repository.KanbanCardRef{&cols.Backlog, &cols.Todo, &cols.Doing, &cols.Done} {
+		if *col == nil {
+			*col = []repository.KanbanCardRef{}
+		}
+	}
+
+	return m, nil
+}
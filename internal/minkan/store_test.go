@@ -0,0 +1,254 @@
+package minkan
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan/fakestate"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// フロントエンドが追加した項目（構造体に無い項目）を各階層に含むstate
+const stateWithUnknownFields = `{
+  "currentPjId": "pj1",
+  "schemaHint": "frontend-only",
+  "kanbanColumns": {"backlog": [], "todo": [], "doing": [], "done": [], "archived": [{"pjId": "pj1", "nodeId": "n1"}]},
+  "kanbanIndex": {},
+  "projects": {
+    "pj1": {
+      "id": "pj1",
+      "name": "Project",
+      "createdAt": "2025-01-01T00:00:00Z",
+      "updatedAt": "2025-01-01T00:00:00Z",
+      "viewport": {"x": 10, "y": 20, "zoom": 1.5},
+      "nodes": [
+        {
+          "id": "root",
+          "type": "custom",
+          "position": {"x": 0, "y": 0},
+          "data": {"label": "root", "isDone": false, "comments": [], "parentId": null}
+        },
+        {
+          "id": "n1",
+          "type": "custom",
+          "position": {"x": 100, "y": 50},
+          "measured": {"width": 180, "height": 40},
+          "style": {"background": "#fee"},
+          "selected": true,
+          "data": {
+            "label": "before",
+            "isDone": false,
+            "parentId": "root",
+            "color": "red",
+            "comments": [{"id": "c1", "content": "hi", "createdAt": "2025-01-01T00:00:00Z", "reactions": ["+1"]}]
+          }
+        }
+      ],
+      "edges": [{"id": "e-root-n1", "source": "root", "target": "n1", "type": "default", "animated": true}]
+    }
+  }
+}`
+
+func TestMutateKeepsUnknownFields(t *testing.T) {
+	repo := fakestate.New()
+	repo.Put(1, json.RawMessage(stateWithUnknownFields))
+	st := NewStore(repo, nil)
+
+	label := "after"
+	version, err := st.Mutate(context.Background(), 1, "test", func(m *repository.Minkan) error {
+		return Apply(m, &Op{Type: OpNodeRename, PjID: "pj1", NodeID: "n1", Label: &label})
+	})
+	if err != nil {
+		t.Fatalf("Mutate: %v", err)
+	}
+	if version != 2 {
+		t.Fatalf("version = %d, want 2", version)
+	}
+
+	var got struct {
+		SchemaHint    string `json:"schemaHint"`
+		KanbanColumns struct {
+			Archived []repository.KanbanCardRef `json:"archived"`
+		} `json:"kanbanColumns"`
+		Projects map[string]struct {
+			Viewport map[string]float64 `json:"viewport"`
+			Nodes    []struct {
+				ID       string             `json:"id"`
+				Measured map[string]float64 `json:"measured"`
+				Style    map[string]string  `json:"style"`
+				Selected bool               `json:"selected"`
+				Data     struct {
+					Label    string `json:"label"`
+					Color    string `json:"color"`
+					Comments []struct {
+						Reactions []string `json:"reactions"`
+					} `json:"comments"`
+				} `json:"data"`
+			} `json:"nodes"`
+			Edges []struct {
+				Animated bool `json:"animated"`
+			} `json:"edges"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(repo.State(1), &got); err != nil {
+		t.Fatalf("unmarshal stored state: %v", err)
+	}
+
+	if got.SchemaHint != "frontend-only" {
+		t.Errorf("top-level unknown field lost: %q", got.SchemaHint)
+	}
+	if len(got.KanbanColumns.Archived) != 1 {
+		t.Errorf("unknown kanban column lost: %+v", got.KanbanColumns.Archived)
+	}
+
+	pj := got.Projects["pj1"]
+	if pj.Viewport["zoom"] != 1.5 {
+		t.Errorf("project viewport lost: %+v", pj.Viewport)
+	}
+	if len(pj.Edges) != 1 || !pj.Edges[0].Animated {
+		t.Errorf("edge unknown field lost: %+v", pj.Edges)
+	}
+
+	var n1 = -1
+	for i, n := range pj.Nodes {
+		if n.ID == "n1" {
+			n1 = i
+		}
+	}
+	if n1 < 0 {
+		t.Fatal("node n1 missing")
+	}
+	n := pj.Nodes[n1]
+	if n.Data.Label != "after" {
+		t.Errorf("label = %q, want %q", n.Data.Label, "after")
+	}
+	if n.Measured["width"] != 180 || n.Style["background"] != "#fee" || !n.Selected {
+		t.Errorf("node unknown fields lost: measured=%v style=%v selected=%v", n.Measured, n.Style, n.Selected)
+	}
+	if n.Data.Color != "red" {
+		t.Errorf("node data unknown field lost: %q", n.Data.Color)
+	}
+	if len(n.Data.Comments) != 1 || len(n.Data.Comments[0].Reactions) != 1 {
+		t.Errorf("comment unknown field lost: %+v", n.Data.Comments)
+	}
+}
+
+// 構造体のフィールドに読み込まれる項目は、大文字小文字が異なっても重複して書き戻さない
+func TestDecodeDoesNotDuplicateKnownKeys(t *testing.T) {
+	m, err := Decode(json.RawMessage(`{"currentPjId": "a", "CurrentPjID": "b", "projects": {}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(b, &keys); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := keys["CurrentPjID"]; ok {
+		t.Errorf("case-insensitive duplicate of a known key written back: %s", b)
+	}
+}
+
+// txRepo はトランザクション内の更新を再現するminkan_states（更新はコミット時に反映する）
+type txRepo struct {
+	*fakestate.Repo
+	db      *sql.DB
+	pending func() // コミット時に反映する更新
+	commits int
+}
+
+func newTxRepo() *txRepo {
+	r := &txRepo{Repo: fakestate.New()}
+	r.db = sql.OpenDB(r)
+	return r
+}
+
+func (r *txRepo) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return r.db.BeginTx(ctx, nil)
+}
+
+func (r *txRepo) UpdateStateByUserIDTx(ctx context.Context, _ *sql.Tx, newStateJSON json.RawMessage, userID int64, version int32) error {
+	r.pending = func() { _ = r.Repo.UpdateStateByUserID(ctx, newStateJSON, userID, version) }
+	return nil
+}
+
+// database/sqlのトランザクションの開始・コミット・ロールバックのみ扱うドライバ
+func (r *txRepo) Connect(context.Context) (driver.Conn, error) { return txConn{r}, nil }
+func (r *txRepo) Driver() driver.Driver                        { return nil }
+
+type txConn struct{ r *txRepo }
+
+func (c txConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c txConn) Close() error                        { return nil }
+func (c txConn) Begin() (driver.Tx, error)           { return c, nil }
+
+func (c txConn) Commit() error {
+	c.r.commits++
+	if c.r.pending != nil {
+		c.r.pending()
+	}
+	c.r.pending = nil
+	return nil
+}
+
+func (c txConn) Rollback() error {
+	c.r.pending = nil
+	return nil
+}
+
+// トランザクション内の更新フックが失敗した場合は、stateの更新ごと取り消す
+func TestTxHookRollsBackUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo := newTxRepo()
+	repo.Put(1, json.RawMessage(stateWithUnknownFields))
+	st := NewStore(repo, nil)
+
+	var failHook bool
+	var recorded, committed []int32
+	st.InTx(func(_ context.Context, tx *sql.Tx, c *Change) error {
+		if tx == nil || c.Before == nil || c.After == nil {
+			t.Errorf("tx hook got tx %v, change %+v", tx, c)
+		}
+		if failHook {
+			return errors.New("outbox unavailable")
+		}
+		recorded = append(recorded, c.Version)
+		return nil
+	})
+	st.OnChange(func(_ context.Context, c *Change) { committed = append(committed, c.Version) })
+
+	label := "after"
+	rename := func(m *repository.Minkan) error {
+		return Apply(m, &Op{Type: OpNodeRename, PjID: "pj1", NodeID: "n1", Label: &label})
+	}
+
+	failHook = true
+	if _, err := st.Mutate(ctx, 1, "test", rename); err == nil {
+		t.Fatal("Mutate succeeded while the tx hook failed")
+	}
+	if got := repo.State(1); string(got) != stateWithUnknownFields || repo.commits != 0 || len(committed) != 0 {
+		t.Fatalf("update not rolled back: commits %d, hooks %v", repo.commits, committed)
+	}
+
+	failHook = false
+	version, err := st.Mutate(ctx, 1, "test", rename)
+	if err != nil || version != 2 {
+		t.Fatalf("Mutate = %d, %v", version, err)
+	}
+	if repo.commits != 1 || len(recorded) != 1 || recorded[0] != 2 || len(committed) != 1 || committed[0] != 2 {
+		t.Errorf("commits %d, tx hooks %v, hooks %v", repo.commits, recorded, committed)
+	}
+	m, err := Decode(repo.State(1))
+	if err != nil || m.Projects["pj1"].Nodes[1].Data.Label != "after" {
+		t.Errorf("state after commit = %s", repo.State(1))
+	}
+}
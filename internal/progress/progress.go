@@ -0,0 +1,149 @@
+package progress
+
+import (
+	"sync"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// キャッシュするユーザー数の上限（超えた場合は任意の1件を捨てる）
+const maxCachedUsers = 1024
+
+// Counts は配下の末端タスク（子を持たないノード）の数と、そのうち完了したものの数
+type Counts struct {
+	Total int `json:"total"`
+	Done  int `json:"done"`
+}
+
+// Project はプロジェクト1件の進捗
+type Project struct {
+	PjID    string            `json:"pjId"`
+	Version int32             `json:"version"` // 集計したstateのversion
+	Counts                    // プロジェクト全体（ルートノード以外の末端タスク）
+	Nodes   map[string]Counts `json:"nodes"` // ノードID → そのノードを根とする部分木の集計
+}
+
+// Compute は parentId の木をたどり、各ノードの部分木の末端タスクの数を集計する
+// - 子を持たないノードを末端タスクとし、isDoneを完了とみなす（ルートノードのみのプロジェクトは0件）
+// - 親が見つからないノードは独立した部分木として扱い、プロジェクト全体には含める
+// - 再帰を使わずノード数に比例する時間で集計する
+func Compute(pj *repository.Project, version int32) *Project {
+	n := len(pj.Nodes)
+	index := make(map[string]int, n)
+	for i := range pj.Nodes {
+		index[pj.Nodes[i].Id] = i
+	}
+
+	parent := make([]int, n)
+	children := make([]int, n)
+	for i := range pj.Nodes {
+		parent[i] = -1
+		if p := pj.Nodes[i].Data.ParentId; p != nil {
+			if j, ok := index[*p]; ok && j != i {
+				parent[i] = j
+				children[j]++
+			}
+		}
+	}
+
+	// 子を持たないノードから順に親へ加算する（子の集計が全て済んだノードを次に処理する）
+	counts := make([]Counts, n)
+	remaining := append([]int(nil), children...)
+	queue := make([]int, 0, n)
+	res := &Project{PjID: pj.Id, Version: version, Nodes: make(map[string]Counts, n)}
+	for i := range pj.Nodes {
+		if children[i] > 0 {
+			continue
+		}
+		if pj.Nodes[i].Id != minkan.RootNodeID {
+			counts[i].Total = 1
+			if pj.Nodes[i].Data.IsDone {
+				counts[i].Done = 1
+			}
+			res.Total += counts[i].Total
+			res.Done += counts[i].Done
+		}
+		queue = append(queue, i)
+	}
+
+	// 循環した親子関係のノードはキューに入らないため、その部分の集計は途中までとなる
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		p := parent[i]
+		if p < 0 {
+			continue
+		}
+		counts[p].Total += counts[i].Total
+		counts[p].Done += counts[i].Done
+		if remaining[p]--; remaining[p] == 0 {
+			queue = append(queue, p)
+		}
+	}
+
+	for i := range pj.Nodes {
+		res.Nodes[pj.Nodes[i].Id] = counts[i]
+	}
+	return res
+}
+
+// Cache はユーザーごとに、最新のstateのversionに対する全プロジェクトの進捗を保持する
+// versionが変わった場合は次の取得時に再集計する
+type Cache struct {
+	mu      sync.Mutex
+	entries map[int64]*entry
+}
+
+type entry struct {
+	version  int32
+	projects map[string]*Project
+}
+
+func NewCache() *Cache {
+	return &Cache{entries: map[int64]*entry{}}
+}
+
+// Projects は全プロジェクトの進捗を返す（キャッシュに無い場合はstateを読み込んで集計する）
+// 返した値は共有されるため、呼び出し側で変更しないこと
+func (c *Cache) Projects(userID int64, state *repository.MinkanState) (map[string]*Project, error) {
+	c.mu.Lock()
+	e, ok := c.entries[userID]
+	c.mu.Unlock()
+	if ok && e.version == state.Version {
+		return e.projects, nil
+	}
+
+	m, err := minkan.Decode(state.StateJSON)
+	if err != nil {
+		return nil, err
+	}
+	projects := make(map[string]*Project, len(m.Projects))
+	for id, pj := range m.Projects {
+		projects[id] = Compute(&pj, state.Version)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// 同時に新しいversionが集計済みの場合は上書きしない
+	if cur, ok := c.entries[userID]; ok && cur.version > state.Version {
+		return projects, nil
+	}
+	if _, ok := c.entries[userID]; !ok && len(c.entries) >= maxCachedUsers {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[userID] = &entry{version: state.Version, projects: projects}
+	return projects, nil
+}
+
+// Project はプロジェクト1件の進捗を返す（プロジェクトが無い場合はnil）
+func (c *Cache) Project(userID int64, state *repository.MinkanState, pjID string) (*Project, error) {
+	projects, err := c.Projects(userID, state)
+	if err != nil {
+		return nil, err
+	}
+	return projects[pjID], nil
+}
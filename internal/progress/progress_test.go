@@ -0,0 +1,128 @@
+package progress
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+func node(id, parent string, done bool) repository.Node {
+	n := repository.Node{Id: id}
+	n.Data.IsDone = done
+	if parent != "" {
+		n.Data.ParentId = &parent
+	}
+	return n
+}
+
+// 末端タスクのみを数え、途中のノードの完了状態は集計に影響しない
+//
+//	root ─ a(完了) ─ a1(完了)
+//	     │        └ a2 ─ a2x(完了)
+//	     │              └ a2y ─ a2y1
+//	     └ b
+//	orphan（親が存在しない）
+//	c1 ⇄ c2（循環）
+func TestComputeNested(t *testing.T) {
+	// 子が親より前に並んでいても集計できる
+	pj := &repository.Project{Id: "pj1", Nodes: []repository.Node{
+		node("a2y1", "a2y", false),
+		node("a2x", "a2", true),
+		node("a1", "a", true),
+		node("a2y", "a2", false),
+		node("a2", "a", false),
+		node("a", "root", true),
+		node("root", "", false),
+		node("b", "root", false),
+		node("orphan", "missing", true),
+		node("c1", "c2", false),
+		node("c2", "c1", false),
+	}}
+	got := Compute(pj, 7)
+
+	if got.PjID != "pj1" || got.Version != 7 {
+		t.Errorf("project = %s v%d", got.PjID, got.Version)
+	}
+	// a1, a2x, a2y1, b, orphan（循環したノードは末端を持たない）
+	if got.Counts != (Counts{Total: 5, Done: 3}) {
+		t.Errorf("project counts = %+v, want {5 3}", got.Counts)
+	}
+
+	want := map[string]Counts{
+		"root":   {Total: 4, Done: 2},
+		"a":      {Total: 3, Done: 2},
+		"a1":     {Total: 1, Done: 1},
+		"a2":     {Total: 2, Done: 1},
+		"a2x":    {Total: 1, Done: 1},
+		"a2y":    {Total: 1, Done: 0},
+		"a2y1":   {Total: 1, Done: 0},
+		"b":      {Total: 1, Done: 0},
+		"orphan": {Total: 1, Done: 1},
+		"c1":     {},
+		"c2":     {},
+	}
+	if len(got.Nodes) != len(want) {
+		t.Errorf("nodes = %d, want %d", len(got.Nodes), len(want))
+	}
+	for id, w := range want {
+		if c := got.Nodes[id]; c != w {
+			t.Errorf("%s = %+v, want %+v", id, c, w)
+		}
+	}
+
+	// ルートノードのみのプロジェクトは0件
+	empty := Compute(&repository.Project{Id: "pj2", Nodes: []repository.Node{node("root", "", true)}}, 1)
+	if empty.Counts != (Counts{}) || empty.Nodes["root"] != (Counts{}) {
+		t.Errorf("root only = %+v %+v", empty.Counts, empty.Nodes["root"])
+	}
+}
+
+// 同じversionではキャッシュを返し、versionが変わると再集計する
+func TestCacheRecomputesOnVersionChange(t *testing.T) {
+	state := func(version int32, done bool) *repository.MinkanState {
+		t.Helper()
+		m := repository.Minkan{Projects: repository.Projects{
+			"pj1": {Id: "pj1", Nodes: []repository.Node{node("root", "", false), node("a", "root", false), node("a1", "a", done)}},
+		}}
+		b, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &repository.MinkanState{UserID: 1, StateJSON: b, Version: version}
+	}
+
+	c := NewCache()
+	p, err := c.Project(1, state(1, false), "pj1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Nodes["a"] != (Counts{Total: 1}) {
+		t.Fatalf("v1 a = %+v", p.Nodes["a"])
+	}
+
+	// versionが同じならstateは読まない（壊れたJSONでもキャッシュを返す）
+	if again, err := c.Project(1, &repository.MinkanState{UserID: 1, StateJSON: json.RawMessage(`{`), Version: 1}, "pj1"); err != nil || again != p {
+		t.Errorf("same version = %p %v, want cached %p", again, err, p)
+	}
+
+	p2, err := c.Project(1, state(2, true), "pj1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p2.Version != 2 || p2.Nodes["a"] != (Counts{Total: 1, Done: 1}) || p2.Nodes["root"] != (Counts{Total: 1, Done: 1}) {
+		t.Errorf("v2 = v%d a %+v root %+v", p2.Version, p2.Nodes["a"], p2.Nodes["root"])
+	}
+
+	// 古いversionの集計で新しいキャッシュを上書きしない
+	if _, err := c.Project(1, state(1, false), "pj1"); err != nil {
+		t.Fatal(err)
+	}
+	if cur, _ := c.Project(1, &repository.MinkanState{UserID: 1, StateJSON: json.RawMessage(`{`), Version: 2}, "pj1"); cur != p2 {
+		t.Errorf("stale version replaced the cache")
+	}
+
+	if missing, err := c.Project(1, state(2, true), "nope"); err != nil || missing != nil {
+		t.Errorf("unknown project = %v %v", missing, err)
+	}
+}
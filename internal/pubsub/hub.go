@@ -0,0 +1,31 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrHubClosed = errors.New("pubsub hub closed")
+
+// Event はユーザーのminkan_stateが更新されたことを通知するイベント
+type Event struct {
+	Version   int32     `json:"version"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Origin    string    `json:"origin"` // 更新元クライアントの識別子（自分の更新を無視するため）
+}
+
+// Hub はユーザー単位のイベント配信を抽象化したもの
+// 現状はプロセス内実装(MemoryHub)のみだが、複数インスタンス構成では
+// Redis Pub/Sub等の実装に差し替えてインスタンス間でファンアウトする想定
+type Hub interface {
+	// userIDの購読者全員にイベントを配信
+	Publish(ctx context.Context, userID int64, ev Event) error
+
+	// userIDのイベントを購読する
+	// - 返り値のチャネルはunsubscribe呼び出し時 or Hubのクローズ時にcloseされる
+	Subscribe(userID int64) (events <-chan Event, unsubscribe func(), err error)
+
+	// 全購読を終了させる（graceful shutdown用）
+	Close() error
+}
@@ -0,0 +1,111 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// 購読者ごとのバッファサイズ
+// - 通知内容はversionの更新のみなので、溢れた場合は古いイベントを捨てて最新を優先する
+const subscriberBufferSize = 8
+
+type subscriber struct {
+	ch chan Event
+}
+
+// MemoryHub はプロセス内で完結するHub実装
+type MemoryHub struct {
+	mu     sync.Mutex
+	subs   map[int64]map[*subscriber]struct{}
+	closed bool
+}
+
+func NewMemoryHub() *MemoryHub {
+	return &MemoryHub{
+		subs: make(map[int64]map[*subscriber]struct{}),
+	}
+}
+
+func (h *MemoryHub) Publish(_ context.Context, userID int64, ev Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return ErrHubClosed
+	}
+
+	for sub := range h.subs[userID] {
+		// 受信側が詰まっていても配信をブロックしない
+		select {
+		case sub.ch <- ev:
+		default:
+			// バッファが埋まっている場合は最古のイベントを捨てて最新を入れる
+			select {
+			case <-sub.ch:
+			default:
+			}
+			select {
+			case sub.ch <- ev:
+			default:
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *MemoryHub) Subscribe(userID int64) (<-chan Event, func(), error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, nil, ErrHubClosed
+	}
+
+	sub := &subscriber{ch: make(chan Event, subscriberBufferSize)}
+
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[*subscriber]struct{})
+	}
+	h.subs[userID][sub] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			// Close済みの場合はチャネルもclose済み
+			if _, ok := h.subs[userID][sub]; !ok {
+				return
+			}
+
+			delete(h.subs[userID], sub)
+			if len(h.subs[userID]) == 0 {
+				delete(h.subs, userID)
+			}
+			close(sub.ch)
+		})
+	}
+
+	return sub.ch, unsubscribe, nil
+}
+
+func (h *MemoryHub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil
+	}
+	h.closed = true
+
+	for userID, subs := range h.subs {
+		for sub := range subs {
+			close(sub.ch)
+		}
+		delete(h.subs, userID)
+	}
+
+	return nil
+}
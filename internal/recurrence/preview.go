@@ -0,0 +1,37 @@
+package recurrence
+
+import (
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/rrule"
+)
+
+// プレビューで返す件数の上限
+const MaxPreviewCount = 50
+
+// Preview はルールの発生日時をdue以降(dueを含む)から最大n件返す
+// startは繰り返しの起点（未設定の場合はdue）
+func Preview(ruleText string, start *time.Time, due time.Time, n int, loc *time.Location) (*rrule.Rule, []time.Time, error) {
+	rule, err := rrule.Parse(ruleText)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dtstart := due
+	if start != nil {
+		dtstart = *start
+	}
+
+	occurrences := rule.Take(dtstart, due.Add(-time.Nanosecond), n, loc)
+	for i := range occurrences {
+		occurrences[i] = occurrences[i].UTC()
+	}
+	return rule, occurrences, nil
+}
+
+// PreviewNode はノードの繰り返し設定の発生日時を、現在の期限から最大n件返す
+func PreviewNode(n *repository.Node, count int, loc *time.Location) (*rrule.Rule, []time.Time, error) {
+	rec := n.Data.Recurrence
+	return Preview(rec.Rule, rec.Start, *n.Data.DueAt, count, loc)
+}
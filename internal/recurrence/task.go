@@ -0,0 +1,193 @@
+package recurrence
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+	"github.com/yopi416/mind-kanban-backend/internal/rrule"
+)
+
+// Kind はnode_schedulesでの予定の種類
+const Kind = "recurrence"
+
+// 次回分のノードを元ノードからどれだけ下に置くか
+const cloneOffsetY = 80
+
+// 実行時点のstateでは予定が既に無効（処理済み・設定変更・ノード削除など）
+var errSkip = errors.New("recurrence skipped")
+
+// Task は繰り返し設定を持つノードの期限到来時に、次回分を作成する
+// 期限(dueAt)を発火時刻とし、dueAtより後の次の発生日時を次回分の期限にする
+type Task struct {
+	Store           *minkan.Store
+	UserRepo        *repository.UserRepository
+	DefaultLocation *time.Location
+}
+
+func NewTask(store *minkan.Store, userRepo *repository.UserRepository, defaultLocation *time.Location) *Task {
+	return &Task{Store: store, UserRepo: userRepo, DefaultLocation: defaultLocation}
+}
+
+// 繰り返し設定を持つノードの期限を発火予定にする
+func (t *Task) Plan(m *repository.Minkan) []repository.NodeSchedule {
+	schedules := []repository.NodeSchedule{}
+	for _, pj := range m.Projects {
+		for _, n := range pj.Nodes {
+			rec := n.Data.Recurrence
+			if rec == nil || n.Data.DueAt == nil {
+				continue
+			}
+			if _, err := rrule.Parse(rec.Rule); err != nil {
+				continue
+			}
+			schedules = append(schedules, repository.NodeSchedule{
+				PjID:   pj.Id,
+				NodeID: n.Id,
+				FireAt: n.Data.DueAt.Truncate(time.Second), // node_schedules.fire_atは秒精度
+			})
+		}
+	}
+	return schedules
+}
+
+// 次回分を作成する
+// 発火時刻とノードのdueAtが一致する場合のみ処理するため、同じ予定を複数回実行しても次回分は1つしかできない
+func (t *Task) Run(ctx context.Context, sc *repository.NodeSchedule) error {
+	loc, err := t.location(ctx, sc.UserID)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.Store.Mutate(ctx, sc.UserID, "scheduler:"+Kind, func(m *repository.Minkan) error {
+		return t.apply(m, sc, loc, time.Now())
+	})
+	if errors.Is(err, errSkip) || errors.Is(err, minkan.ErrStateNotFound) {
+		slog.Default().With("module", "recurrence", "userID", sc.UserID, "nodeID", sc.NodeID).Debug("recurrence skipped")
+		return nil
+	}
+	return err
+}
+
+func (t *Task) location(ctx context.Context, userID int64) (*time.Location, error) {
+	user, err := t.UserRepo.FindUserByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return t.DefaultLocation, nil
+	}
+	return calendar.Location(user.TimeZone, t.DefaultLocation), nil
+}
+
+func (t *Task) apply(m *repository.Minkan, sc *repository.NodeSchedule, loc *time.Location, now time.Time) error {
+	pj, ok := m.Projects[sc.PjID]
+	if !ok {
+		return errSkip
+	}
+	idx := minkan.FindNode(&pj, sc.NodeID)
+	if idx < 0 {
+		return errSkip
+	}
+
+	node := &pj.Nodes[idx]
+	rec := node.Data.Recurrence
+	due := node.Data.DueAt
+	if rec == nil || due == nil || !due.Truncate(time.Second).Equal(sc.FireAt) {
+		return errSkip
+	}
+
+	rule, err := rrule.Parse(rec.Rule)
+	if err != nil {
+		return errSkip
+	}
+
+	start := *due
+	if rec.Start != nil {
+		start = *rec.Start
+	}
+
+	// 停止中に過ぎた回は作らず、現在より後の回から再開する
+	after := *due
+	if now.After(after) {
+		after = now
+	}
+	next, ok := rule.After(start, after, loc)
+	if !ok {
+		return errSkip // 繰り返し終了（COUNT・UNTIL）
+	}
+	next = next.UTC()
+
+	nextRec := *rec
+	nextRec.Start = &start
+	nextStart := shiftStart(node.Data.StartAt, *due, next)
+
+	switch rec.Mode {
+	case minkan.RecurrenceClone:
+		if node.Data.ParentId == nil {
+			return errSkip
+		}
+
+		cloneID := cloneNodeID(sc, next)
+		if minkan.FindNode(&pj, cloneID) >= 0 {
+			return errSkip
+		}
+
+		clone := minkan.NewNode(cloneID, *node.Data.ParentId, node.Data.Label)
+		clone.Position.X = node.Position.X
+		clone.Position.Y = node.Position.Y + cloneOffsetY
+		clone.Data.Priority = node.Data.Priority
+		clone.Data.Reminders = append([]int(nil), node.Data.Reminders...)
+		clone.Data.DueAt = &next
+		clone.Data.StartAt = nextStart
+		clone.Data.Recurrence = &nextRec
+
+		// 繰り返し設定は次回分へ引き継ぐ
+		node.Data.Recurrence = nil
+
+		pj.Nodes = append(pj.Nodes, clone)
+		pj.Edges = append(pj.Edges, minkan.NewEdge(*clone.Data.ParentId, cloneID))
+		m.Projects[sc.PjID] = pj
+		minkan.MoveCard(m, sc.PjID, cloneID, minkan.ColumnTodo, nil)
+
+	case minkan.RecurrenceReset:
+		node.Data.DueAt = &next
+		node.Data.StartAt = nextStart
+		node.Data.IsDone = false
+		node.Data.Recurrence = &nextRec
+		m.Projects[sc.PjID] = pj
+		if minkan.FindCardColumn(&m.KanbanColumns, sc.PjID, sc.NodeID) != minkan.ColumnTodo {
+			minkan.MoveCard(m, sc.PjID, sc.NodeID, minkan.ColumnTodo, nil)
+		}
+
+	default:
+		return errSkip
+	}
+
+	pj = m.Projects[sc.PjID]
+	pj.UpdatedAt = now.UTC()
+	m.Projects[sc.PjID] = pj
+	return nil
+}
+
+// 開始日時は期限との間隔を保ったまま次回へずらす
+func shiftStart(startAt *time.Time, due, next time.Time) *time.Time {
+	if startAt == nil {
+		return nil
+	}
+	s := next.Add(startAt.Sub(due))
+	return &s
+}
+
+// 次回分のノードIDは元ノードと発生日時から決定的に作る（再実行時に重複作成しないため）
+func cloneNodeID(sc *repository.NodeSchedule, next time.Time) string {
+	sum := sha256.Sum256([]byte(sc.PjID + "/" + sc.NodeID + "/" + strconv.FormatInt(next.Unix(), 10)))
+	return "rec-" + hex.EncodeToString(sum[:8])
+}
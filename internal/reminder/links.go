@@ -0,0 +1,121 @@
+package reminder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 配信停止トークンの有効期間（古いメールのリンクはこれを過ぎると使えない。設定画面からは常に変更できる）
+const UnsubscribeTokenTTL = 90 * 24 * time.Hour
+
+// 発行日時が未来の配信停止トークンを許容する範囲（サーバ間の時刻のずれ）
+const unsubscribeClockSkew = 5 * time.Minute
+
+// Links はメール本文・カレンダー等のアプリ外に載せるURLを作成する
+type Links struct {
+	PublicBaseURL string // APIの公開URL（例 https://api.example.com）。配信停止リンク等に使う
+	AppURL        string // アプリを開くリンク
+	Secret        []byte // 配信停止トークンの署名鍵
+}
+
+func NewLinks(publicBaseURL, appURL, secret string) *Links {
+	return &Links{
+		PublicBaseURL: strings.TrimRight(publicBaseURL, "/"),
+		AppURL:        appURL,
+		Secret:        []byte(secret),
+	}
+}
+
+// ログイン不要の配信停止URL
+func (l *Links) UnsubscribeURL(userID int64) string {
+	return l.PublicBaseURL + "/v1/notifications/unsubscribe?token=" + url.QueryEscape(l.UnsubscribeToken(userID))
+}
+
+// ノードを開くアプリのURL（AppURLが未設定の場合は空文字）
+func (l *Links) NodeURL(pjID, nodeID string) string {
+	if l.AppURL == "" {
+		return ""
+	}
+	u, err := url.Parse(l.AppURL)
+	if err != nil {
+		return l.AppURL
+	}
+	q := u.Query()
+	q.Set("pjId", pjID)
+	q.Set("nodeId", nodeID)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// iCalendarフィードのURL（トークンのみで認証するため、URL自体を秘密として扱う）
+func (l *Links) CalendarFeedURL(token string) string {
+	return l.PublicBaseURL + "/v1/calendar/feed/" + url.PathEscape(token) + ".ics"
+}
+
+// タスク取り込み用のURL（トークンのみで認証するため、URL自体を秘密として扱う）
+func (l *Links) InboundURL(token string) string {
+	return l.PublicBaseURL + "/v1/inbound/" + url.PathEscape(token)
+}
+
+// CalDAVクライアントに設定するサーバURL
+func (l *Links) CalDAVURL() string {
+	return l.PublicBaseURL + "/caldav/"
+}
+
+// 配信停止トークン（"<userID>.<発行日時(unix秒)>.<署名>"）
+// ユーザーIDと発行日時の署名のみで状態を持たないため、メールを受け取った本人以外は作れない
+func (l *Links) UnsubscribeToken(userID int64) string {
+	return l.unsubscribeToken(userID, time.Now())
+}
+
+func (l *Links) unsubscribeToken(userID int64, issuedAt time.Time) string {
+	payload := strconv.FormatInt(userID, 10) + "." + strconv.FormatInt(issuedAt.Unix(), 10)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(l.sign(payload))
+}
+
+// 配信停止トークンを検証し、ユーザーIDを返す（有効期間を過ぎたトークンは無効）
+func (l *Links) ParseUnsubscribeToken(token string) (int64, bool) {
+	return l.parseUnsubscribeToken(token, time.Now())
+}
+
+func (l *Links) parseUnsubscribeToken(token string, now time.Time) (int64, bool) {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return 0, false
+	}
+	payload, sig := token[:i], token[i+1:]
+
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, l.sign(payload)) {
+		return 0, false
+	}
+
+	id, iat, ok := strings.Cut(payload, ".")
+	if !ok {
+		return 0, false
+	}
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, false
+	}
+	issued, err := strconv.ParseInt(iat, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	issuedAt := time.Unix(issued, 0)
+	if now.Sub(issuedAt) > UnsubscribeTokenTTL || issuedAt.Sub(now) > unsubscribeClockSkew {
+		return 0, false
+	}
+	return userID, true
+}
+
+func (l *Links) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, l.Secret)
+	mac.Write([]byte("unsubscribe:" + payload))
+	return mac.Sum(nil)
+}
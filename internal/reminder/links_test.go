@@ -0,0 +1,65 @@
+package reminder
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnsubscribeToken(t *testing.T) {
+	l := NewLinks("https://api.example.com/", "", "secret")
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	token := l.unsubscribeToken(42, now)
+
+	// 署名済み部分（ユーザーID・発行日時）と署名
+	id, rest, _ := strings.Cut(token, ".")
+	iat, sig, _ := strings.Cut(rest, ".")
+
+	tests := []struct {
+		name  string
+		token string
+		at    time.Time
+		ok    bool
+	}{
+		{"valid", token, now, true},
+		{"valid until ttl", token, now.Add(UnsubscribeTokenTTL), true},
+		{"expired", token, now.Add(UnsubscribeTokenTTL + time.Second), false},
+		{"issued in the future", token, now.Add(-time.Hour), false},
+		{"small clock skew", token, now.Add(-time.Minute), true},
+		{"other secret", NewLinks("", "", "other").unsubscribeToken(42, now), now, false},
+		{"empty", "", now, false},
+		{"no signature", id + "." + iat, now, false},
+		{"legacy format without issued at", id + "." + base64.RawURLEncoding.EncodeToString(l.sign(id)), now, false},
+		{"tampered user id", "43." + iat + "." + sig, now, false},
+		{"tampered issued at", id + ".9999999999." + sig, now, false},
+		{"tampered signature", id + "." + iat + "." + sig[:len(sig)-2] + "AA", now, false},
+	}
+
+	for _, tt := range tests {
+		userID, ok := l.parseUnsubscribeToken(tt.token, tt.at)
+		if ok != tt.ok {
+			t.Errorf("%s: ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok && userID != 42 {
+			t.Errorf("%s: userID = %d, want 42", tt.name, userID)
+		}
+	}
+}
+
+func TestUnsubscribeURL(t *testing.T) {
+	l := NewLinks("https://api.example.com/", "", "secret")
+
+	u, err := url.Parse(l.UnsubscribeURL(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != "api.example.com" || u.Path != "/v1/notifications/unsubscribe" {
+		t.Errorf("url = %s", u)
+	}
+	if userID, ok := l.ParseUnsubscribeToken(u.Query().Get("token")); !ok || userID != 7 {
+		t.Errorf("ParseUnsubscribeToken = %d, %v", userID, ok)
+	}
+}
@@ -0,0 +1,243 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/calendar"
+	"github.com/yopi416/mind-kanban-backend/internal/mail"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// node_schedulesでの予定の種類
+const (
+	KindReminder = "reminder" // 期限前のリマインダー
+	KindOverdue  = "overdue"  // 期限切れの通知
+)
+
+const (
+	// 発火時刻を過ぎてもこの時間内なら送る（リマインダーを直前に設定した場合や、停止からの復旧時）
+	reminderGrace = time.Hour
+
+	// 期限切れ通知を送る期限からの猶予（これより古い期限切れは通知しない）
+	overdueGrace = 24 * time.Hour
+)
+
+// StateFinder はminkan_statesの取得（*repository.MinkanStatesRepository）
+type StateFinder interface {
+	FindStateByUserID(ctx context.Context, userID int64) (*repository.MinkanState, error)
+}
+
+// UserFinder はユーザーの取得（*repository.UserRepository）
+type UserFinder interface {
+	FindUserByUserID(ctx context.Context, userID int64) (*repository.User, error)
+}
+
+// SettingsFinder は通知設定の取得（*repository.NotificationSettingsRepository）
+type SettingsFinder interface {
+	FindSettingsByUserID(ctx context.Context, userID int64) (*repository.NotificationSettings, error)
+}
+
+// Notifier はリマインダー・期限切れ通知のメール送信に共通の依存
+type Notifier struct {
+	StateRepo       StateFinder
+	UserRepo        UserFinder
+	SettingsRepo    SettingsFinder
+	Sender          mail.Sender
+	Links           *Links
+	DefaultLocation *time.Location
+}
+
+// ReminderTask はノードのreminders（dueAtの何分前か）に従ってメールを送る
+type ReminderTask struct {
+	*Notifier
+}
+
+// OverdueTask は未完了のまま期限を過ぎたノードをメールで知らせる
+type OverdueTask struct {
+	*Notifier
+}
+
+func (t *ReminderTask) Plan(m *repository.Minkan) []repository.NodeSchedule {
+	now := time.Now()
+	schedules := []repository.NodeSchedule{}
+
+	for _, pj := range m.Projects {
+		for _, n := range pj.Nodes {
+			d := n.Data
+			if d.IsDone || d.DueAt == nil || !d.DueAt.After(now) {
+				continue
+			}
+			for _, minutes := range d.Reminders {
+				fireAt := reminderTime(*d.DueAt, minutes)
+				if fireAt.Before(now.Add(-reminderGrace)) {
+					continue
+				}
+				schedules = append(schedules, repository.NodeSchedule{PjID: pj.Id, NodeID: n.Id, FireAt: fireAt})
+			}
+		}
+	}
+	return schedules
+}
+
+// 実行時のstateで、まだ未完了かつ同じ時刻のリマインダーが設定されている場合のみ送る
+func (t *ReminderTask) Run(ctx context.Context, sc *repository.NodeSchedule) error {
+	return t.notify(ctx, sc, func(settings *repository.NotificationSettings, n *repository.Node, now time.Time) bool {
+		if !settings.EmailReminders || !now.Before(*n.Data.DueAt) {
+			return false
+		}
+		for _, minutes := range n.Data.Reminders {
+			if reminderTime(*n.Data.DueAt, minutes).Equal(sc.FireAt) {
+				return true
+			}
+		}
+		return false
+	}, reminderMessage)
+}
+
+func (t *OverdueTask) Plan(m *repository.Minkan) []repository.NodeSchedule {
+	now := time.Now()
+	schedules := []repository.NodeSchedule{}
+
+	for _, pj := range m.Projects {
+		for _, n := range pj.Nodes {
+			d := n.Data
+			if d.IsDone || d.DueAt == nil || d.DueAt.Before(now.Add(-overdueGrace)) {
+				continue
+			}
+			schedules = append(schedules, repository.NodeSchedule{
+				PjID:   pj.Id,
+				NodeID: n.Id,
+				FireAt: d.DueAt.Truncate(time.Second), // node_schedules.fire_atは秒精度
+			})
+		}
+	}
+	return schedules
+}
+
+// 実行時のstateで、まだ未完了かつ期限が変わっていない場合のみ送る
+func (t *OverdueTask) Run(ctx context.Context, sc *repository.NodeSchedule) error {
+	return t.notify(ctx, sc, func(settings *repository.NotificationSettings, n *repository.Node, now time.Time) bool {
+		return settings.EmailOverdue &&
+			n.Data.DueAt.Truncate(time.Second).Equal(sc.FireAt) &&
+			now.Sub(*n.Data.DueAt) < overdueGrace
+	}, overdueMessage)
+}
+
+// メール本文の材料
+type content struct {
+	user  *repository.User
+	pj    *repository.Project
+	node  *repository.Node
+	loc   *time.Location
+	links *Links
+}
+
+// 共通の送信処理
+// shouldSendがfalseの場合、またはノード・宛先が無い場合は何もしない（予定は処理済みになる）
+func (n *Notifier) notify(
+	ctx context.Context,
+	sc *repository.NodeSchedule,
+	shouldSend func(settings *repository.NotificationSettings, node *repository.Node, now time.Time) bool,
+	build func(c *content) (subject, body string),
+) error {
+	lg := slog.Default().With("module", "reminder", "userID", sc.UserID, "nodeID", sc.NodeID, "kind", sc.Kind)
+
+	state, err := n.StateRepo.FindStateByUserID(ctx, sc.UserID)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return nil
+	}
+
+	m, err := minkan.Decode(state.StateJSON)
+	if err != nil {
+		return err
+	}
+
+	pj, ok := m.Projects[sc.PjID]
+	if !ok {
+		return nil
+	}
+	idx := minkan.FindNode(&pj, sc.NodeID)
+	if idx < 0 {
+		return nil
+	}
+	node := &pj.Nodes[idx]
+	if node.Data.IsDone || node.Data.DueAt == nil {
+		return nil
+	}
+
+	settings, err := n.SettingsRepo.FindSettingsByUserID(ctx, sc.UserID)
+	if err != nil {
+		return err
+	}
+	if !shouldSend(settings, node, time.Now()) {
+		lg.Debug("notification skipped")
+		return nil
+	}
+
+	user, err := n.UserRepo.FindUserByUserID(ctx, sc.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.Email == "" || !user.EmailVerified {
+		lg.Debug("notification skipped: no verified email")
+		return nil
+	}
+
+	subject, body := build(&content{
+		user:  user,
+		pj:    &pj,
+		node:  node,
+		loc:   calendar.Location(user.TimeZone, n.DefaultLocation),
+		links: n.Links,
+	})
+
+	return n.Sender.Send(ctx, &mail.Message{
+		To:              user.Email,
+		Subject:         subject,
+		Body:            body,
+		ListUnsubscribe: n.Links.UnsubscribeURL(user.UserID),
+	})
+}
+
+func reminderTime(due time.Time, minutes int) time.Time {
+	return due.Add(-time.Duration(minutes) * time.Minute).Truncate(time.Second)
+}
+
+func reminderMessage(c *content) (string, string) {
+	subject := "【Minkan】まもなく期限: " + c.node.Data.Label
+	return subject, messageBody(c, "次のタスクの期限が近づいています。")
+}
+
+func overdueMessage(c *content) (string, string) {
+	subject := "【Minkan】期限切れ: " + c.node.Data.Label
+	return subject, messageBody(c, "次のタスクが未完了のまま期限を過ぎました。")
+}
+
+var weekdaysJa = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+func messageBody(c *content, lead string) string {
+	due := c.node.Data.DueAt.In(c.loc)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s さん\n\n", c.user.DisplayName)
+	fmt.Fprintf(&b, "%s\n\n", lead)
+	fmt.Fprintf(&b, "タスク: %s\n", c.node.Data.Label)
+	fmt.Fprintf(&b, "プロジェクト: %s\n", c.pj.Name)
+	fmt.Fprintf(&b, "期限: %s (%s) %s (%s)\n\n",
+		due.Format("2006/01/02"), weekdaysJa[due.Weekday()], due.Format("15:04"), c.loc.String())
+	if link := c.links.NodeURL(c.pj.Id, c.node.Id); link != "" {
+		fmt.Fprintf(&b, "Minkanで開く: %s\n\n", link)
+	}
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "このメールの配信を停止する: %s\n", c.links.UnsubscribeURL(c.user.UserID))
+	b.WriteString("通知の設定はMinkanのユーザー設定から変更できます。\n")
+	return b.String()
+}
@@ -0,0 +1,231 @@
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/mail"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+type fakeStates struct{ state *repository.MinkanState }
+
+func (f *fakeStates) FindStateByUserID(_ context.Context, userID int64) (*repository.MinkanState, error) {
+	if f.state == nil || f.state.UserID != userID {
+		return nil, nil
+	}
+	return f.state, nil
+}
+
+type fakeUsers struct{ user *repository.User }
+
+func (f *fakeUsers) FindUserByUserID(_ context.Context, userID int64) (*repository.User, error) {
+	if f.user == nil || f.user.UserID != userID {
+		return nil, nil
+	}
+	return f.user, nil
+}
+
+type fakeSettings struct {
+	settings repository.NotificationSettings
+}
+
+func (f *fakeSettings) FindSettingsByUserID(_ context.Context, userID int64) (*repository.NotificationSettings, error) {
+	s := f.settings
+	s.UserID = userID
+	return &s, nil
+}
+
+type recordingSender struct{ sent []*mail.Message }
+
+func (s *recordingSender) Send(_ context.Context, msg *mail.Message) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// ノード1つ（n1）を持つstateの通知の依存一式
+type notifierFixture struct {
+	notifier *Notifier
+	node     *repository.Node
+	user     *repository.User
+	settings *fakeSettings
+	sender   *recordingSender
+	states   *fakeStates
+}
+
+func newNotifierFixture(t *testing.T, due time.Time, reminders []int) *notifierFixture {
+	t.Helper()
+
+	f := &notifierFixture{
+		node: &repository.Node{
+			Id: "n1",
+			Data: repository.NodeData{
+				Label:     "請求書を送る",
+				Comments:  []repository.NodeComment{},
+				DueAt:     &due,
+				Reminders: reminders,
+			},
+		},
+		user:     &repository.User{UserID: 1, DisplayName: "Taro", Email: "taro@example.com", EmailVerified: true},
+		settings: &fakeSettings{settings: repository.NotificationSettings{EmailReminders: true, EmailOverdue: true}},
+		sender:   &recordingSender{},
+		states:   &fakeStates{},
+	}
+	f.notifier = &Notifier{
+		StateRepo:       f.states,
+		UserRepo:        &fakeUsers{user: f.user},
+		SettingsRepo:    f.settings,
+		Sender:          f.sender,
+		Links:           NewLinks("https://api.example.com", "https://app.example.com/app", "secret"),
+		DefaultLocation: time.FixedZone("JST", 9*60*60),
+	}
+	f.save(t)
+	return f
+}
+
+// nodeの内容でstateを保存し直す
+func (f *notifierFixture) save(t *testing.T) {
+	t.Helper()
+	m := repository.Minkan{
+		CurrentPjId: "pj1",
+		Projects: repository.Projects{
+			"pj1": {Id: "pj1", Name: "経理", Nodes: []repository.Node{*f.node}, Edges: []repository.Edge{}},
+		},
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.states.state = &repository.MinkanState{UserID: f.user.UserID, StateJSON: b, Version: 1}
+}
+
+func (f *notifierFixture) minkan(t *testing.T) *repository.Minkan {
+	t.Helper()
+	m := &repository.Minkan{}
+	if err := json.Unmarshal(f.states.state.StateJSON, m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestReminderTask(t *testing.T) {
+	due := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	f := newNotifierFixture(t, due, []int{60, 30})
+	task := &ReminderTask{Notifier: f.notifier}
+
+	schedules := task.Plan(f.minkan(t))
+	if len(schedules) != 2 {
+		t.Fatalf("planned %d reminders, want 2: %+v", len(schedules), schedules)
+	}
+	sc := &repository.NodeSchedule{UserID: 1, PjID: "pj1", NodeID: "n1", Kind: KindReminder, FireAt: due.Add(-time.Hour)}
+
+	if err := task.Run(context.Background(), sc); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.sender.sent) != 1 {
+		t.Fatalf("sent %d mails, want 1", len(f.sender.sent))
+	}
+	msg := f.sender.sent[0]
+	if msg.To != "taro@example.com" || !strings.Contains(msg.Subject, "まもなく期限: 請求書を送る") {
+		t.Errorf("message = %+v", msg)
+	}
+	for _, want := range []string{"Taro さん", "プロジェクト: 経理", "(JST)", "https://app.example.com/app?nodeId=n1&pjId=pj1"} {
+		if !strings.Contains(msg.Body, want) {
+			t.Errorf("body does not contain %q:\n%s", want, msg.Body)
+		}
+	}
+
+	// 配信停止リンクは宛先のユーザーのトークン
+	u, err := url.Parse(msg.ListUnsubscribe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userID, ok := f.notifier.Links.ParseUnsubscribeToken(u.Query().Get("token")); !ok || userID != 1 {
+		t.Errorf("unsubscribe token = %d, %v", userID, ok)
+	}
+}
+
+// 実行時のstate・設定で送らない場合
+func TestReminderTaskSkips(t *testing.T) {
+	due := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	sc := &repository.NodeSchedule{UserID: 1, PjID: "pj1", NodeID: "n1", Kind: KindReminder, FireAt: due.Add(-time.Hour)}
+
+	tests := []struct {
+		name   string
+		modify func(t *testing.T, f *notifierFixture)
+	}{
+		{"reminders disabled", func(t *testing.T, f *notifierFixture) { f.settings.settings.EmailReminders = false }},
+		{"reminder removed", func(t *testing.T, f *notifierFixture) {
+			f.node.Data.Reminders = []int{30}
+			f.save(t)
+		}},
+		{"node done", func(t *testing.T, f *notifierFixture) {
+			f.node.Data.IsDone = true
+			f.save(t)
+		}},
+		{"node deleted", func(t *testing.T, f *notifierFixture) {
+			f.node.Id = "other"
+			f.save(t)
+		}},
+		{"email not verified", func(t *testing.T, f *notifierFixture) { f.user.EmailVerified = false }},
+		{"user without state", func(t *testing.T, f *notifierFixture) { f.states.state = nil }},
+	}
+
+	for _, tt := range tests {
+		f := newNotifierFixture(t, due, []int{60})
+		tt.modify(t, f)
+
+		if err := (&ReminderTask{Notifier: f.notifier}).Run(context.Background(), sc); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(f.sender.sent) != 0 {
+			t.Errorf("%s: sent %d mails, want none", tt.name, len(f.sender.sent))
+		}
+	}
+}
+
+func TestOverdueTask(t *testing.T) {
+	due := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	f := newNotifierFixture(t, due, nil)
+	task := &OverdueTask{Notifier: f.notifier}
+
+	schedules := task.Plan(f.minkan(t))
+	if len(schedules) != 1 || !schedules[0].FireAt.Equal(due) {
+		t.Fatalf("planned %+v, want one at %v", schedules, due)
+	}
+
+	sc := &repository.NodeSchedule{UserID: 1, PjID: "pj1", NodeID: "n1", Kind: KindOverdue, FireAt: due}
+	if err := task.Run(context.Background(), sc); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.sender.sent) != 1 || !strings.Contains(f.sender.sent[0].Subject, "期限切れ: 請求書を送る") {
+		t.Fatalf("sent %+v", f.sender.sent)
+	}
+
+	// 期限が変わった場合は送らない
+	f.sender.sent = nil
+	later := due.Add(time.Minute)
+	f.node.Data.DueAt = &later
+	f.save(t)
+	if err := task.Run(context.Background(), sc); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.sender.sent) != 0 {
+		t.Errorf("sent %d mails after dueAt changed", len(f.sender.sent))
+	}
+
+	// 猶予より古い期限切れは予定しない
+	old := time.Now().Add(-2 * overdueGrace)
+	f.node.Data.DueAt = &old
+	f.save(t)
+	if got := task.Plan(f.minkan(t)); len(got) != 0 {
+		t.Errorf("planned %+v for an old overdue node", got)
+	}
+}
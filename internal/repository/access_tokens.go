@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// AccessToken は access_tokens テーブル1行（パーソナルアクセストークン）を表す構造体
+type AccessToken struct {
+	ID         int64
+	UserID     int64
+	Name       string
+	TokenHash  []byte // トークンのSHA-256（トークン自体は保存しない）
+	Scope      string // read / write
+	ExpiresAt  *time.Time
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // 未使用の場合はnil
+}
+
+type AccessTokenRepository struct {
+	DB *sql.DB
+}
+
+func NewAccessTokenRepository(DB *sql.DB) *AccessTokenRepository {
+	return &AccessTokenRepository{DB: DB}
+}
+
+const accessTokenColumns = `id, user_id, name, token_hash, scope, expires_at, created_at, last_used_at`
+
+func scanAccessToken(sc interface{ Scan(...any) error }) (*AccessToken, error) {
+	t := &AccessToken{}
+	if err := sc.Scan(&t.ID, &t.UserID, &t.Name, &t.TokenHash, &t.Scope, &t.ExpiresAt, &t.CreatedAt, &t.LastUsedAt); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ユーザーのトークンを作成順に取得する（期限切れのものも含む）
+func (atr *AccessTokenRepository) ListAccessTokens(ctx context.Context, userID int64) ([]AccessToken, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE user_id = ?
+		ORDER BY id
+	`
+
+	rows, err := atr.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	tokens := []AccessToken{}
+	for rows.Next() {
+		t, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *t)
+	}
+	return tokens, rows.Err()
+}
+
+// トークンのハッシュから取得する（該当なしの場合はnil, nil。有効期限は呼び出し側で確認する）
+func (atr *AccessTokenRepository) FindAccessTokenByHash(ctx context.Context, tokenHash []byte) (*AccessToken, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE token_hash = ?
+	`
+
+	t, err := scanAccessToken(atr.DB.QueryRowContext(ctx, query, tokenHash))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// トークンを登録し、生成された id を返す
+// ユーザーごとの上限(limit)に達している場合は ErrLimitExceeded を返す
+func (atr *AccessTokenRepository) CreateAccessToken(ctx context.Context, t *AccessToken, limit int) (int64, error) {
+	// 上限の判定と登録を1文で行い、同時作成でも上限を超えないようにする
+	query := `
+		INSERT INTO access_tokens (user_id, name, token_hash, scope, expires_at)
+		SELECT ?, ?, ?, ?, ?
+		FROM DUAL
+		WHERE (SELECT COUNT(*) FROM access_tokens WHERE user_id = ?) < ?
+	`
+
+	res, err := atr.DB.ExecContext(ctx, query, t.UserID, t.Name, t.TokenHash, t.Scope, t.ExpiresAt, t.UserID, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, ErrLimitExceeded
+	}
+
+	return res.LastInsertId()
+}
+
+// トークンを削除する（該当なしの場合はfalse）
+func (atr *AccessTokenRepository) DeleteAccessToken(ctx context.Context, userID, id int64) (bool, error) {
+	query := `
+		DELETE FROM access_tokens
+		WHERE id = ? AND user_id = ?
+	`
+
+	res, err := atr.DB.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// 最終利用日時を記録する
+// リクエストごとの書き込みを避けるため、前回の記録からinterval以上経っている場合のみ更新する
+func (atr *AccessTokenRepository) TouchAccessToken(ctx context.Context, id int64, at time.Time, interval time.Duration) error {
+	query := `
+		UPDATE access_tokens
+		SET last_used_at = ?
+		WHERE id = ? AND (last_used_at IS NULL OR last_used_at < ?)
+	`
+
+	_, err := atr.DB.ExecContext(ctx, query, at, id, at.Add(-interval))
+	return err
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// ActivityEvent は activity_events テーブル1行（ユーザーの操作履歴1件）を表す構造体
+type ActivityEvent struct {
+	ID        int64
+	UserID    int64
+	Type      string
+	PjID      *string
+	NodeID    *string
+	Origin    string          // 更新元（stateの更新以外は空文字）
+	Version   *int32          // 更新後のstateのversion（stateの更新以外はnil）
+	Data      json.RawMessage // 種類ごとの詳細
+	CreatedAt time.Time
+}
+
+// ActivityFilter は操作履歴の絞り込み条件（nil・ゼロ値の条件は使わない）
+type ActivityFilter struct {
+	PjID     *string
+	NodeID   *string
+	From     *time.Time // この日時以降
+	To       *time.Time // この日時より前
+	BeforeID int64      // このidより古いもの（ページング用）
+}
+
+type ActivityRepository struct {
+	DB *sql.DB
+}
+
+func NewActivityRepository(DB *sql.DB) *ActivityRepository {
+	return &ActivityRepository{DB: DB}
+}
+
+// 操作履歴をまとめて記録する
+func (ar *ActivityRepository) InsertActivityEvents(ctx context.Context, evs []ActivityEvent) error {
+	if len(evs) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(evs))
+	args := make([]any, 0, len(evs)*7)
+	for _, e := range evs {
+		data := e.Data
+		if len(data) == 0 {
+			data = json.RawMessage(`{}`)
+		}
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, e.UserID, e.Type, e.PjID, e.NodeID, e.Origin, e.Version, []byte(data))
+	}
+
+	query := `
+		INSERT INTO activity_events (user_id, type, pj_id, node_id, origin, version, data_json)
+		VALUES ` + strings.Join(placeholders, ", ")
+
+	_, err := ar.DB.ExecContext(ctx, query, args...)
+	return err
+}
+
+// 操作履歴を新しい順に最大limit件取得する
+func (ar *ActivityRepository) ListActivityEvents(ctx context.Context, userID int64, f ActivityFilter, limit int) ([]ActivityEvent, error) {
+	conds := []string{"user_id = ?"}
+	args := []any{userID}
+
+	if f.PjID != nil {
+		conds = append(conds, "pj_id = ?")
+		args = append(args, *f.PjID)
+	}
+	if f.NodeID != nil {
+		conds = append(conds, "node_id = ?")
+		args = append(args, *f.NodeID)
+	}
+	if f.From != nil {
+		conds = append(conds, "created_at >= ?")
+		args = append(args, *f.From)
+	}
+	if f.To != nil {
+		conds = append(conds, "created_at < ?")
+		args = append(args, *f.To)
+	}
+	if f.BeforeID != 0 {
+		conds = append(conds, "id < ?")
+		args = append(args, f.BeforeID)
+	}
+
+	query := `
+		SELECT id, user_id, type, pj_id, node_id, origin, version, data_json, created_at
+		FROM activity_events
+		WHERE ` + strings.Join(conds, " AND ") + `
+		ORDER BY id DESC
+		LIMIT ?
+	`
+
+	rows, err := ar.DB.QueryContext(ctx, query, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	evs := []ActivityEvent{}
+	for rows.Next() {
+		var e ActivityEvent
+		if err := rows.Scan(
+			&e.ID,
+			&e.UserID,
+			&e.Type,
+			&e.PjID,
+			&e.NodeID,
+			&e.Origin,
+			&e.Version,
+			&e.Data,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		evs = append(evs, e)
+	}
+	return evs, rows.Err()
+}
+
+// 退会時に、指定した種類以外の操作履歴を削除する（退会申請の記録は残す）
+func (ar *ActivityRepository) DeleteActivityEventsExcept(ctx context.Context, userID int64, keepTypes ...string) error {
+	query := `
+		DELETE FROM activity_events
+		WHERE user_id = ?
+	`
+	args := []any{userID}
+	if len(keepTypes) > 0 {
+		query += ` AND type NOT IN (?` + strings.Repeat(", ?", len(keepTypes)-1) + `)`
+		for _, t := range keepTypes {
+			args = append(args, t)
+		}
+	}
+
+	_, err := ar.DB.ExecContext(ctx, query, args...)
+	return err
+}
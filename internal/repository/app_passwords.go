@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// AppPassword は app_passwords テーブル1行（CalDAV等、OIDCでログインできないクライアント用のパスワード）を表す構造体
+type AppPassword struct {
+	ID           int64
+	UserID       int64
+	Name         string // 利用者が付ける名前（例 "iPhone リマインダー"）
+	PasswordHash []byte // パスワードのSHA-256（パスワード自体は保存しない）
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time // 未使用の場合はnil
+}
+
+type AppPasswordRepository struct {
+	DB *sql.DB
+}
+
+func NewAppPasswordRepository(DB *sql.DB) *AppPasswordRepository {
+	return &AppPasswordRepository{DB: DB}
+}
+
+// ユーザーのアプリパスワードを作成順に取得する
+func (apr *AppPasswordRepository) ListAppPasswords(ctx context.Context, userID int64) ([]AppPassword, error) {
+	query := `
+		SELECT id, user_id, name, password_hash, created_at, last_used_at
+		FROM app_passwords
+		WHERE user_id = ?
+		ORDER BY id
+	`
+
+	rows, err := apr.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	passwords := []AppPassword{}
+	for rows.Next() {
+		p := AppPassword{}
+		if err := rows.Scan(&p.ID, &p.UserID, &p.Name, &p.PasswordHash, &p.CreatedAt, &p.LastUsedAt); err != nil {
+			return nil, err
+		}
+		passwords = append(passwords, p)
+	}
+	return passwords, rows.Err()
+}
+
+// パスワードのハッシュから取得する（該当なしの場合はnil, nil）
+func (apr *AppPasswordRepository) FindAppPasswordByHash(ctx context.Context, passwordHash []byte) (*AppPassword, error) {
+	query := `
+		SELECT id, user_id, name, password_hash, created_at, last_used_at
+		FROM app_passwords
+		WHERE password_hash = ?
+	`
+
+	row := apr.DB.QueryRowContext(ctx, query, passwordHash)
+	p := &AppPassword{}
+	err := row.Scan(&p.ID, &p.UserID, &p.Name, &p.PasswordHash, &p.CreatedAt, &p.LastUsedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// アプリパスワードを登録し、生成された id を返す
+// ユーザーごとの上限(limit)に達している場合は ErrLimitExceeded を返す
+func (apr *AppPasswordRepository) CreateAppPassword(ctx context.Context, userID int64, name string, passwordHash []byte, limit int) (int64, error) {
+	// 上限の判定と登録を1文で行い、同時作成でも上限を超えないようにする
+	query := `
+		INSERT INTO app_passwords (user_id, name, password_hash)
+		SELECT ?, ?, ?
+		FROM DUAL
+		WHERE (SELECT COUNT(*) FROM app_passwords WHERE user_id = ?) < ?
+	`
+
+	res, err := apr.DB.ExecContext(ctx, query, userID, name, passwordHash, userID, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, ErrLimitExceeded
+	}
+
+	return res.LastInsertId()
+}
+
+// アプリパスワードを削除する（該当なしの場合はfalse）
+func (apr *AppPasswordRepository) DeleteAppPassword(ctx context.Context, userID, id int64) (bool, error) {
+	query := `
+		DELETE FROM app_passwords
+		WHERE id = ? AND user_id = ?
+	`
+
+	res, err := apr.DB.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// 最終利用日時を記録する
+// リクエストごとの書き込みを避けるため、前回の記録からinterval以上経っている場合のみ更新する
+func (apr *AppPasswordRepository) TouchAppPassword(ctx context.Context, id int64, at time.Time, interval time.Duration) error {
+	query := `
+		UPDATE app_passwords
+		SET last_used_at = ?
+		WHERE id = ? AND (last_used_at IS NULL OR last_used_at < ?)
+	`
+
+	_, err := apr.DB.ExecContext(ctx, query, at, id, at.Add(-interval))
+	return err
+}
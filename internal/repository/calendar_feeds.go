@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// CalendarFeed は calendar_feeds テーブル1行（ユーザーごとのiCalendarフィードのトークン）を表す構造体
+type CalendarFeed struct {
+	UserID         int64
+	TokenHash      []byte // トークンのSHA-256（トークン自体は保存しない）
+	CreatedAt      time.Time
+	LastAccessedAt *time.Time // 未取得の場合はnil
+}
+
+type CalendarFeedRepository struct {
+	DB *sql.DB
+}
+
+func NewCalendarFeedRepository(DB *sql.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{DB: DB}
+}
+
+// ユーザーのフィードを取得する（未発行の場合はnil, nil）
+func (cfr *CalendarFeedRepository) FindFeedByUserID(ctx context.Context, userID int64) (*CalendarFeed, error) {
+	query := `
+		SELECT user_id, token_hash, created_at, last_accessed_at
+		FROM calendar_feeds
+		WHERE user_id = ?
+	`
+	return cfr.findFeed(ctx, query, userID)
+}
+
+// トークンのハッシュからフィードを取得する（該当なしの場合はnil, nil）
+func (cfr *CalendarFeedRepository) FindFeedByTokenHash(ctx context.Context, tokenHash []byte) (*CalendarFeed, error) {
+	query := `
+		SELECT user_id, token_hash, created_at, last_accessed_at
+		FROM calendar_feeds
+		WHERE token_hash = ?
+	`
+	return cfr.findFeed(ctx, query, tokenHash)
+}
+
+func (cfr *CalendarFeedRepository) findFeed(ctx context.Context, query string, arg any) (*CalendarFeed, error) {
+	row := cfr.DB.QueryRowContext(ctx, query, arg)
+	feed := &CalendarFeed{}
+	err := row.Scan(
+		&feed.UserID,
+		&feed.TokenHash,
+		&feed.CreatedAt,
+		&feed.LastAccessedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
+
+// トークンを発行する（発行済みの場合は置き換え、以前のトークンは使えなくなる）
+func (cfr *CalendarFeedRepository) UpsertFeed(ctx context.Context, userID int64, tokenHash []byte) error {
+	query := `
+		INSERT INTO calendar_feeds (user_id, token_hash)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE
+			token_hash = VALUES(token_hash),
+			created_at = CURRENT_TIMESTAMP,
+			last_accessed_at = NULL
+	`
+
+	_, err := cfr.DB.ExecContext(ctx, query, userID, tokenHash)
+	return err
+}
+
+// トークンを無効化する（未発行の場合も成功とする）
+func (cfr *CalendarFeedRepository) DeleteFeed(ctx context.Context, userID int64) error {
+	query := `
+		DELETE FROM calendar_feeds
+		WHERE user_id = ?
+	`
+
+	_, err := cfr.DB.ExecContext(ctx, query, userID)
+	return err
+}
+
+// 最終取得日時を記録する
+func (cfr *CalendarFeedRepository) TouchFeed(ctx context.Context, userID int64, at time.Time) error {
+	query := `
+		UPDATE calendar_feeds
+		SET last_accessed_at = ?
+		WHERE user_id = ?
+	`
+
+	_, err := cfr.DB.ExecContext(ctx, query, at, userID)
+	return err
+}
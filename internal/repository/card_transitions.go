@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// CardTransition は card_transitions テーブル1行（カード1枚のカラム移動）を表す構造体
+type CardTransition struct {
+	ID             int64
+	UserID         int64
+	PjID           string
+	NodeID         string
+	FromColumn     string // ""はカンバン外からの追加
+	ToColumn       string // ""はカンバンからの除外
+	Version        int32
+	TransitionedAt time.Time
+}
+
+type CardTransitionRepository struct {
+	DB *sql.DB
+}
+
+func NewCardTransitionRepository(DB *sql.DB) *CardTransitionRepository {
+	return &CardTransitionRepository{DB: DB}
+}
+
+// カラム移動をまとめて記録する
+func (ctr *CardTransitionRepository) InsertCardTransitions(ctx context.Context, ts []CardTransition) error {
+	if len(ts) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(ts))
+	args := make([]any, 0, len(ts)*7)
+	for _, t := range ts {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, t.UserID, t.PjID, t.NodeID, t.FromColumn, t.ToColumn, t.Version, t.TransitionedAt)
+	}
+
+	query := `
+		INSERT INTO card_transitions (user_id, pj_id, node_id, from_column, to_column, version, transitioned_at)
+		VALUES ` + strings.Join(placeholders, ", ")
+
+	_, err := ctr.DB.ExecContext(ctx, query, args...)
+	return err
+}
+
+// until より前のカラム移動を古い順に取得する（pjIDがnil以外の場合はそのプロジェクトのみ）
+func (ctr *CardTransitionRepository) ListCardTransitions(ctx context.Context, userID int64, pjID *string, until time.Time) ([]CardTransition, error) {
+	query := `
+		SELECT id, user_id, pj_id, node_id, from_column, to_column, version, transitioned_at
+		FROM card_transitions
+		WHERE user_id = ? AND (? IS NULL OR pj_id = ?) AND transitioned_at < ?
+		ORDER BY transitioned_at, id
+	`
+
+	rows, err := ctr.DB.QueryContext(ctx, query, userID, pjID, pjID, until)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	ts := []CardTransition{}
+	for rows.Next() {
+		var t CardTransition
+		if err := rows.Scan(
+			&t.ID,
+			&t.UserID,
+			&t.PjID,
+			&t.NodeID,
+			&t.FromColumn,
+			&t.ToColumn,
+			&t.Version,
+			&t.TransitionedAt,
+		); err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	return ts, rows.Err()
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CRDTOp は crdt_ops テーブル1行（オフライン同期の操作ログ）を表す構造体
+type CRDTOp struct {
+	Seq        int64
+	UserID     int64
+	ReplicaID  string
+	Counter    uint64
+	OpJSON     json.RawMessage
+	ReceivedAt time.Time
+}
+
+// CRDTSnapshot は crdt_snapshots テーブル1行（操作ログを圧縮したドキュメント）を表す構造体
+type CRDTSnapshot struct {
+	UserID       int64
+	DocJSON      json.RawMessage // nilの場合は未初期化
+	UptoSeq      int64           // DocJSONに反映済みの操作ログの最大seq
+	PrunedSeq    int64           // このseq以下の操作ログは削除済み
+	StateVersion int32           // ドキュメントと一致しているminkan_statesのversion
+	UpdatedAt    time.Time
+}
+
+type CRDTRepository struct {
+	DB *sql.DB
+}
+
+func NewCRDTRepository(DB *sql.DB) *CRDTRepository {
+	return &CRDTRepository{DB: DB}
+}
+
+// スナップショット行が無ければ未初期化の行を作成する
+// 同一ユーザーの同期処理をスナップショット行のロックで直列化するため、事前に行を用意しておく
+func (cr *CRDTRepository) EnsureSnapshot(ctx context.Context, userID int64) error {
+	query := `
+		INSERT IGNORE INTO crdt_snapshots (user_id, doc_json, upto_seq, pruned_seq, state_version)
+		VALUES (?, NULL, 0, 0, 0)
+	`
+
+	_, err := cr.DB.ExecContext(ctx, query, userID)
+	return err
+}
+
+// スナップショットを排他ロック付きで取得する
+// 見つからない場合、return, nil, nil
+func (cr *CRDTRepository) LockSnapshot(ctx context.Context, tx *sql.Tx, userID int64) (*CRDTSnapshot, error) {
+	query := `
+		SELECT user_id, doc_json, upto_seq, pruned_seq, state_version, updated_at
+		FROM crdt_snapshots
+		WHERE user_id = ?
+		FOR UPDATE
+	`
+
+	row := tx.QueryRowContext(ctx, query, userID)
+	snap := &CRDTSnapshot{}
+	var docJSON []byte
+	err := row.Scan(
+		&snap.UserID,
+		&docJSON,
+		&snap.UptoSeq,
+		&snap.PrunedSeq,
+		&snap.StateVersion,
+		&snap.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if docJSON != nil {
+		snap.DocJSON = docJSON
+	}
+	return snap, nil
+}
+
+// スナップショットを更新する
+func (cr *CRDTRepository) UpdateSnapshot(ctx context.Context, tx *sql.Tx, snap *CRDTSnapshot) error {
+	query := `
+		UPDATE crdt_snapshots
+		SET doc_json = ?, upto_seq = ?, pruned_seq = ?, state_version = ?
+		WHERE user_id = ?
+	`
+
+	var docJSON any
+	if snap.DocJSON != nil {
+		docJSON = []byte(snap.DocJSON)
+	}
+
+	_, err := tx.ExecContext(ctx, query, docJSON, snap.UptoSeq, snap.PrunedSeq, snap.StateVersion, snap.UserID)
+	return err
+}
+
+// 操作ログを追記し、採番されたseqを返す
+// 同じ(レプリカ, カウンタ)の操作が既にある場合は追記せず、inserted=falseを返す
+func (cr *CRDTRepository) AppendOp(ctx context.Context, tx *sql.Tx, userID int64, replicaID string, counter uint64, opJSON json.RawMessage) (seq int64, inserted bool, err error) {
+	query := `
+		INSERT IGNORE INTO crdt_ops (user_id, replica_id, counter, op_json)
+		VALUES (?, ?, ?, ?)
+	`
+
+	res, err := tx.ExecContext(ctx, query, userID, replicaID, counter, []byte(opJSON))
+	if err != nil {
+		return 0, false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, false, err
+	}
+	if rows == 0 {
+		return 0, false, nil
+	}
+
+	seq, err = res.LastInsertId()
+	if err != nil {
+		return 0, false, err
+	}
+	return seq, true, nil
+}
+
+// afterSeqより後の操作ログをseq順に取得する
+func (cr *CRDTRepository) ListOpsAfter(ctx context.Context, tx *sql.Tx, userID int64, afterSeq int64) ([]CRDTOp, error) {
+	query := `
+		SELECT seq, user_id, replica_id, counter, op_json, received_at
+		FROM crdt_ops
+		WHERE user_id = ? AND seq > ?
+		ORDER BY seq
+	`
+
+	rows, err := tx.QueryContext(ctx, query, userID, afterSeq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	ops := []CRDTOp{}
+	for rows.Next() {
+		op := CRDTOp{}
+		if err := rows.Scan(&op.Seq, &op.UserID, &op.ReplicaID, &op.Counter, &op.OpJSON, &op.ReceivedAt); err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// seq以下の操作ログを削除する（スナップショットへの圧縮後に使用）
+func (cr *CRDTRepository) DeleteOpsUpTo(ctx context.Context, tx *sql.Tx, userID int64, seq int64) error {
+	query := `
+		DELETE FROM crdt_ops
+		WHERE user_id = ? AND seq <= ?
+	`
+
+	_, err := tx.ExecContext(ctx, query, userID, seq)
+	return err
+}
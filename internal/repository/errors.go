@@ -3,3 +3,9 @@ package repository
 import "errors"
 
 var ErrOptimisticLock = errors.New("optimistic lock conflict")
+
+// ユーザーごとの登録件数の上限に達している
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// アカウントに紐付いた最後のログイン方法は解除できない
+var ErrLastIdentity = errors.New("last identity")
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// InboundToken は inbound_tokens テーブル1行（ユーザーごとのタスク取り込み用トークン）を表す構造体
+type InboundToken struct {
+	UserID     int64
+	TokenHash  []byte  // トークンのSHA-256（トークン自体は保存しない）
+	PjID       *string // 取り込み先のプロジェクト（nilの場合は作業中のプロジェクト）
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // 未使用の場合はnil
+}
+
+type InboundTokenRepository struct {
+	DB *sql.DB
+}
+
+func NewInboundTokenRepository(DB *sql.DB) *InboundTokenRepository {
+	return &InboundTokenRepository{DB: DB}
+}
+
+// ユーザーのトークンを取得する（未発行の場合はnil, nil）
+func (itr *InboundTokenRepository) FindInboundTokenByUserID(ctx context.Context, userID int64) (*InboundToken, error) {
+	query := `
+		SELECT user_id, token_hash, pj_id, created_at, last_used_at
+		FROM inbound_tokens
+		WHERE user_id = ?
+	`
+	return itr.findInboundToken(ctx, query, userID)
+}
+
+// トークンのハッシュから取得する（該当なしの場合はnil, nil）
+func (itr *InboundTokenRepository) FindInboundTokenByHash(ctx context.Context, tokenHash []byte) (*InboundToken, error) {
+	query := `
+		SELECT user_id, token_hash, pj_id, created_at, last_used_at
+		FROM inbound_tokens
+		WHERE token_hash = ?
+	`
+	return itr.findInboundToken(ctx, query, tokenHash)
+}
+
+func (itr *InboundTokenRepository) findInboundToken(ctx context.Context, query string, arg any) (*InboundToken, error) {
+	row := itr.DB.QueryRowContext(ctx, query, arg)
+	t := &InboundToken{}
+	err := row.Scan(
+		&t.UserID,
+		&t.TokenHash,
+		&t.PjID,
+		&t.CreatedAt,
+		&t.LastUsedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// トークンを発行する（発行済みの場合は置き換え、以前のトークンは使えなくなる）
+func (itr *InboundTokenRepository) UpsertInboundToken(ctx context.Context, userID int64, tokenHash []byte, pjID *string) error {
+	query := `
+		INSERT INTO inbound_tokens (user_id, token_hash, pj_id)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			token_hash = VALUES(token_hash),
+			pj_id = VALUES(pj_id),
+			created_at = CURRENT_TIMESTAMP,
+			last_used_at = NULL
+	`
+
+	_, err := itr.DB.ExecContext(ctx, query, userID, tokenHash, pjID)
+	return err
+}
+
+// 取り込み先のプロジェクトを変更する（未発行の場合はfalse）
+func (itr *InboundTokenRepository) UpdateInboundPjID(ctx context.Context, userID int64, pjID *string) (bool, error) {
+	query := `
+		UPDATE inbound_tokens
+		SET pj_id = ?
+		WHERE user_id = ?
+	`
+
+	if _, err := itr.DB.ExecContext(ctx, query, pjID, userID); err != nil {
+		return false, err
+	}
+
+	// 値が同じ場合はRowsAffectedが0になるため、存在確認は別途行う
+	t, err := itr.FindInboundTokenByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return t != nil, nil
+}
+
+// トークンを無効化する（未発行の場合も成功とする）
+func (itr *InboundTokenRepository) DeleteInboundToken(ctx context.Context, userID int64) error {
+	query := `
+		DELETE FROM inbound_tokens
+		WHERE user_id = ?
+	`
+
+	_, err := itr.DB.ExecContext(ctx, query, userID)
+	return err
+}
+
+// 最終使用日時を記録する
+func (itr *InboundTokenRepository) TouchInboundToken(ctx context.Context, userID int64, at time.Time) error {
+	query := `
+		UPDATE inbound_tokens
+		SET last_used_at = ?
+		WHERE user_id = ?
+	`
+
+	_, err := itr.DB.ExecContext(ctx, query, at, userID)
+	return err
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Extra は構造体のフィールドに無いJSONの項目
+// minkan_states.state_json はフロントエンド（XYFlow）が任意の項目を持たせる前提のJSONのため、
+// 構造体で扱わない項目（ノードの大きさ・スタイル等）は各構造体の Extra に保持し、JSON化の際にそのまま書き戻す
+// （サーバ側の部分更新で読み書きしても、フロントエンドの項目が消えないようにする）
+type Extra map[string]json.RawMessage
+
+// 構造体の型ごとのJSONのキー（フィールドに対応するもの）
+var knownKeysCache sync.Map // reflect.Type -> []string
+
+func knownKeys(t reflect.Type) []string {
+	if keys, ok := knownKeysCache.Load(t); ok {
+		return keys.([]string)
+	}
+
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		keys = append(keys, name)
+	}
+	knownKeysCache.Store(t, keys)
+	return keys
+}
+
+// vに読み込み、フィールドに無い項目を返す
+// encoding/json はキーを大文字小文字を区別せずに照合するため、フィールドに読み込まれた項目は同じ規則で除く
+func unmarshalWithExtra(data []byte, v any) (Extra, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	keys := knownKeys(reflect.TypeOf(v).Elem())
+	for k := range all {
+		for _, known := range keys {
+			if strings.EqualFold(k, known) {
+				delete(all, k)
+				break
+			}
+		}
+	}
+
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return all, nil
+}
+
+// vをJSON化し、フィールドに無い項目を書き戻す
+func marshalWithExtra(v any, extra Extra) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+	for k, raw := range extra {
+		if _, ok := all[k]; !ok {
+			all[k] = raw
+		}
+	}
+	return json.Marshal(all)
+}
+
+func (m *Minkan) UnmarshalJSON(data []byte) error {
+	type plain Minkan
+	extra, err := unmarshalWithExtra(data, (*plain)(m))
+	m.Extra = extra
+	return err
+}
+
+func (m Minkan) MarshalJSON() ([]byte, error) {
+	type plain Minkan
+	return marshalWithExtra(plain(m), m.Extra)
+}
+
+func (pj *Project) UnmarshalJSON(data []byte) error {
+	type plain Project
+	extra, err := unmarshalWithExtra(data, (*plain)(pj))
+	pj.Extra = extra
+	return err
+}
+
+func (pj Project) MarshalJSON() ([]byte, error) {
+	type plain Project
+	return marshalWithExtra(plain(pj), pj.Extra)
+}
+
+func (n *Node) UnmarshalJSON(data []byte) error {
+	type plain Node
+	extra, err := unmarshalWithExtra(data, (*plain)(n))
+	n.Extra = extra
+	return err
+}
+
+func (n Node) MarshalJSON() ([]byte, error) {
+	type plain Node
+	return marshalWithExtra(plain(n), n.Extra)
+}
+
+func (d *NodeData) UnmarshalJSON(data []byte) error {
+	type plain NodeData
+	extra, err := unmarshalWithExtra(data, (*plain)(d))
+	d.Extra = extra
+	return err
+}
+
+func (d NodeData) MarshalJSON() ([]byte, error) {
+	type plain NodeData
+	return marshalWithExtra(plain(d), d.Extra)
+}
+
+func (e *Edge) UnmarshalJSON(data []byte) error {
+	type plain Edge
+	extra, err := unmarshalWithExtra(data, (*plain)(e))
+	e.Extra = extra
+	return err
+}
+
+func (e Edge) MarshalJSON() ([]byte, error) {
+	type plain Edge
+	return marshalWithExtra(plain(e), e.Extra)
+}
+
+func (c *NodeComment) UnmarshalJSON(data []byte) error {
+	type plain NodeComment
+	extra, err := unmarshalWithExtra(data, (*plain)(c))
+	c.Extra = extra
+	return err
+}
+
+func (c NodeComment) MarshalJSON() ([]byte, error) {
+	type plain NodeComment
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+func (r *NodeRecurrence) UnmarshalJSON(data []byte) error {
+	type plain NodeRecurrence
+	extra, err := unmarshalWithExtra(data, (*plain)(r))
+	r.Extra = extra
+	return err
+}
+
+func (r NodeRecurrence) MarshalJSON() ([]byte, error) {
+	type plain NodeRecurrence
+	return marshalWithExtra(plain(r), r.Extra)
+}
+
+func (c *KanbanColumns) UnmarshalJSON(data []byte) error {
+	type plain KanbanColumns
+	extra, err := unmarshalWithExtra(data, (*plain)(c))
+	c.Extra = extra
+	return err
+}
+
+func (c KanbanColumns) MarshalJSON() ([]byte, error) {
+	type plain KanbanColumns
+	return marshalWithExtra(plain(c), c.Extra)
+}
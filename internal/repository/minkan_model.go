@@ -15,6 +15,8 @@ type Edge struct {
 	// Target 接続先ノードID
 	Target string `json:"target"`
 	Type   string `json:"type"`
+
+	Extra Extra `json:"-"`
 }
 
 // KanbanCardRef defines model for KanbanCardRef.
@@ -29,6 +31,8 @@ type KanbanColumns struct {
 	Doing   []KanbanCardRef `json:"doing"`
 	Done    []KanbanCardRef `json:"done"`
 	Todo    []KanbanCardRef `json:"todo"`
+
+	Extra Extra `json:"-"`
 }
 
 // KanbanIndex 本来 pjId -> nodeIdのSetだが、Setがないのでstring[]で代替
@@ -47,6 +51,8 @@ type Minkan struct {
 
 	// Projects pjID -> Project のマップ
 	Projects Projects `json:"projects"`
+
+	Extra Extra `json:"-"`
 }
 
 // Node defines model for Node.
@@ -58,6 +64,8 @@ type Node struct {
 		Y float32 `json:"y"`
 	} `json:"position"`
 	Type string `json:"type"`
+
+	Extra Extra `json:"-"`
 }
 
 // NodeComment defines model for NodeComment.
@@ -65,6 +73,8 @@ type NodeComment struct {
 	Content   string    `json:"content"`
 	CreatedAt time.Time `json:"createdAt"`
 	Id        string    `json:"id"`
+
+	Extra Extra `json:"-"`
 }
 
 // NodeData defines model for NodeData.
@@ -73,6 +83,40 @@ type NodeData struct {
 	IsDone   bool          `json:"isDone"`
 	Label    string        `json:"label"`
 	ParentId *string       `json:"parentId"`
+
+	// 以下はschema_version 2で追加（任意項目）
+	// DueAt 期限（UTC）
+	DueAt *time.Time `json:"dueAt,omitempty"`
+
+	// StartAt 開始日時（UTC）
+	StartAt *time.Time `json:"startAt,omitempty"`
+
+	// Priority 優先度 1(最優先)〜5
+	Priority *int `json:"priority,omitempty"`
+
+	// 以下はschema_version 3で追加（任意項目）
+	// Recurrence 繰り返し設定（dueAtを起点に展開する）
+	Recurrence *NodeRecurrence `json:"recurrence,omitempty"`
+
+	// 以下はschema_version 4で追加（任意項目）
+	// Reminders リマインダーを送る時刻（dueAtの何分前か）
+	Reminders []int `json:"reminders,omitempty"`
+
+	Extra Extra `json:"-"`
+}
+
+// NodeRecurrence 繰り返し設定
+type NodeRecurrence struct {
+	// Rule RFC 5545のRRULE（サブセット）。例 "FREQ=WEEKLY;BYDAY=MO"
+	Rule string `json:"rule"`
+
+	// Mode 期限到来時の動作。"clone"は次回分を兄弟ノードとして作成、"reset"は同じノードを次回分に戻す
+	Mode string `json:"mode"`
+
+	// Start 繰り返しの起点(DTSTART)。未設定の場合は最初の発火時にdueAtを設定する（COUNTの数え始め）
+	Start *time.Time `json:"start,omitempty"`
+
+	Extra Extra `json:"-"`
 }
 
 // Project defines model for Project.
@@ -83,6 +127,8 @@ type Project struct {
 	Name      string    `json:"name"`
 	Nodes     []Node    `json:"nodes"`
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	Extra Extra `json:"-"`
 }
 
 // Projects pjID -> Project のマップ
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// MinkanRevision は minkan_revisions テーブル1行（1回の更新で生じた差分）を表す構造体
+type MinkanRevision struct {
+	UserID    int64
+	Version   int32           // この差分を適用した後のversion
+	PatchJSON json.RawMessage // version-1 → version のJSON Patch(RFC 6902)
+	Origin    string
+	CreatedAt time.Time
+}
+
+type MinkanRevisionsRepository struct {
+	DB *sql.DB
+}
+
+func NewMinkanRevisionsRepository(DB *sql.DB) *MinkanRevisionsRepository {
+	return &MinkanRevisionsRepository{DB: DB}
+}
+
+// 差分を保存する（同じversionが既にある場合は何もしない）
+func (mrr *MinkanRevisionsRepository) InsertRevision(ctx context.Context, rev *MinkanRevision) error {
+	query := `
+		INSERT IGNORE INTO minkan_revisions (user_id, version, patch_json, origin)
+		VALUES (?, ?, ?, ?)
+	`
+
+	_, err := mrr.DB.ExecContext(ctx, query, rev.UserID, rev.Version, []byte(rev.PatchJSON), rev.Origin)
+	return err
+}
+
+// afterVersionより後、uptoVersion以下の差分をversion順に取得する
+func (mrr *MinkanRevisionsRepository) ListRevisions(ctx context.Context, userID int64, afterVersion, uptoVersion int32) ([]MinkanRevision, error) {
+	query := `
+		SELECT user_id, version, patch_json, origin, created_at
+		FROM minkan_revisions
+		WHERE user_id = ? AND version > ? AND version <= ?
+		ORDER BY version
+	`
+
+	rows, err := mrr.DB.QueryContext(ctx, query, userID, afterVersion, uptoVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	revs := []MinkanRevision{}
+	for rows.Next() {
+		rev := MinkanRevision{}
+		if err := rows.Scan(&rev.UserID, &rev.Version, &rev.PatchJSON, &rev.Origin, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+		revs = append(revs, rev)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return revs, nil
+}
+
+// version以下の差分を削除する（保持件数を超えた古い履歴の整理）
+func (mrr *MinkanRevisionsRepository) DeleteRevisionsUpTo(ctx context.Context, userID int64, version int32) error {
+	query := `
+		DELETE FROM minkan_revisions
+		WHERE user_id = ? AND version <= ?
+	`
+
+	_, err := mrr.DB.ExecContext(ctx, query, userID, version)
+	return err
+}
@@ -27,6 +27,13 @@ func NewMinkanStatesRepository(DB *sql.DB) *MinkanStatesRepository {
 	return &MinkanStatesRepository{DB: DB}
 }
 
+// SchemaVersion は現在のstate_jsonのスキーマバージョン
+// - 1: 初期版
+// - 2: NodeDataに dueAt / startAt / priority を追加（任意項目のため1のJSONもそのまま2として読める）
+// - 3: NodeDataに recurrence を追加（同上）
+// - 4: NodeDataに reminders を追加（同上）
+const SchemaVersion = 4
+
 const (
 	// デフォルトノードタイプ（フロントと共通）
 	defaultNodeType = "custom"
@@ -89,16 +96,30 @@ func (msr *MinkanStatesRepository) InitState(ctx context.Context, tx *sql.Tx, us
 
 	query := `
 		INSERT INTO minkan_states (user_id, state_json, schema_version, version)
-		VALUES (?, ?, 1, 1)
+		VALUES (?, ?, ?, 1)
 	`
 
-	_, err = tx.ExecContext(ctx, query, userID, stateBytes)
+	_, err = tx.ExecContext(ctx, query, userID, stateBytes, SchemaVersion)
 	return err
 }
 
+// minkan_statesの更新と、更新から作る記録（Webhookの配信等）を同じトランザクションで書き込むために使う
+func (msr *MinkanStatesRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return msr.DB.BeginTx(ctx, nil)
+}
+
 // userIDからminkan_stateを探す
 // 見つからない場合、return, nil, nil
 func (msr *MinkanStatesRepository) FindStateByUserID(ctx context.Context, userID int64) (*MinkanState, error) {
+	return msr.findStateByUserID(ctx, msr.DB, userID)
+}
+
+// FindStateByUserIDのトランザクション版
+func (msr *MinkanStatesRepository) FindStateByUserIDTx(ctx context.Context, tx *sql.Tx, userID int64) (*MinkanState, error) {
+	return msr.findStateByUserID(ctx, tx, userID)
+}
+
+func (msr *MinkanStatesRepository) findStateByUserID(ctx context.Context, q dbtx, userID int64) (*MinkanState, error) {
 
 	query := `
 		SELECT user_id, state_json, schema_version, version, updated_at
@@ -106,7 +127,7 @@ func (msr *MinkanStatesRepository) FindStateByUserID(ctx context.Context, userID
 		WHERE user_id = ?
 	`
 
-	row := msr.DB.QueryRowContext(ctx, query, userID)
+	row := q.QueryRowContext(ctx, query, userID)
 	state := &MinkanState{}
 	err := row.Scan(
 		&state.UserID,
@@ -128,14 +149,23 @@ func (msr *MinkanStatesRepository) FindStateByUserID(ctx context.Context, userID
 
 // jsonデータを受け取り、userIDに対応するminkan_statesを更新
 func (msr *MinkanStatesRepository) UpdateStateByUserID(ctx context.Context, newStateJSON json.RawMessage, userID int64, version int32) error {
+	return msr.updateStateByUserID(ctx, msr.DB, newStateJSON, userID, version)
+}
+
+// UpdateStateByUserIDのトランザクション版
+func (msr *MinkanStatesRepository) UpdateStateByUserIDTx(ctx context.Context, tx *sql.Tx, newStateJSON json.RawMessage, userID int64, version int32) error {
+	return msr.updateStateByUserID(ctx, tx, newStateJSON, userID, version)
+}
+
+func (msr *MinkanStatesRepository) updateStateByUserID(ctx context.Context, q dbtx, newStateJSON json.RawMessage, userID int64, version int32) error {
 
 	query := `
 		UPDATE minkan_states
-		SET state_json = ?, version = version + 1
+		SET state_json = ?, schema_version = ?, version = version + 1
 		WHERE user_id = ? AND version = ?
 	`
 
-	res, err := msr.DB.ExecContext(ctx, query, newStateJSON, userID, version)
+	res, err := q.ExecContext(ctx, query, newStateJSON, SchemaVersion, userID, version)
 
 	if err != nil {
 		return err
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// NotificationSettings は notification_settings テーブル1行（ユーザーごとの通知設定）を表す構造体
+type NotificationSettings struct {
+	UserID         int64
+	EmailReminders bool // 期限前のリマインダーメール
+	EmailOverdue   bool // 期限切れの通知メール
+}
+
+type NotificationSettingsRepository struct {
+	DB *sql.DB
+}
+
+func NewNotificationSettingsRepository(DB *sql.DB) *NotificationSettingsRepository {
+	return &NotificationSettingsRepository{DB: DB}
+}
+
+// 通知設定を取得する
+// 未設定の場合は全て有効の設定を返す
+func (nsr *NotificationSettingsRepository) FindSettingsByUserID(ctx context.Context, userID int64) (*NotificationSettings, error) {
+	query := `
+		SELECT user_id, email_reminders, email_overdue
+		FROM notification_settings
+		WHERE user_id = ?
+	`
+
+	row := nsr.DB.QueryRowContext(ctx, query, userID)
+	settings := &NotificationSettings{}
+	err := row.Scan(
+		&settings.UserID,
+		&settings.EmailReminders,
+		&settings.EmailOverdue,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return &NotificationSettings{UserID: userID, EmailReminders: true, EmailOverdue: true}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// 通知設定を保存する
+func (nsr *NotificationSettingsRepository) UpsertSettings(ctx context.Context, settings *NotificationSettings) error {
+	query := `
+		INSERT INTO notification_settings (user_id, email_reminders, email_overdue)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			email_reminders = VALUES(email_reminders),
+			email_overdue = VALUES(email_overdue)
+	`
+
+	_, err := nsr.DB.ExecContext(ctx, query, settings.UserID, settings.EmailReminders, settings.EmailOverdue)
+	return err
+}
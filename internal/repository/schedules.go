@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// NodeSchedule は node_schedules テーブル1行（ノードごとの発火予定）を表す構造体
+type NodeSchedule struct {
+	UserID int64
+	PjID   string
+	NodeID string
+	Kind   string // 発火予定の種類（recurrence 等）
+	FireAt time.Time
+}
+
+type ScheduleRepository struct {
+	DB *sql.DB
+}
+
+func NewScheduleRepository(DB *sql.DB) *ScheduleRepository {
+	return &ScheduleRepository{DB: DB}
+}
+
+// ユーザーの発火予定をまとめて置き換える
+// stateVersion以降のstateから作成済みの場合は置き換えず、false を返す（フックの実行順が前後した場合の巻き戻り防止）
+func (sr *ScheduleRepository) ReplaceUserSchedules(ctx context.Context, userID int64, stateVersion int32, schedules []NodeSchedule) (replaced bool, err error) {
+	tx, err := sr.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT IGNORE INTO schedule_indexes (user_id, state_version)
+		VALUES (?, 0)
+	`, userID); err != nil {
+		return false, err
+	}
+
+	var indexed int32
+	row := tx.QueryRowContext(ctx, `
+		SELECT state_version
+		FROM schedule_indexes
+		WHERE user_id = ?
+		FOR UPDATE
+	`, userID)
+	if err := row.Scan(&indexed); err != nil {
+		return false, err
+	}
+	if indexed >= stateVersion {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM node_schedules
+		WHERE user_id = ?
+	`, userID); err != nil {
+		return false, err
+	}
+
+	for _, sc := range schedules {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO node_schedules (user_id, pj_id, node_id, kind, fire_at)
+			VALUES (?, ?, ?, ?, ?)
+		`, userID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE schedule_indexes
+		SET state_version = ?
+		WHERE user_id = ?
+	`, stateVersion, userID); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 発火時刻がnow以前の予定を古い順に最大limit件取得する
+func (sr *ScheduleRepository) ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]NodeSchedule, error) {
+	query := `
+		SELECT user_id, pj_id, node_id, kind, fire_at
+		FROM node_schedules
+		WHERE fire_at <= ?
+		ORDER BY fire_at
+		LIMIT ?
+	`
+
+	rows, err := sr.DB.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	schedules := []NodeSchedule{}
+	for rows.Next() {
+		sc := NodeSchedule{}
+		if err := rows.Scan(&sc.UserID, &sc.PjID, &sc.NodeID, &sc.Kind, &sc.FireAt); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, sc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// 処理済み・処理不要になった予定を削除する
+func (sr *ScheduleRepository) DeleteSchedule(ctx context.Context, sc *NodeSchedule) error {
+	query := `
+		DELETE FROM node_schedules
+		WHERE user_id = ? AND pj_id = ? AND node_id = ? AND kind = ? AND fire_at = ?
+	`
+
+	_, err := sr.DB.ExecContext(ctx, query, sc.UserID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt)
+	return err
+}
+
+// 予定の実行権を取得する（複数インスタンス・再起動をまたいで1回だけ実行するため）
+// - 未実行なら実行記録を作成して取得
+// - 実行中のまま lease を過ぎたもの（クラッシュ等）と、失敗して maxAttempts 未満のものは取り直す
+// 取得できなかった場合は claimed=false。既に完了・断念済みの場合は finished=true も返す
+func (sr *ScheduleRepository) ClaimRun(ctx context.Context, sc *NodeSchedule, now time.Time, lease time.Duration, maxAttempts int) (claimed bool, finished bool, err error) {
+	res, err := sr.DB.ExecContext(ctx, `
+		INSERT IGNORE INTO schedule_runs (user_id, pj_id, node_id, kind, fire_at, claimed_at, attempts)
+		VALUES (?, ?, ?, ?, ?, ?, 1)
+	`, sc.UserID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt, now)
+	if err != nil {
+		return false, false, err
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return false, false, err
+	} else if rows == 1 {
+		return true, false, nil
+	}
+
+	res, err = sr.DB.ExecContext(ctx, `
+		UPDATE schedule_runs
+		SET claimed_at = ?, attempts = attempts + 1
+		WHERE user_id = ? AND pj_id = ? AND node_id = ? AND kind = ? AND fire_at = ?
+		  AND finished_at IS NULL
+		  AND attempts < ?
+		  AND (claimed_at IS NULL OR claimed_at < ?)
+	`, now, sc.UserID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt, maxAttempts, now.Add(-lease))
+	if err != nil {
+		return false, false, err
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return false, false, err
+	} else if rows == 1 {
+		return true, false, nil
+	}
+
+	var finishedAt sql.NullTime
+	var attempts int
+	row := sr.DB.QueryRowContext(ctx, `
+		SELECT finished_at, attempts
+		FROM schedule_runs
+		WHERE user_id = ? AND pj_id = ? AND node_id = ? AND kind = ? AND fire_at = ?
+	`, sc.UserID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt)
+	err = row.Scan(&finishedAt, &attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	return false, finishedAt.Valid || attempts >= maxAttempts, nil
+}
+
+// 実行結果を記録する（runErrがnilでなければ失敗として実行権を解放し、再試行可能にする）
+func (sr *ScheduleRepository) FinishRun(ctx context.Context, sc *NodeSchedule, now time.Time, runErr error) error {
+	if runErr != nil {
+		query := `
+			UPDATE schedule_runs
+			SET claimed_at = NULL, last_error = ?
+			WHERE user_id = ? AND pj_id = ? AND node_id = ? AND kind = ? AND fire_at = ?
+		`
+
+		_, err := sr.DB.ExecContext(ctx, query, truncate(runErr.Error(), 255), sc.UserID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt)
+		return err
+	}
+
+	query := `
+		UPDATE schedule_runs
+		SET finished_at = ?, last_error = NULL
+		WHERE user_id = ? AND pj_id = ? AND node_id = ? AND kind = ? AND fire_at = ?
+	`
+
+	_, err := sr.DB.ExecContext(ctx, query, now, sc.UserID, sc.PjID, sc.NodeID, sc.Kind, sc.FireAt)
+	return err
+}
+
+// before より前に発火した実行記録を削除する（古い記録の整理）
+func (sr *ScheduleRepository) DeleteRunsBefore(ctx context.Context, before time.Time) error {
+	query := `
+		DELETE FROM schedule_runs
+		WHERE fire_at < ?
+	`
+
+	_, err := sr.DB.ExecContext(ctx, query, before)
+	return err
+}
+
+// 発火予定の再作成用に、全ユーザーのIDをafterUserIDより後から最大limit件取得する
+func (sr *ScheduleRepository) ListUserIDs(ctx context.Context, afterUserID int64, limit int) ([]int64, error) {
+	query := `
+		SELECT user_id
+		FROM minkan_states
+		WHERE user_id > ?
+		ORDER BY user_id
+		LIMIT ?
+	`
+
+	rows, err := sr.DB.QueryContext(ctx, query, afterUserID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
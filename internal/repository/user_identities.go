@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// UserIdentity は user_identities テーブル1行（アカウントに紐付いたIdPのログイン）を表す構造体
+type UserIdentity struct {
+	ID          int64
+	UserID      int64
+	Provider    string // AUTH_PROVIDERS の識別子
+	OIDCIss     string
+	OIDCSub     string
+	Email       string // 未取得の場合は空
+	CreatedAt   time.Time
+	LastLoginAt *time.Time // 連携後に未ログインの場合はnil
+}
+
+type UserIdentityRepository struct {
+	DB *sql.DB
+}
+
+func NewUserIdentityRepository(DB *sql.DB) *UserIdentityRepository {
+	return &UserIdentityRepository{DB: DB}
+}
+
+const userIdentityColumns = `id, user_id, provider, oidc_iss, oidc_sub, COALESCE(email, ''), created_at, last_login_at`
+
+func scanUserIdentity(sc interface{ Scan(...any) error }) (*UserIdentity, error) {
+	ui := &UserIdentity{}
+	if err := sc.Scan(&ui.ID, &ui.UserID, &ui.Provider, &ui.OIDCIss, &ui.OIDCSub, &ui.Email, &ui.CreatedAt, &ui.LastLoginAt); err != nil {
+		return nil, err
+	}
+	return ui, nil
+}
+
+// ユーザーのIdPのログインを連携順に取得する
+func (uir *UserIdentityRepository) ListUserIdentities(ctx context.Context, userID int64) ([]UserIdentity, error) {
+	query := `
+		SELECT ` + userIdentityColumns + `
+		FROM user_identities
+		WHERE user_id = ?
+		ORDER BY id
+	`
+
+	rows, err := uir.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	identities := []UserIdentity{}
+	for rows.Next() {
+		ui, err := scanUserIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, *ui)
+	}
+	return identities, rows.Err()
+}
+
+// iss, subから取得する（該当なしの場合はnil, nil）
+func (uir *UserIdentityRepository) FindUserIdentity(ctx context.Context, oidcIss, oidcSub string) (*UserIdentity, error) {
+	query := `
+		SELECT ` + userIdentityColumns + `
+		FROM user_identities
+		WHERE oidc_iss = ? AND oidc_sub = ?
+	`
+
+	ui, err := scanUserIdentity(uir.DB.QueryRowContext(ctx, query, oidcIss, oidcSub))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return ui, nil
+}
+
+// ユーザーの新規登録と同じトランザクションで最初のIdPを紐付け、生成された id を返す
+func (uir *UserIdentityRepository) CreateUserIdentity(ctx context.Context, tx *sql.Tx, ui *UserIdentity) (int64, error) {
+	return uir.createUserIdentity(ctx, tx, ui)
+}
+
+// ログイン中のユーザーにIdPを追加で紐付け、生成された id を返す
+// 他のユーザーに紐付いているかは呼び出し側で FindUserIdentity により確認する（同時実行時は一意制約のエラー）
+func (uir *UserIdentityRepository) LinkUserIdentity(ctx context.Context, ui *UserIdentity) (int64, error) {
+	return uir.createUserIdentity(ctx, uir.DB, ui)
+}
+
+func (uir *UserIdentityRepository) createUserIdentity(ctx context.Context, q dbtx, ui *UserIdentity) (int64, error) {
+	query := `
+		INSERT INTO user_identities (user_id, provider, oidc_iss, oidc_sub, email)
+		VALUES (?, ?, ?, ?, NULLIF(?, ''))
+	`
+
+	res, err := q.ExecContext(ctx, query, ui.UserID, ui.Provider, ui.OIDCIss, ui.OIDCSub, ui.Email)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+// 連携を解除する（該当なしの場合はfalse）
+// 最後の1件の場合はログインできなくなるため ErrLastIdentity を返す
+func (uir *UserIdentityRepository) DeleteUserIdentity(ctx context.Context, userID, id int64) (bool, error) {
+	tx, err := uir.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 同時に解除された場合に0件にならないよう、ユーザーの行をロックして数える
+	var count int
+	query := `
+		SELECT COUNT(*)
+		FROM user_identities
+		WHERE user_id = ?
+		FOR UPDATE
+	`
+	if err := tx.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return false, err
+	}
+
+	query = `
+		DELETE FROM user_identities
+		WHERE id = ? AND user_id = ?
+	`
+	res, err := tx.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	if count <= 1 {
+		return false, ErrLastIdentity
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 最終ログイン日時を現在時刻で更新
+func (uir *UserIdentityRepository) UpdateLastLoginAt(ctx context.Context, id int64) error {
+	query := `
+		UPDATE user_identities
+		SET last_login_at = NOW()
+		WHERE id = ?
+	`
+
+	_, err := uir.DB.ExecContext(ctx, query, id)
+
+	return err
+}
@@ -8,11 +8,12 @@ import (
 
 type User struct {
 	UserID        int64
-	OIDCIss       string
+	OIDCIss       string // 登録時のIdP（ログイン時の照合は user_identities で行う）
 	OIDCSub       string
 	DisplayName   string
 	Email         string
 	EmailVerified bool
+	TimeZone      string // IANAタイムゾーン名。未設定の場合は空文字（サーバのデフォルトを使う）
 }
 
 type UserRepository struct {
@@ -29,7 +30,7 @@ func (ur *UserRepository) CreateUser(ctx context.Context, tx *sql.Tx, user *User
 	// user_idはAuto Incrementなので未登録でOK
 	query := `
 		INSERT INTO users (oidc_iss, oidc_sub, display_name, email, email_verified)
-		VALUES (?, ?, ?, ?, ?)
+		VALUES (?, ?, ?, NULLIF(?, ''), ?)
 	`
 
 	res, err := tx.ExecContext(ctx, query,
@@ -47,17 +48,20 @@ func (ur *UserRepository) CreateUser(ctx context.Context, tx *sql.Tx, user *User
 	return userID, nil
 }
 
-// oidcのiss, subからuserDataを探す
+// メールアドレスからuserDataを探す（別のIdPでの登録の確認に使う）
 // 見つからない場合、return, nil, nil
-func (ur *UserRepository) FindUserByOIDC(ctx context.Context, oidcIss, oidcSub string) (*User, error) {
+func (ur *UserRepository) FindUserByEmail(ctx context.Context, email string) (*User, error) {
 
 	query := `
-		SELECT user_id, oidc_iss, oidc_sub, display_name, email, email_verified
+		SELECT user_id, oidc_iss, oidc_sub, display_name, COALESCE(email, ''), email_verified, COALESCE(time_zone, '')
 		FROM users
-		WHERE oidc_iss = ? AND oidc_sub = ?
+		WHERE email = ?
 	`
 
-	row := ur.DB.QueryRowContext(ctx, query, oidcIss, oidcSub)
+	return ur.findUser(ur.DB.QueryRowContext(ctx, query, email))
+}
+
+func (ur *UserRepository) findUser(row *sql.Row) (*User, error) {
 	user := &User{}
 	err := row.Scan(
 		&user.UserID,
@@ -66,6 +70,7 @@ func (ur *UserRepository) FindUserByOIDC(ctx context.Context, oidcIss, oidcSub s
 		&user.DisplayName,
 		&user.Email,
 		&user.EmailVerified,
+		&user.TimeZone,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -83,30 +88,12 @@ func (ur *UserRepository) FindUserByOIDC(ctx context.Context, oidcIss, oidcSub s
 func (ur *UserRepository) FindUserByUserID(ctx context.Context, userID int64) (*User, error) {
 
 	query := `
-		SELECT user_id, oidc_iss, oidc_sub, display_name, email, email_verified
+		SELECT user_id, oidc_iss, oidc_sub, display_name, COALESCE(email, ''), email_verified, COALESCE(time_zone, '')
 		FROM users
 		WHERE user_id = ?
 	`
 
-	row := ur.DB.QueryRowContext(ctx, query, userID)
-	user := &User{}
-	err := row.Scan(
-		&user.UserID,
-		&user.OIDCIss,
-		&user.OIDCSub,
-		&user.DisplayName,
-		&user.Email,
-		&user.EmailVerified,
-	)
-
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, nil // 該当ユーザーなし
-	}
-
-	if err != nil {
-		return nil, err
-	}
-	return user, nil
+	return ur.findUser(ur.DB.QueryRowContext(ctx, query, userID))
 }
 
 // 最終ログイン日時を現在時刻で更新
@@ -122,6 +109,19 @@ func (ur *UserRepository) UpdateLastLoginAt(ctx context.Context, userID int64) e
 	return err
 }
 
+// タイムゾーンを更新する（空文字の場合は未設定に戻す）
+func (ur *UserRepository) UpdateTimeZone(ctx context.Context, userID int64, timeZone string) error {
+	query := `
+		UPDATE users
+		SET time_zone = NULLIF(?, '')
+		WHERE user_id = ?
+	`
+
+	_, err := ur.DB.ExecContext(ctx, query, timeZone, userID)
+
+	return err
+}
+
 // 指定されたユーザーを削除する（ON DELETE CASCADE により関連 minkan_states も削除される）
 func (ur *UserRepository) DeleteUser(ctx context.Context, userID int64) error {
 	query := `
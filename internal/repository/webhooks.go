@@ -0,0 +1,358 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Webhook は webhooks テーブル1行（ユーザーが登録した送信先）を表す構造体
+type Webhook struct {
+	ID         int64
+	UserID     int64
+	URL        string
+	Secret     string   // 署名(HMAC)の鍵。送信時に使うため平文で保存する
+	EventTypes []string // 購読するイベントの種類
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 配信の状態
+const (
+	DeliveryPending   = "pending"   // 未送信・再送待ち
+	DeliverySucceeded = "succeeded" // 送信成功（2xx）
+	DeliveryFailed    = "failed"    // 最大試行回数を超えて断念
+)
+
+// WebhookDelivery は webhook_deliveries テーブル1行（イベント1件の1送信先への配信）を表す構造体
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	UserID         int64
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage // 送信するJSON（署名もこのバイト列に対して行う）
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int   // 最後の送信のHTTPステータス（接続失敗等の場合はnil）
+	LastError      string // 最後の送信の失敗理由
+	CreatedAt      time.Time
+	FinishedAt     *time.Time
+
+	// 以下は送信時のみ（ClaimDeliveriesでwebhooksから取得）
+	URL    string
+	Secret string
+}
+
+type WebhookRepository struct {
+	DB *sql.DB
+}
+
+func NewWebhookRepository(DB *sql.DB) *WebhookRepository {
+	return &WebhookRepository{DB: DB}
+}
+
+const webhookColumns = `id, user_id, url, secret, event_types, active, created_at, updated_at`
+
+func scanWebhook(sc interface{ Scan(...any) error }) (*Webhook, error) {
+	w := &Webhook{}
+	var eventTypes []byte
+	if err := sc.Scan(&w.ID, &w.UserID, &w.URL, &w.Secret, &eventTypes, &w.Active, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(eventTypes, &w.EventTypes); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ユーザーのWebhookを登録順に取得する（activeOnlyの場合は有効なもののみ）
+func (wr *WebhookRepository) ListWebhooks(ctx context.Context, userID int64, activeOnly bool) ([]Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE user_id = ? AND (active = 1 OR ? = 0)
+		ORDER BY id
+	`
+
+	rows, err := wr.DB.QueryContext(ctx, query, userID, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	webhooks := []Webhook{}
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *w)
+	}
+	return webhooks, rows.Err()
+}
+
+// Webhookを1件取得する（該当なし・他ユーザーのものはnil, nil）
+func (wr *WebhookRepository) FindWebhook(ctx context.Context, userID, id int64) (*Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE id = ? AND user_id = ?
+	`
+
+	w, err := scanWebhook(wr.DB.QueryRowContext(ctx, query, id, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Webhookを登録し、生成された id を返す
+// ユーザーごとの上限(limit)に達している場合は ErrLimitExceeded を返す
+func (wr *WebhookRepository) CreateWebhook(ctx context.Context, w *Webhook, limit int) (int64, error) {
+	eventTypes, err := json.Marshal(w.EventTypes)
+	if err != nil {
+		return 0, err
+	}
+
+	// 上限の判定と登録を1文で行い、同時作成でも上限を超えないようにする
+	query := `
+		INSERT INTO webhooks (user_id, url, secret, event_types, active)
+		SELECT ?, ?, ?, ?, ?
+		FROM DUAL
+		WHERE (SELECT COUNT(*) FROM webhooks WHERE user_id = ?) < ?
+	`
+
+	res, err := wr.DB.ExecContext(ctx, query, w.UserID, w.URL, w.Secret, eventTypes, w.Active, w.UserID, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, ErrLimitExceeded
+	}
+
+	return res.LastInsertId()
+}
+
+// Webhookの設定を更新する（Secretが空の場合は変更しない。該当なしの場合はfalse）
+func (wr *WebhookRepository) UpdateWebhook(ctx context.Context, w *Webhook) (bool, error) {
+	eventTypes, err := json.Marshal(w.EventTypes)
+	if err != nil {
+		return false, err
+	}
+
+	query := `
+		UPDATE webhooks
+		SET url = ?, event_types = ?, active = ?, secret = COALESCE(NULLIF(?, ''), secret)
+		WHERE id = ? AND user_id = ?
+	`
+
+	if _, err := wr.DB.ExecContext(ctx, query, w.URL, eventTypes, w.Active, w.Secret, w.ID, w.UserID); err != nil {
+		return false, err
+	}
+
+	// 値が同じ場合はRowsAffectedが0になるため、存在確認は別途行う
+	found, err := wr.FindWebhook(ctx, w.UserID, w.ID)
+	if err != nil {
+		return false, err
+	}
+	return found != nil, nil
+}
+
+// Webhookを削除する（配信記録も削除される。該当なしの場合はfalse）
+func (wr *WebhookRepository) DeleteWebhook(ctx context.Context, userID, id int64) (bool, error) {
+	query := `
+		DELETE FROM webhooks
+		WHERE id = ? AND user_id = ?
+	`
+
+	res, err := wr.DB.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// 配信をまとめて登録する（すぐに送信対象になる）
+// イベントが発生したstateの更新と同じトランザクションで登録する
+func (wr *WebhookRepository) InsertDeliveries(ctx context.Context, tx *sql.Tx, deliveries []WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(deliveries))
+	args := make([]any, 0, len(deliveries)*5)
+	for _, d := range deliveries {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, d.WebhookID, d.UserID, d.EventID, d.EventType, []byte(d.Payload))
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, user_id, event_id, event_type, payload_json)
+		VALUES ` + strings.Join(placeholders, ", ")
+
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// 配信を1件登録し、生成された id を返す
+func (wr *WebhookRepository) InsertDelivery(ctx context.Context, d *WebhookDelivery) (int64, error) {
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, user_id, event_id, event_type, payload_json)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	res, err := wr.DB.ExecContext(ctx, query, d.WebhookID, d.UserID, d.EventID, d.EventType, []byte(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+const deliveryColumns = `d.id, d.webhook_id, d.user_id, d.event_id, d.event_type, d.payload_json, d.status, d.attempts,
+	d.next_attempt_at, d.last_status_code, COALESCE(d.last_error, ''), d.created_at, d.finished_at`
+
+func scanDelivery(sc interface{ Scan(...any) error }, extra ...any) (*WebhookDelivery, error) {
+	d := &WebhookDelivery{}
+	dest := []any{
+		&d.ID, &d.WebhookID, &d.UserID, &d.EventID, &d.EventType, &d.Payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &d.LastStatusCode, &d.LastError, &d.CreatedAt, &d.FinishedAt,
+	}
+	if err := sc.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Webhookの配信記録を新しい順に取得する（beforeIDが0以外の場合はそれより古いもの）
+func (wr *WebhookRepository) ListDeliveries(ctx context.Context, userID, webhookID, beforeID int64, limit int) ([]WebhookDelivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries d
+		WHERE d.user_id = ? AND d.webhook_id = ? AND (? = 0 OR d.id < ?)
+		ORDER BY d.id DESC
+		LIMIT ?
+	`
+
+	rows, err := wr.DB.QueryContext(ctx, query, userID, webhookID, beforeID, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	deliveries := []WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *d)
+	}
+	return deliveries, rows.Err()
+}
+
+// 送信時刻を過ぎた配信を最大limit件取得し、送信中として確保する
+// - 他のインスタンスが確保中の行はSKIP LOCKEDで飛ばすため、複数インスタンスでも同じ配信を同時に送らない
+// - 確保した配信はattemptsを加算し、next_attempt_atをlease後にずらす（送信中に停止した場合はlease後に再送される）
+// - 無効化されたWebhookの配信は確保しない（再度有効化されると送信される）
+func (wr *WebhookRepository) ClaimDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]WebhookDelivery, error) {
+	tx, err := wr.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT `+deliveryColumns+`, w.url, w.secret
+		FROM webhook_deliveries d
+		JOIN webhooks w ON w.id = d.webhook_id
+		WHERE d.status = ? AND d.next_attempt_at <= ? AND w.active = 1
+		ORDER BY d.next_attempt_at, d.id
+		LIMIT ?
+		FOR UPDATE OF d SKIP LOCKED
+	`, DeliveryPending, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := []WebhookDelivery{}
+	for rows.Next() {
+		var url, secret string
+		d, err := scanDelivery(rows, &url, &secret)
+		if err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		d.URL, d.Secret = url, secret
+		deliveries = append(deliveries, *d)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range deliveries {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE webhook_deliveries
+			SET attempts = attempts + 1, next_attempt_at = ?
+			WHERE id = ?
+		`, now.Add(lease), deliveries[i].ID); err != nil {
+			return nil, err
+		}
+		deliveries[i].Attempts++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// 送信結果を記録する
+// statusがDeliveryPendingの場合はnextAttemptAtに再送する。それ以外はfinished_atを記録する
+func (wr *WebhookRepository) FinishDelivery(ctx context.Context, d *WebhookDelivery, now time.Time) error {
+	var finishedAt *time.Time
+	if d.Status != DeliveryPending {
+		finishedAt = &now
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = ?, next_attempt_at = ?, last_status_code = ?, last_error = NULLIF(?, ''), finished_at = ?
+		WHERE id = ?
+	`
+
+	_, err := wr.DB.ExecContext(ctx, query, d.Status, d.NextAttemptAt, d.LastStatusCode, truncate(d.LastError, 255), finishedAt, d.ID)
+	return err
+}
+
+// before より前に作成された配信記録を削除する
+func (wr *WebhookRepository) DeleteDeliveriesBefore(ctx context.Context, before time.Time) error {
+	query := `
+		DELETE FROM webhook_deliveries
+		WHERE created_at < ?
+	`
+
+	_, err := wr.DB.ExecContext(ctx, query, before)
+	return err
+}
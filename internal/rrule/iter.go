@@ -0,0 +1,232 @@
+package rrule
+
+import (
+	"sort"
+	"time"
+)
+
+// 該当日が1件も見つからない期間が続いた場合に打ち切る期間数（不成立なルールで無限ループしないため）
+const maxEmptyPeriods = 1000
+
+// Iterator は開始日時(DTSTART)から順に発生日時を返す
+// 曜日・日付の判定は loc で行い、時刻はDTSTARTと同じ（夏時間の切り替え日はlocの規則に従う）
+type Iterator struct {
+	r       *Rule
+	start   time.Time // loc上のDTSTART
+	period  int       // 次に展開する期間（0はDTSTARTを含む期間）
+	pending []time.Time
+	emitted int
+	done    bool
+}
+
+func (r *Rule) Iter(dtstart time.Time, loc *time.Location) *Iterator {
+	return &Iterator{r: r, start: dtstart.In(loc)}
+}
+
+// 次の発生日時を返す（終了した場合はfalse）
+// DTSTARTはルールに一致しなくても常に最初の発生日時として扱う（RFC 5545と同じ）
+func (it *Iterator) Next() (time.Time, bool) {
+	if it.done {
+		return time.Time{}, false
+	}
+
+	if it.emitted == 0 {
+		return it.emit(it.start)
+	}
+
+	empty := 0
+	for len(it.pending) == 0 {
+		if empty >= maxEmptyPeriods {
+			it.done = true
+			return time.Time{}, false
+		}
+		for _, t := range it.expand(it.period) {
+			if t.After(it.start) {
+				it.pending = append(it.pending, t)
+			}
+		}
+		it.period++
+		empty++
+	}
+
+	t := it.pending[0]
+	it.pending = it.pending[1:]
+	return it.emit(t)
+}
+
+func (it *Iterator) emit(t time.Time) (time.Time, bool) {
+	if !it.r.Until.IsZero() && t.After(it.r.Until) {
+		it.done = true
+		return time.Time{}, false
+	}
+	it.emitted++
+	if it.r.Count > 0 && it.emitted >= it.r.Count {
+		it.done = true
+	}
+	return t, true
+}
+
+// n番目の期間に含まれる発生候補を昇順で返す
+func (it *Iterator) expand(n int) []time.Time {
+	s := it.start
+	h, mi, sec := s.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, h, mi, sec, 0, s.Location())
+	}
+	step := n * it.r.Interval
+
+	var out []time.Time
+	switch it.r.Freq {
+	case Daily:
+		t := at(s.Year(), s.Month(), s.Day()+step)
+		if it.matchesWeekday(t) {
+			out = append(out, t)
+		}
+
+	case Weekly:
+		// 週の始まり(月曜)からの日数
+		offset := (int(s.Weekday()) + 6) % 7
+		monday := at(s.Year(), s.Month(), s.Day()-offset+7*step)
+		if len(it.r.ByDay) == 0 {
+			out = append(out, at(monday.Year(), monday.Month(), monday.Day()+offset))
+			break
+		}
+		for i := 0; i < 7; i++ {
+			t := at(monday.Year(), monday.Month(), monday.Day()+i)
+			if it.matchesWeekday(t) {
+				out = append(out, t)
+			}
+		}
+
+	case Monthly:
+		first := time.Date(s.Year(), s.Month()+time.Month(step), 1, 0, 0, 0, 0, s.Location())
+		y, m := first.Year(), first.Month()
+		days := daysIn(y, m)
+
+		switch {
+		case len(it.r.ByMonthDay) > 0:
+			for _, d := range it.r.ByMonthDay {
+				if d < 0 {
+					d = days + 1 + d
+				}
+				if d >= 1 && d <= days && it.matchesMonthWeekday(y, m, days, d, at) {
+					out = append(out, at(y, m, d))
+				}
+			}
+		case len(it.r.ByDay) > 0:
+			for _, wd := range it.r.ByDay {
+				out = append(out, monthWeekdays(y, m, days, wd, at)...)
+			}
+		default:
+			// 該当日が無い月（31日など）はスキップ
+			if s.Day() <= days {
+				out = append(out, at(y, m, s.Day()))
+			}
+		}
+
+	case Yearly:
+		y := s.Year() + step
+		if s.Day() <= daysIn(y, s.Month()) {
+			out = append(out, at(y, s.Month(), s.Day()))
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return dedupe(out)
+}
+
+func (it *Iterator) matchesWeekday(t time.Time) bool {
+	if len(it.r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range it.r.ByDay {
+		if wd.Weekday == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// BYMONTHDAYと併用したBYDAYは日付を絞り込む（RFC 5545。例: BYDAY=FR;BYMONTHDAY=13 は13日の金曜日）
+// 序数付き（1MO等）の場合は、その月のN番目の該当曜日である日のみ
+func (it *Iterator) matchesMonthWeekday(y int, m time.Month, days, d int, at func(int, time.Month, int) time.Time) bool {
+	if len(it.r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range it.r.ByDay {
+		for _, t := range monthWeekdays(y, m, days, wd, at) {
+			if t.Day() == d {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 月内のwd.N番目（負の場合は末尾から）の曜日。Nが0の場合は全て
+func monthWeekdays(y int, m time.Month, days int, wd WeekdayNum, at func(int, time.Month, int) time.Time) []time.Time {
+	var all []int
+	for d := 1; d <= days; d++ {
+		if time.Date(y, m, d, 12, 0, 0, 0, time.UTC).Weekday() == wd.Weekday {
+			all = append(all, d)
+		}
+	}
+
+	switch {
+	case wd.N == 0:
+		out := make([]time.Time, 0, len(all))
+		for _, d := range all {
+			out = append(out, at(y, m, d))
+		}
+		return out
+	case wd.N > 0 && wd.N <= len(all):
+		return []time.Time{at(y, m, all[wd.N-1])}
+	case wd.N < 0 && -wd.N <= len(all):
+		return []time.Time{at(y, m, all[len(all)+wd.N])}
+	}
+	return nil
+}
+
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func dedupe(ts []time.Time) []time.Time {
+	out := ts[:0]
+	for i, t := range ts {
+		if i == 0 || !t.Equal(ts[i-1]) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// afterより後の最初の発生日時を返す（無い場合はfalse）
+func (r *Rule) After(dtstart, after time.Time, loc *time.Location) (time.Time, bool) {
+	it := r.Iter(dtstart, loc)
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return time.Time{}, false
+		}
+		if t.After(after) {
+			return t, true
+		}
+	}
+}
+
+// afterより後の発生日時を最大n件返す
+func (r *Rule) Take(dtstart, after time.Time, n int, loc *time.Location) []time.Time {
+	out := []time.Time{}
+	it := r.Iter(dtstart, loc)
+	for len(out) < n {
+		t, ok := it.Next()
+		if !ok {
+			break
+		}
+		if t.After(after) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,223 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRule はRRULEの構文・値が不正、または未対応の場合のエラー
+var ErrInvalidRule = errors.New("invalid rrule")
+
+func invalidRule(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRule, fmt.Sprintf(format, args...))
+}
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// WeekdayNum はBYDAYの1要素（例: MO, 1MO, -1FR）
+// Nが0の場合は「その月(週)の全ての該当曜日」
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule はRFC 5545のRRULEのうち、以下のサブセットを表す
+// FREQ(DAILY/WEEKLY/MONTHLY/YEARLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT, UNTIL
+// WKSTは常にMO扱い
+// BYMONTHDAYとBYDAYを併用した場合は、両方に一致する日のみ（RFC 5545と同じ）
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	Count      int       // 0は無制限
+	Until      time.Time // ゼロ値は無制限
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// RRULE文字列を解析する（先頭の"RRULE:"は省略可）
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, invalidRule("empty rule")
+	}
+
+	r := &Rule{Interval: 1}
+	seen := map[string]bool{}
+
+	for _, part := range strings.Split(s, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, invalidRule("malformed part %q", part)
+		}
+		key = strings.ToUpper(key)
+		if seen[key] {
+			return nil, invalidRule("duplicate %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch f := Frequency(strings.ToUpper(val)); f {
+			case Daily, Weekly, Monthly, Yearly:
+				r.Freq = f
+			default:
+				return nil, invalidRule("unsupported FREQ %q", val)
+			}
+
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > 1000 {
+				return nil, invalidRule("invalid INTERVAL %q", val)
+			}
+			r.Interval = n
+
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, invalidRule("invalid COUNT %q", val)
+			}
+			r.Count = n
+
+		case "UNTIL":
+			t, err := parseUntil(val)
+			if err != nil {
+				return nil, invalidRule("invalid UNTIL %q", val)
+			}
+			r.Until = t
+
+		case "BYDAY":
+			for _, v := range strings.Split(val, ",") {
+				wd, err := parseWeekdayNum(v)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(val, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, invalidRule("invalid BYMONTHDAY %q", v)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+
+		case "WKST":
+			if strings.ToUpper(val) != "MO" {
+				return nil, invalidRule("only WKST=MO is supported")
+			}
+
+		default:
+			return nil, invalidRule("unsupported part %s", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, invalidRule("FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, invalidRule("COUNT and UNTIL must not both be set")
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq != Monthly {
+		return nil, invalidRule("BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+	if r.Freq == Yearly && len(r.ByDay) > 0 {
+		return nil, invalidRule("BYDAY is not supported with FREQ=YEARLY")
+	}
+	for _, wd := range r.ByDay {
+		if wd.N != 0 && r.Freq != Monthly {
+			return nil, invalidRule("BYDAY with ordinal is only supported with FREQ=MONTHLY")
+		}
+	}
+
+	return r, nil
+}
+
+func parseWeekdayNum(v string) (WeekdayNum, error) {
+	v = strings.ToUpper(strings.TrimSpace(v))
+	if len(v) < 2 {
+		return WeekdayNum{}, invalidRule("invalid BYDAY %q", v)
+	}
+
+	wd, ok := weekdayCodes[v[len(v)-2:]]
+	if !ok {
+		return WeekdayNum{}, invalidRule("invalid BYDAY %q", v)
+	}
+
+	n := 0
+	if prefix := v[:len(v)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, invalidRule("invalid BYDAY %q", v)
+		}
+	}
+
+	return WeekdayNum{N: n, Weekday: wd}, nil
+}
+
+// UNTILはUTC(末尾Z)の日時、または日付のみ（その日の終わりまで含む）を受け付ける
+func parseUntil(v string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", v); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("20060102", v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.AddDate(0, 0, 1).Add(-time.Second), nil
+}
+
+// 正規化したRRULE文字列（"RRULE:"は付けない）
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			code := strings.ToUpper(wd.Weekday.String()[:2])
+			if wd.N != 0 {
+				code = strconv.Itoa(wd.N) + code
+			}
+			days = append(days, code)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,156 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // String()の結果
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:FREQ=WEEKLY;BYDAY=MO,WE", "FREQ=WEEKLY;BYDAY=MO,WE"},
+		{"freq=weekly;interval=2;byday=fr", "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR"},
+		{"FREQ=MONTHLY;BYDAY=-1FR", "FREQ=MONTHLY;BYDAY=-1FR"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1", "FREQ=MONTHLY;BYMONTHDAY=1,-1"},
+		{"FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13"},
+		{"FREQ=DAILY;COUNT=10", "FREQ=DAILY;COUNT=10"},
+		{"FREQ=DAILY;UNTIL=20250131T000000Z", "FREQ=DAILY;UNTIL=20250131T000000Z"},
+		{"FREQ=DAILY;UNTIL=20250131", "FREQ=DAILY;UNTIL=20250131T235959Z"},
+		{"FREQ=YEARLY;WKST=MO", "FREQ=YEARLY"},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if got := r.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"RRULE:",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=YEARLY;BYDAY=MO",
+		"FREQ=DAILY;WKST=SU",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=DAILY;COUNT",
+	} {
+		if _, err := Parse(in); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("Parse(%q) err = %v, want ErrInvalidRule", in, err)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		rule  string
+		start time.Time
+		want  []time.Time
+	}{
+		{"FREQ=DAILY", date(2025, 1, 30), []time.Time{date(2025, 1, 30), date(2025, 1, 31), date(2025, 2, 1)}},
+		{"FREQ=DAILY;INTERVAL=3;COUNT=3", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 4), date(2025, 1, 7)}},
+		{"FREQ=DAILY;UNTIL=20250102", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 2)}},
+		{"FREQ=DAILY;BYDAY=MO,FR", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 3), date(2025, 1, 6), date(2025, 1, 10)}},
+		{"FREQ=WEEKLY;INTERVAL=2", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 15), date(2025, 1, 29)}},
+		// DTSTART（水曜）はルールに一致しなくても最初の発生日時
+		{"FREQ=WEEKLY;BYDAY=MO,TH", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 2), date(2025, 1, 6), date(2025, 1, 9)}},
+		// 31日の無い月はスキップ
+		{"FREQ=MONTHLY", date(2025, 1, 31), []time.Time{date(2025, 1, 31), date(2025, 3, 31), date(2025, 5, 31)}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", date(2025, 1, 31), []time.Time{date(2025, 1, 31), date(2025, 2, 28), date(2025, 3, 31)}},
+		{"FREQ=MONTHLY;BYMONTHDAY=15,1", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 15), date(2025, 2, 1), date(2025, 2, 15)}},
+		{"FREQ=MONTHLY;BYDAY=2TU", date(2025, 1, 14), []time.Time{date(2025, 1, 14), date(2025, 2, 11), date(2025, 3, 11)}},
+		{"FREQ=MONTHLY;BYDAY=-1FR", date(2025, 1, 31), []time.Time{date(2025, 1, 31), date(2025, 2, 28), date(2025, 3, 28)}},
+		// BYMONTHDAYとBYDAYは両方に一致する日のみ（13日の金曜日）
+		{"FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 6, 13), date(2026, 2, 13), date(2026, 3, 13)}},
+		// 序数付きのBYDAYは、その月のN番目の該当曜日である日のみ（第1月曜日）
+		{"FREQ=MONTHLY;BYDAY=1MO;BYMONTHDAY=1,2,3,4,5,6,7", date(2025, 1, 1), []time.Time{date(2025, 1, 1), date(2025, 1, 6), date(2025, 2, 3), date(2025, 3, 3)}},
+		// 両方に一致する日が無いルールはDTSTARTのみ
+		{"FREQ=MONTHLY;BYDAY=1MO;BYMONTHDAY=31", date(2025, 1, 1), []time.Time{date(2025, 1, 1)}},
+		{"FREQ=YEARLY", date(2024, 2, 29), []time.Time{date(2024, 2, 29), date(2028, 2, 29)}},
+	}
+
+	for _, tt := range tests {
+		r, err := Parse(tt.rule)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.rule, err)
+		}
+		got := r.Take(tt.start, tt.start.Add(-time.Second), len(tt.want), time.UTC)
+		if !equalTimes(got, tt.want) {
+			t.Errorf("%s from %s:\n got %v\nwant %v", tt.rule, tt.start.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+// 回数・期限付きのルールは、期限を過ぎると終了する
+func TestExpandEnds(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	for _, rule := range []string{"FREQ=DAILY;COUNT=3", "FREQ=DAILY;UNTIL=20250103", "FREQ=MONTHLY;BYDAY=1MO;BYMONTHDAY=31"} {
+		r, err := Parse(rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := r.Take(start, start.Add(-time.Second), 10, time.UTC)
+		want := 3
+		if r.Count == 0 && r.Until.IsZero() {
+			want = 1
+		}
+		if len(got) != want {
+			t.Errorf("%s: %d occurrences %v, want %d", rule, len(got), got, want)
+		}
+	}
+}
+
+// 曜日・日付の判定はlocで行い、時刻はDTSTARTのlocでの時刻を保つ
+func TestExpandInLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	// 日本時間の月曜 08:00 は UTC では日曜 23:00
+	start := time.Date(2025, 1, 5, 23, 0, 0, 0, time.UTC)
+	r, err := Parse("FREQ=WEEKLY;BYDAY=MO")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next, ok := r.After(start, start, tokyo)
+	want := time.Date(2025, 1, 13, 8, 0, 0, 0, tokyo)
+	if !ok || !next.Equal(want) {
+		t.Errorf("After = %v, %v, want %v", next, ok, want)
+	}
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const (
+	// 1回の実行で処理する予定の最大件数
+	batchSize = 100
+
+	// 実行中のまま放置された予定を他のインスタンスが取り直すまでの時間
+	runLease = 5 * time.Minute
+
+	// 失敗時の最大試行回数（超えた予定は断念する）
+	maxAttempts = 5
+
+	// 実行記録の保持期間
+	runRetention = 30 * 24 * time.Hour
+)
+
+// Task は予定の種類(kind)ごとの処理
+type Task interface {
+	// stateから発火予定を計算する（UserIDとKindは呼び出し側で設定する）
+	Plan(m *repository.Minkan) []repository.NodeSchedule
+
+	// 発火時刻を過ぎた予定を実行する
+	// 同じ予定が複数回実行されても結果が変わらないよう、実行時のstateを確認してから更新すること
+	Run(ctx context.Context, sc *repository.NodeSchedule) error
+}
+
+// Scheduler はノードの発火予定(node_schedules)を管理し、時刻が来た予定を実行する
+// - 発火予定はminkan_statesの更新フック(Index)で作り直す
+// - 実行は schedule_runs への記録で排他するため、複数インスタンスで動かしても1回だけ実行される
+type Scheduler struct {
+	Repo      *repository.ScheduleRepository
+	StateRepo *repository.MinkanStatesRepository
+	Interval  time.Duration // 発火予定を確認する間隔
+	tasks     map[string]Task
+	kinds     []string // 登録順（Planの呼び出し順を安定させるため）
+}
+
+func New(repo *repository.ScheduleRepository, stateRepo *repository.MinkanStatesRepository, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		Repo:      repo,
+		StateRepo: stateRepo,
+		Interval:  interval,
+		tasks:     map[string]Task{},
+	}
+}
+
+// 予定の種類を登録する（起動時にのみ呼び出すこと）
+func (s *Scheduler) Register(kind string, t Task) {
+	if _, ok := s.tasks[kind]; !ok {
+		s.kinds = append(s.kinds, kind)
+	}
+	s.tasks[kind] = t
+}
+
+// minkan.Storeの更新フックとして登録し、更新後のstateから発火予定を作り直す
+func (s *Scheduler) Index(ctx context.Context, c *minkan.Change) {
+	lg := slog.Default().With("module", "scheduler", "userID", c.UserID)
+
+	// 変換できなかった場合はStore側でログ出力済み
+	if c.After == nil {
+		return
+	}
+	if err := s.reindex(ctx, c.UserID, c.Version, c.After); err != nil {
+		lg.Error("reindex schedules failed", "err", err)
+	}
+}
+
+func (s *Scheduler) reindex(ctx context.Context, userID int64, version int32, m *repository.Minkan) error {
+	schedules := []repository.NodeSchedule{}
+	for _, kind := range s.kinds {
+		for _, sc := range s.tasks[kind].Plan(m) {
+			sc.UserID = userID
+			sc.Kind = kind
+			schedules = append(schedules, sc)
+		}
+	}
+
+	_, err := s.Repo.ReplaceUserSchedules(ctx, userID, version, schedules)
+	return err
+}
+
+// 現在のstateからユーザーの発火予定を作り直す
+func (s *Scheduler) Reindex(ctx context.Context, userID int64) error {
+	state, err := s.StateRepo.FindStateByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return nil
+	}
+
+	m, err := minkan.Decode(state.StateJSON)
+	if err != nil {
+		return err
+	}
+	return s.reindex(ctx, userID, state.Version, m)
+}
+
+// ctxが終了するまでInterval毎に発火予定を実行する
+// 起動時に全ユーザーの発火予定を作り直す（機能追加前のデータや、フックの取りこぼしへの対応）
+func (s *Scheduler) Run(ctx context.Context) {
+	lg := slog.Default().With("module", "scheduler")
+	lg.Info("scheduler started", "interval", s.Interval.String())
+
+	if err := s.reindexAll(ctx); err != nil && ctx.Err() == nil {
+		lg.Error("reindex all schedules failed", "err", err)
+	}
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			lg.Info("scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) reindexAll(ctx context.Context) error {
+	var after int64
+	for {
+		ids, err := s.Repo.ListUserIDs(ctx, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		for _, id := range ids {
+			if err := s.Reindex(ctx, id); err != nil {
+				slog.Default().With("module", "scheduler", "userID", id).Error("reindex schedules failed", "err", err)
+			}
+		}
+		after = ids[len(ids)-1]
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	lg := slog.Default().With("module", "scheduler")
+	now := time.Now()
+
+	due, err := s.Repo.ListDueSchedules(ctx, now, batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			lg.Error("list due schedules failed", "err", err)
+		}
+		return
+	}
+
+	for i := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		s.fire(ctx, &due[i], now)
+	}
+
+	if err := s.Repo.DeleteRunsBefore(ctx, now.Add(-runRetention)); err != nil && ctx.Err() == nil {
+		lg.Warn("delete old schedule runs failed", "err", err)
+	}
+}
+
+func (s *Scheduler) fire(ctx context.Context, sc *repository.NodeSchedule, now time.Time) {
+	lg := slog.Default().With("module", "scheduler",
+		"userID", sc.UserID, "pjID", sc.PjID, "nodeID", sc.NodeID, "kind", sc.Kind, "fireAt", sc.FireAt)
+
+	task, ok := s.tasks[sc.Kind]
+	if !ok {
+		lg.Warn("unknown schedule kind")
+		s.drop(ctx, sc, lg)
+		return
+	}
+
+	claimed, finished, err := s.Repo.ClaimRun(ctx, sc, now, runLease, maxAttempts)
+	if err != nil {
+		lg.Error("claim schedule run failed", "err", err)
+		return
+	}
+	if !claimed {
+		// 他のインスタンスが実行中の場合はそのまま。完了・断念済みなら予定から外す
+		if finished {
+			s.drop(ctx, sc, lg)
+		}
+		return
+	}
+
+	runErr := task.Run(ctx, sc)
+	if runErr != nil {
+		lg.Error("schedule run failed", "err", runErr)
+	} else {
+		lg.Info("schedule run ok")
+	}
+
+	if err := s.Repo.FinishRun(ctx, sc, time.Now(), runErr); err != nil {
+		lg.Error("finish schedule run failed", "err", err)
+		return
+	}
+	if runErr == nil {
+		s.drop(ctx, sc, lg)
+	}
+}
+
+func (s *Scheduler) drop(ctx context.Context, sc *repository.NodeSchedule, lg *slog.Logger) {
+	if err := s.Repo.DeleteSchedule(ctx, sc); err != nil {
+		lg.Warn("delete schedule failed", "err", err)
+	}
+}
@@ -0,0 +1,76 @@
+package session
+
+import "sync"
+
+// セッションで認証した長時間接続（WebSocket・SSE）
+// セッションの削除（ログアウト・無効化・退会・IdPでの失効）時に切断する
+type watchedConn struct {
+	userID int64
+	idHash string // 再発行で付け替える（connRegistry.muで保護）
+	close  func()
+}
+
+// このプロセスの長時間接続の一覧
+// 複数インスタンス構成では、他のインスタンスで削除したセッションの接続は切断されない（pubsub.Hubと同様に通知が必要）
+type connRegistry struct {
+	mu    sync.Mutex
+	conns map[*watchedConn]struct{}
+}
+
+// Watch はセッションIDで認証した長時間接続を登録し、セッションの削除時に closeFn を呼ぶ
+// 接続の終了時に返り値の関数を呼ぶこと（closeFnは1度だけ呼ぶ。呼び出しをブロックしないこと）
+func (sm *SessionManager) Watch(sessID string, userID int64, closeFn func()) (stop func()) {
+	c := &watchedConn{userID: userID, idHash: HashID(sessID), close: closeFn}
+
+	reg := &sm.conns
+	reg.mu.Lock()
+	if reg.conns == nil {
+		reg.conns = make(map[*watchedConn]struct{})
+	}
+	reg.conns[c] = struct{}{}
+	reg.mu.Unlock()
+
+	return func() {
+		reg.mu.Lock()
+		defer reg.mu.Unlock()
+		delete(reg.conns, c)
+	}
+}
+
+// matchに一致する接続の登録を解除して閉じる
+func (reg *connRegistry) closeWhere(match func(c *watchedConn) bool) {
+	reg.mu.Lock()
+	var closing []*watchedConn
+	for c := range reg.conns {
+		if match(c) {
+			closing = append(closing, c)
+			delete(reg.conns, c)
+		}
+	}
+	reg.mu.Unlock()
+
+	for _, c := range closing {
+		c.close()
+	}
+}
+
+// セッションの接続を閉じる
+func (reg *connRegistry) closeSession(idHash string) {
+	reg.closeWhere(func(c *watchedConn) bool { return c.idHash == idHash })
+}
+
+// ユーザーの（exceptHash以外の）セッションの接続を閉じる
+func (reg *connRegistry) closeUser(userID int64, exceptHash string) {
+	reg.closeWhere(func(c *watchedConn) bool { return c.userID == userID && c.idHash != exceptHash })
+}
+
+// 再発行したセッションの接続を新しいIDに付け替える（元のIDの期限切れで切断しないように）
+func (reg *connRegistry) rename(oldHash, newHash string) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	for c := range reg.conns {
+		if c.idHash == oldHash {
+			c.idHash = newHash
+		}
+	}
+}
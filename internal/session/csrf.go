@@ -0,0 +1,31 @@
+package session
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// CSRFToken はセッションIDに紐づくCSRFトークンを返す
+// セッションIDとサーバの秘密鍵のHMACのため保存は不要で、セッションIDを再発行すると変わる
+func (sm *SessionManager) CSRFToken(sessID string) string {
+	return base64.RawURLEncoding.EncodeToString(sm.signCSRF(sessID))
+}
+
+// VerifyCSRF はCSRFトークンがセッションIDに紐づくものか検証する
+func (sm *SessionManager) VerifyCSRF(sessID, csrfToken string) bool {
+	if sessID == "" || csrfToken == "" {
+		return false
+	}
+	got, err := base64.RawURLEncoding.DecodeString(csrfToken)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, sm.signCSRF(sessID))
+}
+
+func (sm *SessionManager) signCSRF(sessID string) []byte {
+	mac := hmac.New(sha256.New, sm.csrfSecret)
+	mac.Write([]byte("csrf:" + sessID))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,456 @@
+// Package fakeredis はRedisStoreの動作確認用のプロセス内Redisサーバ
+// RedisStoreが使うコマンドのみをRESP2で実装し、127.0.0.1の空きポートで待ち受ける
+// トランザクションはWATCH・MULTI・EXECのみ対応する（キーの変更はversionで検出する）
+package fakeredis
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	value    string
+	set      map[string]struct{} // 集合の場合のみ（文字列の場合はnil）
+	expireAt time.Time           // ゼロ値の場合は期限なし
+}
+
+// Server はプロセス内で動くRedisプロトコルのサーバ
+type Server struct {
+	ln       net.Listener
+	password string // 空の場合はAUTH不要
+
+	mu      sync.Mutex
+	data    map[string]entry
+	version map[string]uint64 // キーを変更するたびに増やす（WATCHの判定用）
+	wg      sync.WaitGroup
+}
+
+// conn は1接続分のトランザクションの状態
+type conn struct {
+	watched map[string]uint64 // WATCHしたキーと、その時点のversion
+	multi   bool              // MULTI〜EXECの間
+	queued  [][]string        // MULTI中に受け付けたコマンド
+}
+
+// 127.0.0.1の空きポートで待ち受けを開始する
+func NewServer(password string) (*Server, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		ln:       ln,
+		password: password,
+		data:     make(map[string]entry),
+		version:  make(map[string]uint64),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// 待ち受けアドレス（host:port）
+func (s *Server) Addr() string {
+	return s.ln.Addr().String()
+}
+
+// 待ち受けを停止する（接続中のクライアントの切断は待たない）
+func (s *Server) Close() error {
+	err := s.ln.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(nc net.Conn) {
+	defer func() { _ = nc.Close() }()
+
+	r := bufio.NewReader(nc)
+	w := bufio.NewWriter(nc)
+	authed := s.password == ""
+	c := &conn{}
+
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		cmd := strings.ToUpper(args[0])
+		switch {
+		case cmd == "AUTH":
+			if len(args) == 2 && args[1] == s.password {
+				authed = true
+				w.WriteString("+OK\r\n")
+			} else {
+				w.WriteString("-WRONGPASS invalid password\r\n")
+			}
+		case !authed:
+			w.WriteString("-NOAUTH Authentication required.\r\n")
+		default:
+			s.transaction(w, c, cmd, args)
+		}
+
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// WATCH・MULTI・EXEC等のトランザクションのコマンドを処理し、それ以外はexecで実行する
+func (s *Server) transaction(w *bufio.Writer, c *conn, cmd string, args []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case cmd == "WATCH":
+		if c.multi {
+			w.WriteString("-ERR WATCH inside MULTI is not allowed\r\n")
+			return
+		}
+		if c.watched == nil {
+			c.watched = make(map[string]uint64)
+		}
+		for _, key := range args[1:] {
+			s.lookup(key) // 期限切れのキーは先に消しておく
+			c.watched[key] = s.version[key]
+		}
+		w.WriteString("+OK\r\n")
+	case cmd == "UNWATCH":
+		c.watched = nil
+		w.WriteString("+OK\r\n")
+	case cmd == "MULTI":
+		if c.multi {
+			w.WriteString("-ERR MULTI calls can not be nested\r\n")
+			return
+		}
+		c.multi = true
+		w.WriteString("+OK\r\n")
+	case cmd == "DISCARD":
+		if !c.multi {
+			w.WriteString("-ERR DISCARD without MULTI\r\n")
+			return
+		}
+		*c = conn{}
+		w.WriteString("+OK\r\n")
+	case cmd == "EXEC":
+		if !c.multi {
+			w.WriteString("-ERR EXEC without MULTI\r\n")
+			return
+		}
+		queued, watched := c.queued, c.watched
+		*c = conn{}
+
+		// WATCHしたキーがMULTIまでの間に変更・削除された場合は実行しない
+		for key, v := range watched {
+			s.lookup(key)
+			if s.version[key] != v {
+				w.WriteString("*-1\r\n")
+				return
+			}
+		}
+		fmt.Fprintf(w, "*%d\r\n", len(queued))
+		for _, q := range queued {
+			s.exec(w, strings.ToUpper(q[0]), q[1:])
+		}
+	case c.multi:
+		c.queued = append(c.queued, args)
+		w.WriteString("+QUEUED\r\n")
+	default:
+		s.exec(w, cmd, args[1:])
+	}
+}
+
+// コマンドを実行する（s.muを確保して呼び出すこと）
+func (s *Server) exec(w *bufio.Writer, cmd string, args []string) {
+	switch cmd {
+	case "PING":
+		w.WriteString("+PONG\r\n")
+
+	case "SELECT":
+		// DBは1つのみ（番号は無視する）
+		w.WriteString("+OK\r\n")
+
+	case "GET":
+		if len(args) != 1 {
+			writeArgError(w, cmd)
+			return
+		}
+		e, ok := s.lookup(args[0])
+		if !ok {
+			w.WriteString("$-1\r\n")
+			return
+		}
+		if e.set != nil {
+			writeWrongType(w)
+			return
+		}
+		writeBulk(w, e.value)
+
+	case "SET":
+		// SET key value [PX ms | EX s]
+		if len(args) != 2 && len(args) != 4 {
+			writeArgError(w, cmd)
+			return
+		}
+		e := entry{value: args[1]}
+		if len(args) == 4 {
+			n, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil || n <= 0 {
+				w.WriteString("-ERR invalid expire time in 'set' command\r\n")
+				return
+			}
+			switch strings.ToUpper(args[2]) {
+			case "PX":
+				e.expireAt = time.Now().Add(time.Duration(n) * time.Millisecond)
+			case "EX":
+				e.expireAt = time.Now().Add(time.Duration(n) * time.Second)
+			default:
+				w.WriteString("-ERR syntax error\r\n")
+				return
+			}
+		}
+		s.data[args[0]] = e
+		s.version[args[0]]++
+		w.WriteString("+OK\r\n")
+
+	case "DEL":
+		var n int
+		for _, key := range args {
+			if _, ok := s.lookup(key); ok {
+				delete(s.data, key)
+				s.version[key]++
+				n++
+			}
+		}
+		fmt.Fprintf(w, ":%d\r\n", n)
+
+	case "PEXPIRE":
+		if len(args) != 2 {
+			writeArgError(w, cmd)
+			return
+		}
+		n, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			w.WriteString("-ERR value is not an integer or out of range\r\n")
+			return
+		}
+		e, ok := s.lookup(args[0])
+		if !ok {
+			w.WriteString(":0\r\n")
+			return
+		}
+		if n <= 0 {
+			delete(s.data, args[0])
+		} else {
+			e.expireAt = time.Now().Add(time.Duration(n) * time.Millisecond)
+			s.data[args[0]] = e
+		}
+		s.version[args[0]]++
+		w.WriteString(":1\r\n")
+
+	case "SADD":
+		if len(args) < 2 {
+			writeArgError(w, cmd)
+			return
+		}
+		e, ok := s.lookup(args[0])
+		if ok && e.set == nil {
+			writeWrongType(w)
+			return
+		}
+		if !ok {
+			e = entry{set: make(map[string]struct{})}
+		}
+		var n int
+		for _, m := range args[1:] {
+			if _, ok := e.set[m]; !ok {
+				e.set[m] = struct{}{}
+				n++
+			}
+		}
+		s.data[args[0]] = e
+		s.version[args[0]]++
+		fmt.Fprintf(w, ":%d\r\n", n)
+
+	case "SREM":
+		if len(args) < 2 {
+			writeArgError(w, cmd)
+			return
+		}
+		e, ok := s.lookup(args[0])
+		if !ok {
+			w.WriteString(":0\r\n")
+			return
+		}
+		if e.set == nil {
+			writeWrongType(w)
+			return
+		}
+		var n int
+		for _, m := range args[1:] {
+			if _, ok := e.set[m]; ok {
+				delete(e.set, m)
+				n++
+			}
+		}
+		// 空の集合はキーごと消える
+		if len(e.set) == 0 {
+			delete(s.data, args[0])
+		}
+		s.version[args[0]]++
+		fmt.Fprintf(w, ":%d\r\n", n)
+
+	case "SMEMBERS":
+		if len(args) != 1 {
+			writeArgError(w, cmd)
+			return
+		}
+		e, ok := s.lookup(args[0])
+		if ok && e.set == nil {
+			writeWrongType(w)
+			return
+		}
+		fmt.Fprintf(w, "*%d\r\n", len(e.set))
+		for m := range e.set {
+			writeBulk(w, m)
+		}
+
+	case "SCAN":
+		// SCAN cursor [MATCH pattern] [COUNT n]
+		// 全てのキーを1回で返す（COUNTは無視し、カーソルは常に0）
+		if len(args) < 1 {
+			writeArgError(w, cmd)
+			return
+		}
+		pattern := "*"
+		for i := 1; i+1 < len(args); i += 2 {
+			if strings.ToUpper(args[i]) == "MATCH" {
+				pattern = args[i+1]
+			}
+		}
+		var keys []string
+		for key := range s.data {
+			if _, ok := s.lookup(key); !ok {
+				continue
+			}
+			if ok, _ := path.Match(pattern, key); ok {
+				keys = append(keys, key)
+			}
+		}
+		fmt.Fprintf(w, "*2\r\n$1\r\n0\r\n*%d\r\n", len(keys))
+		for _, key := range keys {
+			writeBulk(w, key)
+		}
+
+	case "PTTL":
+		if len(args) != 1 {
+			writeArgError(w, cmd)
+			return
+		}
+		e, ok := s.lookup(args[0])
+		switch {
+		case !ok:
+			w.WriteString(":-2\r\n")
+		case e.expireAt.IsZero():
+			w.WriteString(":-1\r\n")
+		default:
+			fmt.Fprintf(w, ":%d\r\n", time.Until(e.expireAt).Milliseconds())
+		}
+
+	default:
+		fmt.Fprintf(w, "-ERR unknown command '%s'\r\n", cmd)
+	}
+}
+
+// 期限切れのキーは参照時に削除する
+func (s *Server) lookup(key string) (entry, bool) {
+	e, ok := s.data[key]
+	if !ok {
+		return entry{}, false
+	}
+	if !e.expireAt.IsZero() && !time.Now().Before(e.expireAt) {
+		delete(s.data, key)
+		s.version[key]++
+		return entry{}, false
+	}
+	return e, true
+}
+
+func writeBulk(w *bufio.Writer, v string) {
+	fmt.Fprintf(w, "$%d\r\n%s\r\n", len(v), v)
+}
+
+func writeWrongType(w *bufio.Writer) {
+	w.WriteString("-WRONGTYPE Operation against a key holding the wrong kind of value\r\n")
+}
+
+func writeArgError(w *bufio.Writer, cmd string) {
+	fmt.Fprintf(w, "-ERR wrong number of arguments for '%s' command\r\n", strings.ToLower(cmd))
+}
+
+// クライアントからのコマンド（バルク文字列の配列）を読む
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, errors.New("fakeredis: inline commands are not supported")
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(line, "$") {
+			return nil, fmt.Errorf("fakeredis: unexpected %q", line)
+		}
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 {
+			return nil, fmt.Errorf("fakeredis: invalid bulk length %q", line)
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line, "\r\n"), nil
+}
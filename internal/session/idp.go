@@ -0,0 +1,84 @@
+package session
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// IdPTokens はログイン時にIdPから受け取ったトークン（ログアウト・IdPでの再確認に使う）
+// 保存先には暗号化して保存する（Session.IdPTokens）
+type IdPTokens struct {
+	RefreshToken string `json:"rt,omitempty"` // 無い場合はIdPでの再確認を行わない
+	IDToken      string `json:"it,omitempty"` // RP-Initiated Logout の id_token_hint
+}
+
+func (t IdPTokens) empty() bool {
+	return t.RefreshToken == "" && t.IDToken == ""
+}
+
+// tokenSealer はIdPのトークンをAES-GCMで暗号化・復号する
+// 別のユーザーのセッションに付け替えられないよう、ユーザーIDを追加データにする
+type tokenSealer struct {
+	aead cipher.AEAD
+}
+
+// 鍵は SESSION_TOKEN_KEY のSHA-256（任意の長さの文字列を指定できる）
+func newTokenSealer(secret string) *tokenSealer {
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		// 32バイトの鍵のため発生しない
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return &tokenSealer{aead: aead}
+}
+
+// 暗号化する（トークンが無い場合はnil）
+func (ts *tokenSealer) seal(userID int64, t IdPTokens) ([]byte, error) {
+	if t.empty() {
+		return nil, nil
+	}
+
+	plain, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, ts.aead.NonceSize(), ts.aead.NonceSize()+len(plain)+ts.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return ts.aead.Seal(nonce, nonce, plain, sealAD(userID)), nil
+}
+
+// 復号する（保存されていない場合は空のトークン）
+func (ts *tokenSealer) open(userID int64, sealed []byte) (IdPTokens, error) {
+	var t IdPTokens
+	if len(sealed) == 0 {
+		return t, nil
+	}
+
+	n := ts.aead.NonceSize()
+	if len(sealed) < n {
+		return t, errors.New("session: sealed idp tokens too short")
+	}
+	plain, err := ts.aead.Open(nil, sealed[:n], sealed[n:], sealAD(userID))
+	if err != nil {
+		return t, err
+	}
+	err = json.Unmarshal(plain, &t)
+	return t, err
+}
+
+func sealAD(userID int64) []byte {
+	return []byte("minkan-session-idp:" + strconv.FormatInt(userID, 10))
+}
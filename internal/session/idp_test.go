@@ -0,0 +1,41 @@
+package session
+
+import "testing"
+
+func TestTokenSealer(t *testing.T) {
+	ts := newTokenSealer("token-key")
+	tokens := IdPTokens{RefreshToken: "rt", IDToken: "it"}
+
+	sealed, err := ts.seal(1, tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ts.open(1, sealed); err != nil || got != tokens {
+		t.Errorf("open = %+v, %v", got, err)
+	}
+
+	// 別のユーザーのセッションに付け替えたトークンは復号できない
+	if got, err := ts.open(2, sealed); err == nil {
+		t.Errorf("open with another user = %+v", got)
+	}
+	// 鍵を変更した場合も復号できない
+	if got, err := newTokenSealer("other-key").open(1, sealed); err == nil {
+		t.Errorf("open with another key = %+v", got)
+	}
+	tampered := append([]byte(nil), sealed...)
+	tampered[len(tampered)-1] ^= 1
+	if got, err := ts.open(1, tampered); err == nil {
+		t.Errorf("open tampered = %+v", got)
+	}
+	if _, err := ts.open(1, sealed[:4]); err == nil {
+		t.Error("open accepted a truncated value")
+	}
+
+	// トークンが無い場合は保存しない
+	if sealed, err := ts.seal(1, IdPTokens{}); sealed != nil || err != nil {
+		t.Errorf("seal(empty) = %x, %v", sealed, err)
+	}
+	if got, err := ts.open(1, nil); err != nil || got != (IdPTokens{}) {
+		t.Errorf("open(nil) = %+v, %v", got, err)
+	}
+}
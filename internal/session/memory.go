@@ -0,0 +1,170 @@
+package session
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore はプロセス内で完結するStore実装
+type MemoryStore struct {
+	mu     sync.Mutex
+	data   map[string]Session
+	byUser map[int64]map[string]struct{} // ユーザーID ⇒ セッションのキー
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		data:   make(map[string]Session),
+		byUser: make(map[int64]map[string]struct{}),
+	}
+}
+
+func (ms *MemoryStore) Create(_ context.Context, s *Session) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.data[s.IDHash] = *s
+
+	keys, ok := ms.byUser[s.UserID]
+	if !ok {
+		keys = make(map[string]struct{})
+		ms.byUser[s.UserID] = keys
+	}
+	keys[s.IDHash] = struct{}{}
+	return nil
+}
+
+func (ms *MemoryStore) Get(_ context.Context, idHash string) (*Session, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	s, ok := ms.data[idHash]
+	if !ok {
+		return nil, nil
+	}
+
+	// 有効期限切れの場合は削除
+	if !time.Now().Before(s.ExpiresAt) {
+		ms.remove(idHash)
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func (ms *MemoryStore) Touch(_ context.Context, idHash string, at, expiresAt time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if s, ok := ms.data[idHash]; ok {
+		s.LastSeenAt = at
+		s.ExpiresAt = expiresAt
+		ms.data[idHash] = s
+	}
+	return nil
+}
+
+func (ms *MemoryStore) Delete(_ context.Context, idHash string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.remove(idHash)
+	return nil
+}
+
+func (ms *MemoryStore) ListByUser(_ context.Context, userID int64) ([]*Session, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	now := time.Now()
+	var res []*Session
+	for idHash := range ms.byUser[userID] {
+		s := ms.data[idHash]
+		if !now.Before(s.ExpiresAt) {
+			ms.remove(idHash)
+			continue
+		}
+		res = append(res, &s)
+	}
+	return res, nil
+}
+
+func (ms *MemoryStore) DeleteByUser(_ context.Context, userID int64, exceptIDHash string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	for idHash := range ms.byUser[userID] {
+		if idHash != exceptIDHash {
+			ms.remove(idHash)
+		}
+	}
+	return nil
+}
+
+func (ms *MemoryStore) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var n int64
+	for idHash, s := range ms.data {
+		if !now.Before(s.ExpiresAt) {
+			ms.remove(idHash)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (ms *MemoryStore) Count(_ context.Context, now time.Time) (int64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var n int64
+	for _, s := range ms.data {
+		if now.Before(s.ExpiresAt) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (ms *MemoryStore) ListIdPStale(_ context.Context, checkedBefore, now time.Time, limit int) ([]*Session, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var res []*Session
+	for _, s := range ms.data {
+		if len(s.IdPTokens) > 0 && !s.IdPCheckedAt.IsZero() && s.IdPCheckedAt.Before(checkedBefore) && now.Before(s.ExpiresAt) {
+			res = append(res, &s)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].IdPCheckedAt.Before(res[j].IdPCheckedAt)
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (ms *MemoryStore) UpdateIdP(_ context.Context, idHash string, tokens []byte, checkedAt time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if s, ok := ms.data[idHash]; ok {
+		s.IdPTokens = tokens
+		s.IdPCheckedAt = checkedAt
+		ms.data[idHash] = s
+	}
+	return nil
+}
+
+// セッションと索引から削除する（ロックは呼び出し側で取る）
+func (ms *MemoryStore) remove(idHash string) {
+	s, ok := ms.data[idHash]
+	if !ok {
+		return
+	}
+	delete(ms.data, idHash)
+
+	keys := ms.byUser[s.UserID]
+	delete(keys, idHash)
+	if len(keys) == 0 {
+		delete(ms.byUser, s.UserID)
+	}
+}
@@ -0,0 +1,250 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// MySQLStore は sessions テーブルに保存するStore実装
+// 期限切れの行は取得時に無視し、削除は別途行う
+type MySQLStore struct {
+	DB *sql.DB
+}
+
+func NewMySQLStore(DB *sql.DB) *MySQLStore {
+	return &MySQLStore{DB: DB}
+}
+
+func (ms *MySQLStore) Create(ctx context.Context, s *Session) error {
+	idHash, err := hex.DecodeString(s.IDHash)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO sessions (id_hash, user_id, created_at, last_seen_at, expires_at, ip, user_agent, provider, idp_tokens, idp_checked_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err = ms.DB.ExecContext(ctx, query,
+		idHash, s.UserID, s.CreatedAt, s.LastSeenAt, s.ExpiresAt, s.IP, s.UserAgent,
+		s.Provider, s.IdPTokens, nullTime(s.IdPCheckedAt),
+	)
+	return err
+}
+
+func (ms *MySQLStore) Get(ctx context.Context, idHash string) (*Session, error) {
+	key, err := hex.DecodeString(idHash)
+	if err != nil {
+		return nil, nil
+	}
+
+	query := `
+		SELECT user_id, created_at, last_seen_at, expires_at, ip, user_agent, provider, idp_tokens, idp_checked_at
+		FROM sessions
+		WHERE id_hash = ?
+	`
+
+	s := &Session{IDHash: idHash}
+	var checkedAt sql.NullTime
+	err = ms.DB.QueryRowContext(ctx, query, key).Scan(
+		&s.UserID,
+		&s.CreatedAt,
+		&s.LastSeenAt,
+		&s.ExpiresAt,
+		&s.IP,
+		&s.UserAgent,
+		&s.Provider,
+		&s.IdPTokens,
+		&checkedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	s.IdPCheckedAt = checkedAt.Time
+	return s, nil
+}
+
+func (ms *MySQLStore) Touch(ctx context.Context, idHash string, at, expiresAt time.Time) error {
+	key, err := hex.DecodeString(idHash)
+	if err != nil {
+		return nil
+	}
+
+	query := `
+		UPDATE sessions
+		SET last_seen_at = ?, expires_at = ?
+		WHERE id_hash = ?
+	`
+
+	_, err = ms.DB.ExecContext(ctx, query, at, expiresAt, key)
+	return err
+}
+
+func (ms *MySQLStore) Delete(ctx context.Context, idHash string) error {
+	key, err := hex.DecodeString(idHash)
+	if err != nil {
+		return nil
+	}
+
+	query := `
+		DELETE FROM sessions
+		WHERE id_hash = ?
+	`
+
+	_, err = ms.DB.ExecContext(ctx, query, key)
+	return err
+}
+
+func (ms *MySQLStore) ListByUser(ctx context.Context, userID int64) ([]*Session, error) {
+	query := `
+		SELECT id_hash, created_at, last_seen_at, expires_at, ip, user_agent
+		FROM sessions
+		WHERE user_id = ? AND expires_at > ?
+	`
+
+	rows, err := ms.DB.QueryContext(ctx, query, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var res []*Session
+	for rows.Next() {
+		var idHash []byte
+		s := &Session{UserID: userID}
+		if err := rows.Scan(
+			&idHash,
+			&s.CreatedAt,
+			&s.LastSeenAt,
+			&s.ExpiresAt,
+			&s.IP,
+			&s.UserAgent,
+		); err != nil {
+			return nil, err
+		}
+		s.IDHash = hex.EncodeToString(idHash)
+		res = append(res, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ms *MySQLStore) DeleteByUser(ctx context.Context, userID int64, exceptIDHash string) error {
+	// 空（全て削除）や不正な値の場合は、どの行とも一致しない空のキーで比較する
+	// （nilだとNULLとの比較になり、1行も削除されない）
+	except, err := hex.DecodeString(exceptIDHash)
+	if err != nil || except == nil {
+		except = []byte{}
+	}
+
+	query := `
+		DELETE FROM sessions
+		WHERE user_id = ? AND id_hash <> ?
+	`
+
+	_, err = ms.DB.ExecContext(ctx, query, userID, except)
+	return err
+}
+
+func (ms *MySQLStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM sessions
+		WHERE expires_at <= ?
+	`
+
+	res, err := ms.DB.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (ms *MySQLStore) Count(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM sessions
+		WHERE expires_at > ?
+	`
+
+	var n int64
+	err := ms.DB.QueryRowContext(ctx, query, now).Scan(&n)
+	return n, err
+}
+
+func (ms *MySQLStore) ListIdPStale(ctx context.Context, checkedBefore, now time.Time, limit int) ([]*Session, error) {
+	query := `
+		SELECT id_hash, user_id, created_at, last_seen_at, expires_at, ip, user_agent, provider, idp_tokens, idp_checked_at
+		FROM sessions
+		WHERE idp_tokens IS NOT NULL AND idp_checked_at < ? AND expires_at > ?
+		ORDER BY idp_checked_at
+		LIMIT ?
+	`
+
+	rows, err := ms.DB.QueryContext(ctx, query, checkedBefore, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var res []*Session
+	for rows.Next() {
+		var idHash []byte
+		var checkedAt sql.NullTime
+		s := &Session{}
+		if err := rows.Scan(
+			&idHash,
+			&s.UserID,
+			&s.CreatedAt,
+			&s.LastSeenAt,
+			&s.ExpiresAt,
+			&s.IP,
+			&s.UserAgent,
+			&s.Provider,
+			&s.IdPTokens,
+			&checkedAt,
+		); err != nil {
+			return nil, err
+		}
+		s.IDHash = hex.EncodeToString(idHash)
+		s.IdPCheckedAt = checkedAt.Time
+		res = append(res, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ms *MySQLStore) UpdateIdP(ctx context.Context, idHash string, tokens []byte, checkedAt time.Time) error {
+	key, err := hex.DecodeString(idHash)
+	if err != nil {
+		return nil
+	}
+
+	query := `
+		UPDATE sessions
+		SET idp_tokens = ?, idp_checked_at = ?
+		WHERE id_hash = ?
+	`
+
+	_, err = ms.DB.ExecContext(ctx, query, tokens, nullTime(checkedAt), key)
+	return err
+}
+
+// ゼロ値の日時はNULLとして保存する
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
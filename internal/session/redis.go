@@ -0,0 +1,346 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Redisのキーの接頭辞
+	redisKeyPrefix = "minkan:session:"
+
+	// ユーザーごとのセッションの索引（セッションのキーの集合）の接頭辞
+	redisUserKeyPrefix = "minkan:user-sessions:"
+
+	// 更新中に他の更新と競合した場合のやり直し回数
+	maxRedisUpdateRetries = 5
+)
+
+// errRedisConflict はWATCHしたセッションがEXECまでに他の更新・削除で変わった場合のエラー
+var errRedisConflict = errors.New("redis: session changed during update")
+
+// RedisStore はRedisプロトコルのサーバに保存するStore実装
+// セッションはJSONで保存し、有効期限をキーのTTLにする（期限切れのキーはサーバ側で消える）
+// ユーザーごとの索引は集合で持ち、期限切れで消えたセッションは一覧時に取り除く
+type RedisStore struct {
+	client *redisClient
+
+	// テスト用: 読み込み後、書き込みのトランザクションを始める前に呼ぶ
+	testHookBeforeExec func()
+}
+
+func NewRedisStore(addr, password string, db int) *RedisStore {
+	return &RedisStore{client: newRedisClient(addr, password, db)}
+}
+
+// 接続を確認する（起動時の設定ミスの検出用）
+func (rs *RedisStore) Ping(ctx context.Context) error {
+	_, err := rs.client.do(ctx, "PING")
+	return err
+}
+
+func (rs *RedisStore) Create(ctx context.Context, s *Session) error {
+	if err := rs.set(ctx, s); err != nil {
+		return err
+	}
+
+	userKey := redisUserKey(s.UserID)
+	if _, err := rs.client.do(ctx, "SADD", userKey, s.IDHash); err != nil {
+		return err
+	}
+	return rs.extendIndex(ctx, userKey, s.ExpiresAt)
+}
+
+func (rs *RedisStore) Get(ctx context.Context, idHash string) (*Session, error) {
+	res, err := rs.client.do(ctx, "GET", redisKeyPrefix+idHash)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	str, _ := res.(string)
+	s := &Session{}
+	if err := json.Unmarshal([]byte(str), s); err != nil {
+		return nil, err
+	}
+	s.IDHash = idHash
+	return s, nil
+}
+
+func (rs *RedisStore) Touch(ctx context.Context, idHash string, at, expiresAt time.Time) error {
+	s, err := rs.update(ctx, idHash, func(s *Session) {
+		s.LastSeenAt = at
+		s.ExpiresAt = expiresAt
+	})
+	if err != nil || s == nil {
+		return err
+	}
+	return rs.extendIndex(ctx, redisUserKey(s.UserID), expiresAt)
+}
+
+func (rs *RedisStore) Delete(ctx context.Context, idHash string) error {
+	s, err := rs.Get(ctx, idHash)
+	if err != nil {
+		return err
+	}
+
+	if _, err := rs.client.do(ctx, "DEL", redisKeyPrefix+idHash); err != nil {
+		return err
+	}
+	if s == nil {
+		return nil
+	}
+	_, err = rs.client.do(ctx, "SREM", redisUserKey(s.UserID), idHash)
+	return err
+}
+
+func (rs *RedisStore) ListByUser(ctx context.Context, userID int64) ([]*Session, error) {
+	userKey := redisUserKey(userID)
+	idHashes, err := rs.members(ctx, userKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Session
+	for _, idHash := range idHashes {
+		s, err := rs.Get(ctx, idHash)
+		if err != nil {
+			return nil, err
+		}
+
+		// 期限切れで消えたセッションは索引から取り除く
+		if s == nil {
+			if _, err := rs.client.do(ctx, "SREM", userKey, idHash); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+func (rs *RedisStore) DeleteByUser(ctx context.Context, userID int64, exceptIDHash string) error {
+	userKey := redisUserKey(userID)
+	idHashes, err := rs.members(ctx, userKey)
+	if err != nil {
+		return err
+	}
+
+	for _, idHash := range idHashes {
+		if idHash == exceptIDHash {
+			continue
+		}
+		if _, err := rs.client.do(ctx, "DEL", redisKeyPrefix+idHash); err != nil {
+			return err
+		}
+		if _, err := rs.client.do(ctx, "SREM", userKey, idHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 期限切れのキーはサーバ側で消えるため何もしない（索引からは一覧時に取り除く）
+func (rs *RedisStore) DeleteExpired(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// セッションのキーを数える
+func (rs *RedisStore) Count(ctx context.Context, _ time.Time) (int64, error) {
+	var n int64
+	err := rs.scan(ctx, func(keys []string) error {
+		n += int64(len(keys))
+		return nil
+	})
+	return n, err
+}
+
+// 全てのセッションを走査して探す（索引が無いため、件数に比例して時間がかかる）
+func (rs *RedisStore) ListIdPStale(ctx context.Context, checkedBefore, now time.Time, limit int) ([]*Session, error) {
+	var res []*Session
+	err := rs.scan(ctx, func(keys []string) error {
+		for _, key := range keys {
+			s, err := rs.Get(ctx, strings.TrimPrefix(key, redisKeyPrefix))
+			if err != nil {
+				return err
+			}
+			if s != nil && len(s.IdPTokens) > 0 && !s.IdPCheckedAt.IsZero() && s.IdPCheckedAt.Before(checkedBefore) && now.Before(s.ExpiresAt) {
+				res = append(res, s)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].IdPCheckedAt.Before(res[j].IdPCheckedAt)
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (rs *RedisStore) UpdateIdP(ctx context.Context, idHash string, tokens []byte, checkedAt time.Time) error {
+	_, err := rs.update(ctx, idHash, func(s *Session) {
+		s.IdPTokens = tokens
+		s.IdPCheckedAt = checkedAt
+	})
+	return err
+}
+
+// セッションを読み込んでfnで変更し、書き戻す（セッションが無い場合はnil, nil）
+// 読み込みから書き込みまでをWATCHで監視し、その間に削除・更新された場合は読み込みからやり直す
+// （削除されたセッションを書き戻して復活させたり、同時の更新を上書きして失ったりしないように）
+func (rs *RedisStore) update(ctx context.Context, idHash string, fn func(s *Session)) (*Session, error) {
+	key := redisKeyPrefix + idHash
+	for attempt := 0; ; attempt++ {
+		var s *Session
+		err := rs.client.withConn(ctx, func(rc *redisConn) error {
+			if _, err := rc.do(ctx, "WATCH", key); err != nil {
+				return err
+			}
+			res, err := rc.do(ctx, "GET", key)
+			if err != nil {
+				return err
+			}
+			if res == nil {
+				_, err := rc.do(ctx, "UNWATCH")
+				return err
+			}
+
+			str, _ := res.(string)
+			s = &Session{}
+			if err := json.Unmarshal([]byte(str), s); err != nil {
+				return err
+			}
+			s.IDHash = idHash
+			fn(s)
+
+			if rs.testHookBeforeExec != nil {
+				rs.testHookBeforeExec()
+			}
+
+			// 期限切れになった場合は消す（索引からは一覧時に取り除く）
+			write := []string{"DEL", key}
+			if ttl := time.Until(s.ExpiresAt).Milliseconds(); ttl > 0 {
+				b, err := json.Marshal(s)
+				if err != nil {
+					return err
+				}
+				write = []string{"SET", key, string(b), "PX", strconv.FormatInt(ttl, 10)}
+			}
+
+			for _, cmd := range [][]string{{"MULTI"}, write} {
+				if _, err := rc.do(ctx, cmd...); err != nil {
+					return err
+				}
+			}
+			res, err = rc.do(ctx, "EXEC")
+			if err != nil {
+				return err
+			}
+			if res == nil {
+				return errRedisConflict
+			}
+			return nil
+		})
+		if errors.Is(err, errRedisConflict) && attempt < maxRedisUpdateRetries {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+
+// セッションのキーをSCANで少しずつ走査する（サーバを長時間ブロックしない）
+func (rs *RedisStore) scan(ctx context.Context, fn func(keys []string) error) error {
+	cursor := "0"
+	for {
+		res, err := rs.client.do(ctx, "SCAN", cursor, "MATCH", redisKeyPrefix+"*", "COUNT", "1000")
+		if err != nil {
+			return err
+		}
+
+		// 応答は [次のカーソル, [キー...]]
+		items, _ := res.([]any)
+		if len(items) != 2 {
+			return errors.New("redis: unexpected SCAN reply")
+		}
+		raw, _ := items[1].([]any)
+		keys := make([]string, 0, len(raw))
+		for _, k := range raw {
+			if key, ok := k.(string); ok {
+				keys = append(keys, key)
+			}
+		}
+		if err := fn(keys); err != nil {
+			return err
+		}
+
+		cursor, _ = items[0].(string)
+		if cursor == "0" || cursor == "" {
+			return nil
+		}
+	}
+}
+
+// 有効期限までをTTLとして保存する（期限切れの場合は保存しない）
+func (rs *RedisStore) set(ctx context.Context, s *Session) error {
+	ttl := time.Until(s.ExpiresAt).Milliseconds()
+	if ttl <= 0 {
+		return rs.Delete(ctx, s.IDHash)
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = rs.client.do(ctx, "SET", redisKeyPrefix+s.IDHash, string(b), "PX", strconv.FormatInt(ttl, 10))
+	return err
+}
+
+// 索引のTTLを、ユーザーのセッションの中で最も遅い有効期限まで延ばす
+func (rs *RedisStore) extendIndex(ctx context.Context, userKey string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt).Milliseconds()
+	res, err := rs.client.do(ctx, "PTTL", userKey)
+	if err != nil {
+		return err
+	}
+
+	// -1（期限なし）・-2（キーなし）の場合も設定する
+	if cur, _ := res.(int64); cur >= 0 && cur >= ttl {
+		return nil
+	}
+	_, err = rs.client.do(ctx, "PEXPIRE", userKey, strconv.FormatInt(ttl, 10))
+	return err
+}
+
+func (rs *RedisStore) members(ctx context.Context, userKey string) ([]string, error) {
+	res, err := rs.client.do(ctx, "SMEMBERS", userKey)
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := res.([]any)
+	idHashes := make([]string, 0, len(items))
+	for _, item := range items {
+		if idHash, ok := item.(string); ok {
+			idHashes = append(idHashes, idHash)
+		}
+	}
+	return idHashes, nil
+}
+
+func redisUserKey(userID int64) string {
+	return redisUserKeyPrefix + strconv.FormatInt(userID, 10)
+}
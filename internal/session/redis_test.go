@@ -0,0 +1,180 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/session/fakeredis"
+)
+
+func newTestRedisStore(t *testing.T) *RedisStore {
+	t.Helper()
+	srv, err := fakeredis.NewServer("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+
+	rs := NewRedisStore(srv.Addr(), "secret", 1)
+	if err := rs.Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func testSession(idHash string, userID int64, expiresAt time.Time) *Session {
+	now := time.Now().Truncate(time.Second)
+	return &Session{IDHash: idHash, UserID: userID, CreatedAt: now, LastSeenAt: now, ExpiresAt: expiresAt, IP: "192.0.2.1"}
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	rs := newTestRedisStore(t)
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	for _, s := range []*Session{testSession("a", 1, exp), testSession("b", 1, exp), testSession("c", 2, exp)} {
+		if err := rs.Create(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, err := rs.Get(ctx, "a")
+	if err != nil || s == nil || s.UserID != 1 || s.IP != "192.0.2.1" || !s.ExpiresAt.Equal(exp) {
+		t.Fatalf("Get = %+v, %v", s, err)
+	}
+	if s, err := rs.Get(ctx, "missing"); s != nil || err != nil {
+		t.Errorf("Get(missing) = %+v, %v", s, err)
+	}
+
+	// Touch・UpdateIdPはそれぞれの項目のみ変更する
+	seen, later := exp.Add(-time.Minute), exp.Add(time.Hour)
+	if err := rs.Touch(ctx, "a", seen, later); err != nil {
+		t.Fatal(err)
+	}
+	checked := time.Now().Truncate(time.Second)
+	if err := rs.UpdateIdP(ctx, "a", []byte("tokens"), checked); err != nil {
+		t.Fatal(err)
+	}
+	s, err = rs.Get(ctx, "a")
+	if err != nil || !s.LastSeenAt.Equal(seen) || !s.ExpiresAt.Equal(later) || string(s.IdPTokens) != "tokens" || !s.IdPCheckedAt.Equal(checked) {
+		t.Errorf("after Touch/UpdateIdP = %+v, %v", s, err)
+	}
+	if err := rs.Touch(ctx, "missing", seen, later); err != nil {
+		t.Errorf("Touch(missing) = %v", err)
+	}
+	if s, _ := rs.Get(ctx, "missing"); s != nil {
+		t.Errorf("Touch created a session: %+v", s)
+	}
+
+	stale, err := rs.ListIdPStale(ctx, checked.Add(time.Second), time.Now(), 10)
+	if err != nil || len(stale) != 1 || stale[0].IDHash != "a" {
+		t.Errorf("ListIdPStale = %+v, %v", stale, err)
+	}
+	if n, err := rs.Count(ctx, time.Now()); n != 3 || err != nil {
+		t.Errorf("Count = %d, %v", n, err)
+	}
+
+	if err := rs.Delete(ctx, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.Delete(ctx, "b"); err != nil {
+		t.Errorf("second Delete = %v", err)
+	}
+	list, err := rs.ListByUser(ctx, 1)
+	if err != nil || len(list) != 1 || list[0].IDHash != "a" {
+		t.Errorf("ListByUser after Delete = %+v, %v", list, err)
+	}
+
+	// 除外したセッションと他のユーザーのセッションは残る
+	if err := rs.Create(ctx, testSession("d", 1, exp)); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.DeleteByUser(ctx, 1, "d"); err != nil {
+		t.Fatal(err)
+	}
+	for idHash, want := range map[string]bool{"a": false, "c": true, "d": true} {
+		if s, err := rs.Get(ctx, idHash); (s != nil) != want || err != nil {
+			t.Errorf("after DeleteByUser %s = %+v, %v", idHash, s, err)
+		}
+	}
+}
+
+func TestRedisStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	rs := newTestRedisStore(t)
+
+	if err := rs.Create(ctx, testSession("short", 1, time.Now().Add(50*time.Millisecond))); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.Create(ctx, testSession("long", 1, time.Now().Add(time.Hour))); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if s, err := rs.Get(ctx, "short"); s != nil || err != nil {
+		t.Errorf("expired session = %+v, %v", s, err)
+	}
+	list, err := rs.ListByUser(ctx, 1)
+	if err != nil || len(list) != 1 || list[0].IDHash != "long" {
+		t.Errorf("ListByUser = %+v, %v", list, err)
+	}
+
+	// 期限を過去にするTouchはセッションを消す
+	if err := rs.Touch(ctx, "long", time.Now(), time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := rs.Get(ctx, "long"); s != nil || err != nil {
+		t.Errorf("session touched into the past = %+v, %v", s, err)
+	}
+}
+
+// 読み込みから書き込みまでの間に削除されたセッションを復活させない
+func TestRedisStoreTouchAfterConcurrentDelete(t *testing.T) {
+	ctx := context.Background()
+	rs := newTestRedisStore(t)
+	exp := time.Now().Add(time.Hour)
+	if err := rs.Create(ctx, testSession("a", 1, exp)); err != nil {
+		t.Fatal(err)
+	}
+
+	rs.testHookBeforeExec = func() {
+		rs.testHookBeforeExec = nil
+		if err := rs.Delete(ctx, "a"); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := rs.Touch(ctx, "a", time.Now(), exp.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := rs.Get(ctx, "a"); s != nil || err != nil {
+		t.Errorf("deleted session resurrected: %+v, %v", s, err)
+	}
+}
+
+// 同時の更新を上書きしない
+func TestRedisStoreTouchAfterConcurrentUpdate(t *testing.T) {
+	ctx := context.Background()
+	rs := newTestRedisStore(t)
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := rs.Create(ctx, testSession("a", 1, exp)); err != nil {
+		t.Fatal(err)
+	}
+
+	checked := time.Now().Truncate(time.Second)
+	rs.testHookBeforeExec = func() {
+		rs.testHookBeforeExec = nil
+		if err := rs.UpdateIdP(ctx, "a", []byte("tokens"), checked); err != nil {
+			t.Error(err)
+		}
+	}
+	later := exp.Add(time.Hour)
+	if err := rs.Touch(ctx, "a", time.Now(), later); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := rs.Get(ctx, "a")
+	if err != nil || s == nil || !s.ExpiresAt.Equal(later) || string(s.IdPTokens) != "tokens" || !s.IdPCheckedAt.Equal(checked) {
+		t.Errorf("session = %+v, %v", s, err)
+	}
+}
@@ -0,0 +1,195 @@
+package session
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// プールに残す接続数の上限
+	maxIdleConns = 8
+
+	// 接続・コマンドのタイムアウト（ctxに期限が無い場合）
+	redisTimeout = 3 * time.Second
+)
+
+// RedisError はサーバが返したエラー応答（-ERR ...）
+type RedisError string
+
+func (e RedisError) Error() string { return string(e) }
+
+// redisClient はRedisプロトコル(RESP2)の最小限のクライアント
+// 使うコマンドが少ないため外部ライブラリは使わず、接続のプールのみ行う
+type redisClient struct {
+	addr     string
+	password string
+	db       int
+
+	mu   sync.Mutex
+	idle []*redisConn
+}
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newRedisClient(addr, password string, db int) *redisClient {
+	return &redisClient{addr: addr, password: password, db: db}
+}
+
+// do はコマンドを送り、応答を返す
+// 応答は string（+ と $）、int64（:）、nil（$-1 / *-1）、[]any（*）のいずれか
+func (c *redisClient) do(ctx context.Context, args ...string) (any, error) {
+	rc, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := rc.do(ctx, args...)
+
+	// サーバのエラー応答以外（通信エラー等）の場合は接続を捨てる
+	var redisErr RedisError
+	if err != nil && !errors.As(err, &redisErr) {
+		_ = rc.conn.Close()
+		return nil, err
+	}
+	c.put(rc)
+	return res, err
+}
+
+// withConn は1つの接続でfnを実行する（WATCH〜EXECのように同じ接続で送る必要があるコマンド用）
+// fnがエラーを返した場合は、トランザクションの途中の状態を残さないよう接続を捨てる
+func (c *redisClient) withConn(ctx context.Context, fn func(rc *redisConn) error) error {
+	rc, err := c.get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(rc); err != nil {
+		_ = rc.conn.Close()
+		return err
+	}
+	c.put(rc)
+	return nil
+}
+
+func (c *redisClient) get(ctx context.Context) (*redisConn, error) {
+	c.mu.Lock()
+	if n := len(c.idle); n > 0 {
+		rc := c.idle[n-1]
+		c.idle = c.idle[:n-1]
+		c.mu.Unlock()
+		return rc, nil
+	}
+	c.mu.Unlock()
+
+	d := net.Dialer{Timeout: redisTimeout}
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, err
+	}
+	rc := &redisConn{conn: conn, r: bufio.NewReader(conn)}
+
+	if c.password != "" {
+		if _, err := rc.do(ctx, "AUTH", c.password); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	if c.db != 0 {
+		if _, err := rc.do(ctx, "SELECT", strconv.Itoa(c.db)); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return rc, nil
+}
+
+func (c *redisClient) put(rc *redisConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.idle) >= maxIdleConns {
+		_ = rc.conn.Close()
+		return
+	}
+	c.idle = append(c.idle, rc)
+}
+
+func (rc *redisConn) do(ctx context.Context, args ...string) (any, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(redisTimeout)
+	}
+	if err := rc.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, a := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(a), a)
+	}
+	if _, err := io.WriteString(rc.conn, b.String()); err != nil {
+		return nil, err
+	}
+	return readReply(rc.r)
+}
+
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("redis: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, RedisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]any, 0, n)
+		for i := 0; i < n; i++ {
+			item, err := readReply(r)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("redis: unexpected reply %q", line)
+}
@@ -0,0 +1,157 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/metrics"
+)
+
+const (
+	// 1回に取得して確認するセッションの件数
+	revalidateBatch = 100
+
+	// IdPへの1回の問い合わせの上限
+	revalidateTimeout = 30 * time.Second
+)
+
+// IdPChecker はrefresh tokenでIdPにトークンを再発行させ、アカウントが有効か確認する
+type IdPChecker interface {
+	// 再発行されたトークンを返す（IdPが再発行しなかった項目は空）
+	// アカウントの無効化・同意の取り消し等でIdPが拒否した場合は revoked=true
+	// IdPに到達できない等、判断できない場合は error を返す
+	Refresh(ctx context.Context, provider string, tokens IdPTokens) (renewed IdPTokens, revoked bool, err error)
+}
+
+// Revalidator はIdPのトークンを持つセッションを定期的にIdPで確認し、IdPで無効になったアカウントのセッションを削除する
+// refresh tokenの無いセッション（IdPが発行しない場合）は確認しない
+// IdPに到達できない場合はセッションを残し、次の間隔で再度確認する
+type Revalidator struct {
+	Manager  *SessionManager
+	Checker  IdPChecker
+	Interval time.Duration // 同じセッションを確認する間隔
+	revoked  *metrics.Counter
+	failures *metrics.Counter
+}
+
+func NewRevalidator(sm *SessionManager, checker IdPChecker, interval time.Duration, reg *metrics.Registry) *Revalidator {
+	return &Revalidator{
+		Manager:  sm,
+		Checker:  checker,
+		Interval: interval,
+		revoked:  reg.Counter("minkan_sessions_idp_revoked_total", "Number of login sessions removed because the identity provider rejected the refresh token."),
+		failures: reg.Counter("minkan_sessions_idp_check_failures_total", "Number of session checks that could not reach a decision from the identity provider."),
+	}
+}
+
+// ctxが終了するまで定期的に実行する（Intervalが0以下の場合は何もしない）
+func (rv *Revalidator) Run(ctx context.Context) {
+	lg := slog.Default().With("module", "session")
+	if rv.Interval <= 0 {
+		lg.Info("session revalidation disabled")
+		return
+	}
+	lg.Info("session revalidator started", "interval", rv.Interval.String())
+
+	ticker := time.NewTicker(rv.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			lg.Info("session revalidator stopped")
+			return
+		case <-ticker.C:
+		}
+
+		rv.revalidate(ctx)
+	}
+}
+
+// 確認が Interval 以上前のセッションを全て確認する
+func (rv *Revalidator) revalidate(ctx context.Context) {
+	lg := slog.Default().With("module", "session")
+
+	// 確認したセッションは確認日時が進むため、同じセッションを繰り返し取得しない
+	checkedBefore := time.Now().Add(-rv.Interval)
+	for {
+		sessions, err := rv.Manager.ListIdPStale(ctx, checkedBefore, revalidateBatch)
+		if err != nil {
+			if ctx.Err() == nil {
+				lg.Error("list sessions to revalidate failed", "err", err)
+			}
+			return
+		}
+
+		for _, s := range sessions {
+			if !rv.check(ctx, s) {
+				return
+			}
+		}
+
+		if len(sessions) < revalidateBatch {
+			return
+		}
+	}
+}
+
+// セッション1件をIdPで確認する（保存先のエラー等で続けられない場合はfalse）
+func (rv *Revalidator) check(ctx context.Context, s *Session) bool {
+	lg := slog.Default().With("module", "session", "userID", s.UserID, "provider", s.Provider)
+
+	tokens, err := rv.Manager.IdPTokens(s)
+	if err != nil || tokens.RefreshToken == "" {
+		// 復号できない（鍵の変更等）・refresh tokenが無い場合は確認できないため、以降の対象から外す
+		lg.Warn("session has no usable refresh token", "err", err)
+		return rv.mark(ctx, s, IdPTokens{}, time.Time{})
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, revalidateTimeout)
+	renewed, revoked, err := rv.Checker.Refresh(checkCtx, s.Provider, tokens)
+	cancel()
+
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if revoked {
+		if err := rv.Manager.deleteByHash(ctx, s.IDHash); err != nil {
+			lg.Error("delete revoked session failed", "err", err)
+			return false
+		}
+		rv.revoked.Add(1)
+		lg.Info("session revoked by identity provider")
+		return true
+	}
+
+	if err != nil {
+		// IdPが不調の場合はセッションを残し、次の間隔で再度確認する
+		rv.failures.Add(1)
+		lg.Warn("identity provider check failed", "err", err)
+		return rv.mark(ctx, s, IdPTokens{}, time.Now())
+	}
+
+	// 再発行されなかった項目は保存済みの値を使う
+	if renewed.RefreshToken == "" {
+		renewed.RefreshToken = tokens.RefreshToken
+	}
+	if renewed.IDToken == "" {
+		renewed.IDToken = tokens.IDToken
+	}
+	if renewed == tokens {
+		renewed = IdPTokens{}
+	}
+	return rv.mark(ctx, s, renewed, time.Now())
+}
+
+// 確認日時を更新する（renewedが空の場合は保存済みのトークンを保つ）
+func (rv *Revalidator) mark(ctx context.Context, s *Session, renewed IdPTokens, at time.Time) bool {
+	if err := rv.Manager.MarkIdPChecked(ctx, s, renewed, at); err != nil {
+		if ctx.Err() == nil {
+			slog.Default().With("module", "session").Error("update session idp check failed", "err", err)
+		}
+		return false
+	}
+	return true
+}
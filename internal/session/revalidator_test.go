@@ -0,0 +1,156 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/metrics"
+)
+
+// refresh tokenごとに結果を返すIdP
+type fakeChecker struct {
+	mu      sync.Mutex
+	revoked map[string]bool
+	failing map[string]bool
+	renew   map[string]IdPTokens
+	calls   []string
+}
+
+func (f *fakeChecker) Refresh(_ context.Context, _ string, tokens IdPTokens) (IdPTokens, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, tokens.RefreshToken)
+	switch {
+	case f.revoked[tokens.RefreshToken]:
+		return IdPTokens{}, true, nil
+	case f.failing[tokens.RefreshToken]:
+		return IdPTokens{}, false, errors.New("idp unavailable")
+	}
+	return f.renew[tokens.RefreshToken], false, nil
+}
+
+// 最後のIdPでの確認が1時間前のセッションを作成する
+func createStaleSession(t *testing.T, sm *SessionManager, store *MemoryStore, userID int64, refreshToken string) string {
+	t.Helper()
+	ctx := context.Background()
+	sessID, err := sm.CreateSession(ctx, userID, Meta{Provider: "google", IdPTokens: IdPTokens{RefreshToken: refreshToken, IDToken: "id-" + refreshToken}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := store.Get(ctx, HashID(sessID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateIdP(ctx, s.IDHash, s.IdPTokens, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	return sessID
+}
+
+func TestRevalidatorDeletesRevokedSessions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	checker := &fakeChecker{revoked: map[string]bool{"revoked-1": true, "revoked-2": true}}
+	rv := NewRevalidator(sm, checker, 10*time.Minute, metrics.NewRegistry())
+
+	// IdPで無効になったユーザーのセッション（2端末）と、有効なユーザーのセッション
+	revoked1 := createStaleSession(t, sm, store, 1, "revoked-1")
+	revoked2 := createStaleSession(t, sm, store, 1, "revoked-2")
+	active := createStaleSession(t, sm, store, 2, "active")
+
+	closed := make(chan struct{})
+	stop := sm.Watch(revoked1, 1, func() { close(closed) })
+	defer stop()
+
+	rv.revalidate(ctx)
+
+	for _, id := range []string{revoked1, revoked2} {
+		if _, ok := sm.GetSession(ctx, id); ok {
+			t.Error("session with a revoked refresh token was kept")
+		}
+	}
+	if _, ok := sm.GetSession(ctx, active); !ok {
+		t.Error("active session was deleted")
+	}
+	if got := rv.revoked.Value(); got != 2 {
+		t.Errorf("revoked counter = %d, want 2", got)
+	}
+	select {
+	case <-closed:
+	default:
+		t.Error("connection of the revoked session was not closed")
+	}
+}
+
+// IdPに到達できない場合はセッションを残し、次の間隔まで再確認しない
+func TestRevalidatorKeepsSessionsOnIdPError(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	checker := &fakeChecker{failing: map[string]bool{"rt": true}}
+	rv := NewRevalidator(sm, checker, 10*time.Minute, metrics.NewRegistry())
+
+	sessID := createStaleSession(t, sm, store, 1, "rt")
+	rv.revalidate(ctx)
+
+	s, err := store.Get(ctx, HashID(sessID))
+	if err != nil || s == nil {
+		t.Fatalf("session deleted on an IdP error: %v", err)
+	}
+	if time.Since(s.IdPCheckedAt) > time.Minute {
+		t.Errorf("checked at %v was not updated", s.IdPCheckedAt)
+	}
+	if tokens, err := sm.IdPTokens(s); err != nil || tokens.RefreshToken != "rt" {
+		t.Errorf("tokens after failure = %+v, %v", tokens, err)
+	}
+	if got := rv.failures.Value(); got != 1 {
+		t.Errorf("failures counter = %d, want 1", got)
+	}
+
+	rv.revalidate(ctx)
+	if len(checker.calls) != 1 {
+		t.Errorf("refreshed %d times, want once per interval", len(checker.calls))
+	}
+}
+
+func TestRevalidatorStoresRenewedTokens(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	checker := &fakeChecker{renew: map[string]IdPTokens{"rt": {RefreshToken: "rt-2"}}}
+	rv := NewRevalidator(sm, checker, 10*time.Minute, metrics.NewRegistry())
+
+	sessID := createStaleSession(t, sm, store, 1, "rt")
+	rv.revalidate(ctx)
+
+	s, _ := store.Get(ctx, HashID(sessID))
+	if s == nil {
+		t.Fatal("session deleted")
+	}
+	// 再発行されなかったIDトークンは保存済みの値を保つ
+	if tokens, err := sm.IdPTokens(s); err != nil || tokens != (IdPTokens{RefreshToken: "rt-2", IDToken: "id-rt"}) {
+		t.Errorf("tokens = %+v, %v", tokens, err)
+	}
+}
+
+// 復号できないトークンは確認できないため、以降の対象から外す（セッションは残す）
+func TestRevalidatorSkipsUnusableTokens(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	sessID := createStaleSession(t, sm, store, 1, "rt")
+
+	// 鍵を変更した後のプロセス
+	other := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "new-token-key")
+	checker := &fakeChecker{}
+	NewRevalidator(other, checker, 10*time.Minute, metrics.NewRegistry()).revalidate(ctx)
+
+	s, _ := store.Get(ctx, HashID(sessID))
+	if s == nil || !s.IdPCheckedAt.IsZero() || len(checker.calls) != 0 {
+		t.Errorf("session = %+v, refresh calls %v", s, checker.calls)
+	}
+}
@@ -1,58 +1,330 @@
 package session
 
 import (
-	"sync"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"net"
+	"net/http"
+	"sort"
 	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/token"
+)
+
+const (
+	// セッションIDのランダム部分のバイト数
+	sessionIDBytes = 32
+
+	// 最終アクセス日時を更新する間隔（リクエストごとの書き込みを避ける）
+	touchInterval = time.Minute
+
+	// セッションIDの再発行後、元のIDを使える猶予
+	// 再発行と同時に元のIDで送られていたリクエスト（別タブ等）を失敗させないため
+	// touchIntervalより短くすること（猶予中のアクセスで有効期限が延長されないように）
+	rotateGrace = 30 * time.Second
+
+	// User-Agentの最大長（超えた分は切り詰める）
+	maxUserAgentLen = 255
 )
 
-type SessionData struct {
-	UserID    int64 // DB上のユーザーID
-	ExpiresAt time.Time
+// Meta はセッション作成時の情報（接続元・ログインに使ったIdP）
+type Meta struct {
+	IP        string
+	UserAgent string
+	Provider  string    // ログインに使ったIdP（AUTH_PROVIDERS の識別子）
+	IdPTokens IdPTokens // IdPから受け取ったトークン（暗号化して保存する）
+}
+
+// リクエストから接続元の情報を取り出す（プロキシのヘッダは偽装できるため使わない）
+func MetaFromRequest(r *http.Request) Meta {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	ua := r.UserAgent()
+	if len(ua) > maxUserAgentLen {
+		ua = ua[:maxUserAgentLen]
+	}
+	return Meta{IP: ip, UserAgent: ua}
 }
 
+// SessionManager はセッションの発行・検証・削除を行う
+// 保存先はStoreで差し替える（プロセス内・MySQL・Redis）
+// Cookieに入れるセッションIDは保存せず、SHA-256のみを保存先のキーにする
+//
+// 有効期限は次の早い方
+// - 最終アクセスから idleTTL（アクセスのたびに延長する）
+// - 作成から maxLifetime（延長しない）
 type SessionManager struct {
-	mu   sync.Mutex
-	data map[string]SessionData
-	ttl  time.Duration
+	store       Store
+	idleTTL     time.Duration
+	maxLifetime time.Duration
+	csrfSecret  []byte       // CSRFトークンの署名鍵
+	sealer      *tokenSealer // IdPのトークンの暗号化
+	conns       connRegistry // セッションで認証した長時間接続（削除時に切断する）
 }
 
-func NewSessionManager(ttl time.Duration) *SessionManager {
+func NewSessionManager(store Store, idleTTL, maxLifetime time.Duration, csrfSecret, tokenKey string) *SessionManager {
 	return &SessionManager{
-		data: make(map[string]SessionData),
-		ttl:  ttl,
+		store:       store,
+		idleTTL:     idleTTL,
+		maxLifetime: maxLifetime,
+		csrfSecret:  []byte(csrfSecret),
+		sealer:      newTokenSealer(tokenKey),
 	}
 }
 
-func (sm *SessionManager) CreateSession(sessID string, userID int64) {
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
-	sm.data[sessID] = SessionData{
-		UserID:    userID,
-		ExpiresAt: time.Now().Add(sm.ttl),
+// セッションを作成し、CookieにいれるセッションIDを返す
+func (sm *SessionManager) CreateSession(ctx context.Context, userID int64, meta Meta) (string, error) {
+	sessID, err := newSessionID()
+	if err != nil {
+		return "", err
 	}
+
+	sealed, err := sm.sealer.seal(userID, meta.IdPTokens)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	s := &Session{
+		IDHash:     HashID(sessID),
+		UserID:     userID,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  sm.expiresAt(now, now),
+		IP:         meta.IP,
+		UserAgent:  meta.UserAgent,
+		Provider:   meta.Provider,
+		IdPTokens:  sealed,
+	}
+	// ログイン直後はIdPで確認済みとして扱う（refresh tokenが無い場合は確認しない）
+	if meta.IdPTokens.RefreshToken != "" {
+		s.IdPCheckedAt = now
+	}
+	if err := sm.store.Create(ctx, s); err != nil {
+		return "", err
+	}
+	return sessID, nil
 }
 
-func (sm *SessionManager) GetSession(sessID string) (int64, bool) {
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
-	sessData, ok := sm.data[sessID]
+// RotateSession はセッションIDを再発行し、新しいセッションIDとセッションを返す
+// 元のIDは rotateGrace の間だけ使え、その後は期限切れになる
+// 作成日時（最大の有効期間の起点）とログイン時の接続元・IdPの情報は引き継ぐ
+// 元のセッションが無い・期限切れの場合は nil を返す
+func (sm *SessionManager) RotateSession(ctx context.Context, sessID string) (string, *Session, error) {
+	if sessID == "" {
+		return "", nil, nil
+	}
+
+	oldHash := HashID(sessID)
+	old, err := sm.store.Get(ctx, oldHash)
+	if err != nil {
+		return "", nil, err
+	}
+	now := time.Now()
+	if old == nil || !now.Before(old.ExpiresAt) {
+		return "", nil, nil
+	}
+
+	newID, err := newSessionID()
+	if err != nil {
+		return "", nil, err
+	}
+
+	s := *old
+	s.IDHash = HashID(newID)
+	s.LastSeenAt = now
+	s.ExpiresAt = sm.expiresAt(old.CreatedAt, now)
+	if err := sm.store.Create(ctx, &s); err != nil {
+		return "", nil, err
+	}
+
+	// 最終アクセスを現在にして、猶予中にValidateSessionで延長されないようにする
+	graceEnd := now.Add(rotateGrace)
+	if graceEnd.After(old.ExpiresAt) {
+		graceEnd = old.ExpiresAt
+	}
+	if err := sm.store.Touch(ctx, oldHash, now, graceEnd); err != nil {
+		return "", nil, err
+	}
+	sm.conns.rename(oldHash, s.IDHash)
+	return newID, &s, nil
+}
 
-	// sessionがない or 有効期限切れの場合はsession無しでreturn
-	if !ok || time.Now().After(sessData.ExpiresAt) {
-		// 有効期限切れの場合は削除
-		delete(sm.data, sessID)
+// セッションIDを検証し、ユーザーIDを返す（無い・期限切れ・保存先のエラーの場合はfalse）
+func (sm *SessionManager) GetSession(ctx context.Context, sessID string) (int64, bool) {
+	s, _ := sm.ValidateSession(ctx, sessID)
+	if s == nil {
 		return 0, false
 	}
+	return s.UserID, true
+}
+
+// セッションIDを検証し、セッションを返す（無い・期限切れ・保存先のエラーの場合はnil）
+// 最終アクセスから touchInterval 以上経っている場合は有効期限を延長し、renewed=true を返す
+// （呼び出し側でCookieのMaxAgeを更新する）
+func (sm *SessionManager) ValidateSession(ctx context.Context, sessID string) (s *Session, renewed bool) {
+	lg := slog.Default().With("module", "session")
+
+	if sessID == "" {
+		return nil, false
+	}
+
+	idHash := HashID(sessID)
+	s, err := sm.store.Get(ctx, idHash)
+	if err != nil {
+		lg.Error("get session failed", "err", err)
+		return nil, false
+	}
+
+	now := time.Now()
+	if s == nil || !now.Before(s.ExpiresAt) {
+		return nil, false
+	}
+
+	if now.Sub(s.LastSeenAt) < touchInterval {
+		return s, false
+	}
 
-	return sessData.UserID, true
+	expiresAt := sm.expiresAt(s.CreatedAt, now)
+	if err := sm.store.Touch(ctx, idHash, now, expiresAt); err != nil {
+		// 延長できなくても今回のリクエストは有効なセッションとして扱う
+		lg.Warn("touch session failed", "err", err)
+		return s, false
+	}
+	s.LastSeenAt = now
+	s.ExpiresAt = expiresAt
+	return s, true
+}
+
+// セッションを削除し、そのセッションの長時間接続を切断する（無い場合も成功とする）
+func (sm *SessionManager) DeleteSession(ctx context.Context, sessID string) error {
+	return sm.deleteByHash(ctx, HashID(sessID))
+}
+
+func (sm *SessionManager) deleteByHash(ctx context.Context, idHash string) error {
+	if err := sm.store.Delete(ctx, idHash); err != nil {
+		return err
+	}
+	sm.conns.closeSession(idHash)
+	return nil
+}
+
+// ユーザーの有効なセッションを最終アクセス日時の新しい順に返す
+func (sm *SessionManager) ListSessions(ctx context.Context, userID int64) ([]*Session, error) {
+	sessions, err := sm.store.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := make([]*Session, 0, len(sessions))
+	for _, s := range sessions {
+		if now.Before(s.ExpiresAt) {
+			res = append(res, s)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].LastSeenAt.After(res[j].LastSeenAt)
+	})
+	return res, nil
+}
+
+// ユーザーのセッションを1件削除する（無い・他のユーザーのセッションの場合はfalse）
+func (sm *SessionManager) RevokeSession(ctx context.Context, userID int64, idHash string) (bool, error) {
+	s, err := sm.store.Get(ctx, idHash)
+	if err != nil {
+		return false, err
+	}
+	if s == nil || s.UserID != userID {
+		return false, nil
+	}
+	return true, sm.deleteByHash(ctx, idHash)
+}
+
+// 現在のセッション（currentSessID）以外のユーザーのセッションを全て削除する
+func (sm *SessionManager) RevokeOtherSessions(ctx context.Context, userID int64, currentSessID string) error {
+	exceptHash := HashID(currentSessID)
+	if err := sm.store.DeleteByUser(ctx, userID, exceptHash); err != nil {
+		return err
+	}
+	sm.conns.closeUser(userID, exceptHash)
+	return nil
+}
+
+// ユーザーのセッションを全て削除する（退会時等）
+func (sm *SessionManager) DeleteUserSessions(ctx context.Context, userID int64) error {
+	if err := sm.store.DeleteByUser(ctx, userID, ""); err != nil {
+		return err
+	}
+	sm.conns.closeUser(userID, "")
+	return nil
+}
+
+// セッションに保存したIdPのトークンを復号する（保存されていない場合は空のトークン）
+func (sm *SessionManager) IdPTokens(s *Session) (IdPTokens, error) {
+	return sm.sealer.open(s.UserID, s.IdPTokens)
+}
+
+// IdPで確認が必要なセッション（最後の確認が checkedBefore より前）を確認の古い順に最大limit件返す
+func (sm *SessionManager) ListIdPStale(ctx context.Context, checkedBefore time.Time, limit int) ([]*Session, error) {
+	return sm.store.ListIdPStale(ctx, checkedBefore, time.Now(), limit)
 }
 
-func (sm *SessionManager) DeleteSession(sessID string) {
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
-	delete(sm.data, sessID)
+// IdPでの確認日時を更新する（tokensはIdPが再発行したトークン。空の場合は保存済みのトークンを保つ）
+// atがゼロ値の場合は以降確認しない
+func (sm *SessionManager) MarkIdPChecked(ctx context.Context, s *Session, tokens IdPTokens, at time.Time) error {
+	sealed := s.IdPTokens
+	if !tokens.empty() {
+		var err error
+		sealed, err = sm.sealer.seal(s.UserID, tokens)
+		if err != nil {
+			return err
+		}
+	}
+	return sm.store.UpdateIdP(ctx, s.IDHash, sealed, at)
 }
 
+// 作成直後のセッションの有効期間（ログイン時のCookieのMaxAge）
 func (sm *SessionManager) GetTTL() time.Duration {
-	return sm.ttl
+	return min(sm.idleTTL, sm.maxLifetime)
+}
+
+// 期限切れのセッションを削除し、削除した件数を返す
+func (sm *SessionManager) DeleteExpired(ctx context.Context) (int64, error) {
+	return sm.store.DeleteExpired(ctx, time.Now())
+}
+
+// 有効なセッションの件数を返す
+func (sm *SessionManager) CountActive(ctx context.Context) (int64, error) {
+	return sm.store.Count(ctx, time.Now())
+}
+
+// 最終アクセス日時 now のセッションの有効期限
+func (sm *SessionManager) expiresAt(createdAt, now time.Time) time.Time {
+	idle := now.Add(sm.idleTTL)
+	absolute := createdAt.Add(sm.maxLifetime)
+	if absolute.Before(idle) {
+		return absolute
+	}
+	return idle
+}
+
+// ランダムなセッションIDを生成する
+func newSessionID() (string, error) {
+	buf := make([]byte, sessionIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashID はセッションIDから保存先のキー（SHA-256の16進表記）を求める
+// 一覧・個別の無効化ではセッションIDの代わりにこのキーを識別子として使う
+func HashID(sessID string) string {
+	return hex.EncodeToString(token.Hash(sessID))
 }
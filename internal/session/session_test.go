@@ -0,0 +1,257 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/metrics"
+)
+
+func TestRotateSession(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+
+	oldID, err := sm.CreateSession(ctx, 1, Meta{IP: "192.0.2.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 最終アクセスを古くし、猶予中のアクセスで延長されるか確認できるようにする
+	created, _ := store.Get(ctx, HashID(oldID))
+	if err := store.Touch(ctx, HashID(oldID), time.Now().Add(-10*time.Minute), created.ExpiresAt); err != nil {
+		t.Fatal(err)
+	}
+
+	newID, s, err := sm.RotateSession(ctx, oldID)
+	if err != nil || s == nil || newID == "" || newID == oldID {
+		t.Fatalf("RotateSession = %q, %+v, %v", newID, s, err)
+	}
+	if s.IP != "192.0.2.1" || !s.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("rotated session lost login info: %+v", s)
+	}
+	if userID, ok := sm.GetSession(ctx, newID); !ok || userID != 1 {
+		t.Errorf("new session = %d, %v", userID, ok)
+	}
+
+	// 元のIDは猶予の間だけ使え、アクセスしても延長されない
+	old, renewed := sm.ValidateSession(ctx, oldID)
+	if old == nil || renewed {
+		t.Fatalf("old session during grace = %+v, renewed %v", old, renewed)
+	}
+	if limit := time.Now().Add(rotateGrace); old.ExpiresAt.After(limit) {
+		t.Errorf("old session expires at %v, after the grace period %v", old.ExpiresAt, limit)
+	}
+
+	// 猶予を過ぎると使えない
+	if err := store.Touch(ctx, HashID(oldID), time.Now(), time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sm.GetSession(ctx, oldID); ok {
+		t.Error("old session valid after the grace period")
+	}
+	if _, ok := sm.GetSession(ctx, newID); !ok {
+		t.Error("new session invalid after the grace period of the old one")
+	}
+
+	// 無い・空のセッションは再発行しない
+	if id, s, err := sm.RotateSession(ctx, "missing"); id != "" || s != nil || err != nil {
+		t.Errorf("RotateSession(missing) = %q, %+v, %v", id, s, err)
+	}
+}
+
+// セッションを削除すると、そのセッションで認証した長時間接続を切断する
+func TestWatchClosesOnDelete(t *testing.T) {
+	ctx := context.Background()
+	sm := NewSessionManager(NewMemoryStore(), time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+
+	newSession := func(userID int64) string {
+		t.Helper()
+		id, err := sm.CreateSession(ctx, userID, Meta{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	closed := map[string]int{}
+	watch := func(name, sessID string, userID int64) func() {
+		return sm.Watch(sessID, userID, func() { closed[name]++ })
+	}
+
+	a, b, c, other := newSession(1), newSession(1), newSession(1), newSession(2)
+	watch("a", a, 1)
+	watch("b", b, 1)
+	stopC := watch("c", c, 1)
+	watch("other", other, 2)
+	watch("stopped", a, 1)()
+
+	// 再発行した接続は元のIDの削除では切断しない
+	rotated, _, err := sm.RotateSession(ctx, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.DeleteSession(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if closed["b"] != 0 {
+		t.Fatal("connection closed by deleting the ID before rotation")
+	}
+
+	if err := sm.DeleteSession(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	if closed["a"] != 1 || closed["stopped"] != 0 {
+		t.Errorf("after DeleteSession: %v", closed)
+	}
+
+	// 他のセッションの無効化では現在のセッションの接続を残す
+	if err := sm.RevokeOtherSessions(ctx, 1, c); err != nil {
+		t.Fatal(err)
+	}
+	if closed["b"] != 1 || closed["c"] != 0 || closed["other"] != 0 {
+		t.Errorf("after RevokeOtherSessions: %v", closed)
+	}
+	if _, ok := sm.GetSession(ctx, rotated); ok {
+		t.Error("rotated session survived RevokeOtherSessions")
+	}
+
+	stopC()
+	if err := sm.DeleteUserSessions(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if closed["c"] != 0 {
+		t.Errorf("closed an unwatched connection: %v", closed)
+	}
+
+	if ok, err := sm.RevokeSession(ctx, 2, HashID(other)); !ok || err != nil {
+		t.Fatalf("RevokeSession = %v, %v", ok, err)
+	}
+	if closed["other"] != 1 {
+		t.Errorf("after RevokeSession: %v", closed)
+	}
+}
+
+// アクセスのたびに有効期限を延長するが、touchInterval以内の再アクセスでは書き込まず、作成からmaxLifetimeを超えて延長しない
+func TestValidateSessionSlidingExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 90*time.Minute, "csrf-secret", "token-key")
+
+	sessID, err := sm.CreateSession(ctx, 1, Meta{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, _ := store.Get(ctx, HashID(sessID))
+
+	// 作成直後は延長しない
+	if s, renewed := sm.ValidateSession(ctx, sessID); s == nil || renewed {
+		t.Fatalf("fresh session = %+v, renewed %v", s, renewed)
+	}
+
+	// 最終アクセスからtouchInterval以上経つと延長する
+	lastSeen := time.Now().Add(-touchInterval - time.Second)
+	if err := store.Touch(ctx, HashID(sessID), lastSeen, lastSeen.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now()
+	s, renewed := sm.ValidateSession(ctx, sessID)
+	if s == nil || !renewed {
+		t.Fatalf("idle session = %+v, renewed %v", s, renewed)
+	}
+	if s.LastSeenAt.Before(before) || s.ExpiresAt.Before(before.Add(time.Hour)) {
+		t.Errorf("renewed to last seen %v, expires %v", s.LastSeenAt, s.ExpiresAt)
+	}
+	stored, _ := store.Get(ctx, HashID(sessID))
+	if !stored.ExpiresAt.Equal(s.ExpiresAt) || !stored.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("stored = %+v, want expiry %v and created %v", stored, s.ExpiresAt, created.CreatedAt)
+	}
+
+	// 続けてのアクセスでは延長しない
+	if s, renewed := sm.ValidateSession(ctx, sessID); s == nil || renewed {
+		t.Errorf("second access = %+v, renewed %v", s, renewed)
+	}
+
+	// 最大の有効期間で打ち切る
+	capped := NewSessionManager(store, time.Hour, 30*time.Minute, "csrf-secret", "token-key")
+	if err := store.Touch(ctx, HashID(sessID), lastSeen, lastSeen.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	s, renewed = capped.ValidateSession(ctx, sessID)
+	if s == nil || !renewed {
+		t.Fatalf("capped session = %+v, renewed %v", s, renewed)
+	}
+	if want := created.CreatedAt.Add(30 * time.Minute); !s.ExpiresAt.Equal(want) {
+		t.Errorf("capped expiry = %v, want %v", s.ExpiresAt, want)
+	}
+
+	// 期限切れのセッションは延長しない
+	if err := store.Touch(ctx, HashID(sessID), lastSeen, time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if s, renewed := sm.ValidateSession(ctx, sessID); s != nil || renewed {
+		t.Errorf("expired session = %+v, renewed %v", s, renewed)
+	}
+}
+
+// 期限切れのセッションを削除し、削除件数とアクティブなセッション数をメトリクスに反映する
+func TestSweeper(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sm := NewSessionManager(store, time.Hour, 24*time.Hour, "csrf-secret", "token-key")
+	sw := NewSweeper(sm, time.Hour, metrics.NewRegistry())
+
+	var ids []string
+	for userID := int64(1); userID <= 4; userID++ {
+		id, err := sm.CreateSession(ctx, userID, Meta{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	for _, id := range ids[:3] {
+		if err := store.Touch(ctx, HashID(id), time.Now(), time.Now().Add(-time.Second)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sw.sweep(ctx)
+	if got := sw.expired.Value(); got != 3 {
+		t.Errorf("expired = %d, want 3", got)
+	}
+	if got := sw.active.Value(); got != 1 {
+		t.Errorf("active = %d, want 1", got)
+	}
+	for i, id := range ids {
+		s, _ := store.Get(ctx, HashID(id))
+		if (s != nil) != (i == 3) {
+			t.Errorf("session %d stored = %v", i, s != nil)
+		}
+	}
+
+	// Runは起動直後に1回実行し、ctxの終了で戻る
+	if _, err := sm.CreateSession(ctx, 5, Meta{}); err != nil {
+		t.Fatal(err)
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		sw.Run(runCtx)
+		close(done)
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for sw.active.Value() != 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("active = %d after Run, want 2", sw.active.Value())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+	if got := sw.expired.Value(); got != 3 {
+		t.Errorf("expired after Run = %d, want 3", got)
+	}
+}
@@ -0,0 +1,58 @@
+package session
+
+import (
+	"context"
+	"time"
+)
+
+// Session は保存するセッション1件
+type Session struct {
+	IDHash     string // セッションIDのSHA-256（16進表記）
+	UserID     int64  // DB上のユーザーID
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	IP         string
+	UserAgent  string
+
+	// ログインに使ったIdP（ログアウト時のトークンの失効・IdPでの再確認に使う）
+	Provider     string    // AUTH_PROVIDERS の識別子（IdPを使わないセッションは空）
+	IdPTokens    []byte    // 暗号化したIdPのトークン（IdPTokens。無い場合はnil）
+	IdPCheckedAt time.Time // 最後にIdPでアカウントを確認（を試行）した日時（refresh tokenが無く確認しない場合はゼロ値）
+}
+
+// Store はセッションの保存先を抽象化したもの
+// - MemoryStore: プロセス内（再起動でログアウトされる。開発・単一インスタンス向け）
+// - MySQLStore: sessions テーブル（複数インスタンスで共有できる）
+// - RedisStore: Redisプロトコルのサーバ（複数インスタンスで共有できる）
+type Store interface {
+	// セッションを保存する
+	Create(ctx context.Context, s *Session) error
+
+	// セッションを取得する（無い場合はnil, nil。期限切れの判定は呼び出し側で行う）
+	Get(ctx context.Context, idHash string) (*Session, error)
+
+	// 最終アクセス日時と有効期限を更新する（無い場合は何もしない）
+	Touch(ctx context.Context, idHash string, at, expiresAt time.Time) error
+
+	// セッションを削除する（無い場合も成功とする）
+	Delete(ctx context.Context, idHash string) error
+
+	// ユーザーのセッションを一覧する（期限切れを含んでもよい）
+	ListByUser(ctx context.Context, userID int64) ([]*Session, error)
+
+	// ユーザーのセッションを削除する（exceptIDHashは残す。空の場合は全て削除）
+	DeleteByUser(ctx context.Context, userID int64, exceptIDHash string) error
+
+	// 期限切れのセッションを削除し、削除した件数を返す（保存先が自動で消す場合は0）
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+
+	// 有効なセッションの件数を返す
+	Count(ctx context.Context, now time.Time) (int64, error)
+
+	// IdPで確認するセッションのうち、確認が checkedBefore より前の有効なセッションを、確認の古い順に最大limit件返す
+	ListIdPStale(ctx context.Context, checkedBefore, now time.Time, limit int) ([]*Session, error)
+
+	// IdPのトークンと確認日時を更新する（checkedAtがゼロ値の場合は以降確認しない。無い場合は何もしない）
+	UpdateIdP(ctx context.Context, idHash string, tokens []byte, checkedAt time.Time) error
+}
@@ -0,0 +1,72 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/metrics"
+)
+
+// Sweeper は期限切れのセッションを定期的に削除し、アクティブなセッション数をメトリクスに反映する
+// （保存先が期限切れを自動で消さない場合、提示されないセッションが残り続けるため）
+type Sweeper struct {
+	Manager  *SessionManager
+	Interval time.Duration // 削除・集計の間隔
+	active   *metrics.Gauge
+	expired  *metrics.Counter
+}
+
+func NewSweeper(sm *SessionManager, interval time.Duration, reg *metrics.Registry) *Sweeper {
+	return &Sweeper{
+		Manager:  sm,
+		Interval: interval,
+		active:   reg.Gauge("minkan_sessions_active", "Number of unexpired login sessions."),
+		expired:  reg.Counter("minkan_sessions_expired_total", "Number of expired login sessions removed by the sweeper."),
+	}
+}
+
+// ctxが終了するまで定期的に実行する（起動直後にも1回実行する）
+func (sw *Sweeper) Run(ctx context.Context) {
+	lg := slog.Default().With("module", "session")
+	lg.Info("session sweeper started", "interval", sw.Interval.String())
+
+	ticker := time.NewTicker(sw.Interval)
+	defer ticker.Stop()
+
+	for {
+		sw.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			lg.Info("session sweeper stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (sw *Sweeper) sweep(ctx context.Context) {
+	lg := slog.Default().With("module", "session")
+
+	n, err := sw.Manager.DeleteExpired(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			lg.Error("delete expired sessions failed", "err", err)
+		}
+		return
+	}
+	sw.expired.Add(n)
+	if n > 0 {
+		lg.Info("expired sessions deleted", "count", n)
+	}
+
+	active, err := sw.Manager.CountActive(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			lg.Error("count sessions failed", "err", err)
+		}
+		return
+	}
+	sw.active.Set(active)
+}
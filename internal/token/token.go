@@ -0,0 +1,28 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// 生成するトークンのランダム部分のバイト数
+const randomBytes = 32
+
+// New は "<prefix>_<ランダム文字列>" 形式のトークンを生成する
+// トークン自体は発行時に一度だけ利用者へ返し、DBにはHashの結果のみを保存すること
+// prefixは用途の判別用（ログやシークレットスキャンで見分けやすくするため）
+func New(prefix string) (string, error) {
+	buf := make([]byte, randomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return prefix + "_" + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Hash はトークンの保存・照合用のハッシュ(SHA-256)を返す
+// ランダムなトークンのため、ソルトや低速なハッシュは不要
+func Hash(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
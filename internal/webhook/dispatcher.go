@@ -0,0 +1,282 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+const (
+	// 1回の確認で送信する最大件数（並行して送信する）
+	batchSize = 20
+
+	// 1件の送信のタイムアウト
+	sendTimeout = 10 * time.Second
+
+	// 送信中のまま停止した配信を他のインスタンスが取り直すまでの時間（sendTimeoutより十分長くする）
+	claimLease = 2 * time.Minute
+
+	// 最大試行回数（超えた配信は断念する）
+	maxAttempts = 10
+
+	// 再送間隔（試行ごとに倍にし、maxBackoffで打ち切る）
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+
+	// 配信記録の保持期間
+	deliveryRetention = 30 * 24 * time.Hour
+
+	// 接続を再利用するために読み捨てるレスポンスボディの最大サイズ
+	maxResponseBytes = 64 << 10
+)
+
+// ErrForbiddenAddress は送信先がプライベートネットワーク等のアドレスに解決された場合のエラー
+var ErrForbiddenAddress = errors.New("webhook: forbidden destination address")
+
+// Dispatcher は配信キュー(webhook_deliveries)から送信時刻を過ぎた配信を送信する
+// - 2xx以外・接続失敗は指数バックオフで再送し、maxAttempts回で断念する
+// - 確保はClaimDeliveriesで排他するため、複数インスタンスで動かしても同じ配信を同時に送らない
+type Dispatcher struct {
+	Repo     *repository.WebhookRepository
+	Client   *http.Client
+	Interval time.Duration // 配信キューを確認する間隔
+}
+
+func NewDispatcher(repo *repository.WebhookRepository, interval time.Duration, allowPrivateNetworks bool) *Dispatcher {
+	guard := CheckPublicAddr
+	if allowPrivateNetworks {
+		guard = nil
+	}
+	return &Dispatcher{
+		Repo:     repo,
+		Client:   NewClient(guard),
+		Interval: interval,
+	}
+}
+
+// AddrGuard は接続直前に名前解決後の接続先アドレスを判定し、接続しない場合はエラーを返す
+type AddrGuard func(addr netip.Addr) error
+
+// NewClient はWebhook送信用のHTTPクライアントを返す
+// - リダイレクトは追わない（3xxは失敗扱い）
+// - guardがnilでない場合、接続先アドレスをguardで判定する（通常はCheckPublicAddr）
+// （ユーザーが指定したURLから内部ネットワークへアクセスされないよう、名前解決後のアドレスで判定する）
+func NewClient(guard AddrGuard) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if guard != nil {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			return guard(ap.Addr())
+		}
+	}
+
+	return &http.Client{
+		Timeout: sendTimeout,
+		Transport: &http.Transport{
+			Proxy:               nil, // 接続先アドレスの判定を確実にするためプロキシは使わない
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+			MaxIdleConns:        20,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// 送信を拒否するアドレス範囲（IANA Special-Purpose Address Registry のうちグローバルに到達できないもの等）
+var blockedPrefixes = []netip.Prefix{
+	// IPv4
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("10.0.0.0/8"),      // プライベート
+	netip.MustParsePrefix("100.64.0.0/10"),   // CGNAT（共有アドレス）
+	netip.MustParsePrefix("127.0.0.0/8"),     // ループバック
+	netip.MustParsePrefix("169.254.0.0/16"),  // リンクローカル（クラウドのメタデータ等）
+	netip.MustParsePrefix("172.16.0.0/12"),   // プライベート
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETFプロトコル割当
+	netip.MustParsePrefix("192.0.2.0/24"),    // ドキュメント用(TEST-NET-1)
+	netip.MustParsePrefix("192.88.99.0/24"),  // 6to4リレー
+	netip.MustParsePrefix("192.168.0.0/16"),  // プライベート
+	netip.MustParsePrefix("198.18.0.0/15"),   // ベンチマーク用
+	netip.MustParsePrefix("198.51.100.0/24"), // ドキュメント用(TEST-NET-2)
+	netip.MustParsePrefix("203.0.113.0/24"),  // ドキュメント用(TEST-NET-3)
+	netip.MustParsePrefix("224.0.0.0/4"),     // マルチキャスト
+	netip.MustParsePrefix("240.0.0.0/4"),     // 予約済み・ブロードキャスト
+	// IPv6
+	netip.MustParsePrefix("::/128"),         // 未指定
+	netip.MustParsePrefix("::1/128"),        // ループバック
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64（IPv4アドレスを埋め込む）
+	netip.MustParsePrefix("64:ff9b:1::/48"), // ローカル用NAT64
+	netip.MustParsePrefix("100::/64"),       // 破棄用
+	netip.MustParsePrefix("2001::/23"),      // IETFプロトコル割当（Teredo等）
+	netip.MustParsePrefix("2001:db8::/32"),  // ドキュメント用
+	netip.MustParsePrefix("2002::/16"),      // 6to4（IPv4アドレスを埋め込む）
+	netip.MustParsePrefix("fc00::/7"),       // ユニークローカル
+	netip.MustParsePrefix("fe80::/10"),      // リンクローカル
+	netip.MustParsePrefix("ff00::/8"),       // マルチキャスト
+}
+
+// CheckPublicAddr はaddrがblockedPrefixesに含まれる場合にErrForbiddenAddressを返す
+// IPv4射影アドレス(::ffff:a.b.c.d)はIPv4アドレスとして判定する
+func CheckPublicAddr(addr netip.Addr) error {
+	a := addr.Unmap()
+	if !a.IsValid() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(a.WithZone("")) {
+			return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+		}
+	}
+	return nil
+}
+
+// ctxが終了するまでInterval毎に配信キューを確認して送信する
+func (d *Dispatcher) Run(ctx context.Context) {
+	lg := slog.Default().With("module", "webhook")
+	lg.Info("webhook dispatcher started", "interval", d.Interval.String())
+
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+
+	for {
+		d.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			lg.Info("webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) tick(ctx context.Context) {
+	lg := slog.Default().With("module", "webhook")
+	now := time.Now()
+
+	// 確保できる限り続けて送信する（1回の確認で確保するのはbatchSize件まで）
+	for ctx.Err() == nil {
+		deliveries, err := d.Repo.ClaimDeliveries(ctx, now, batchSize, claimLease)
+		if err != nil {
+			if ctx.Err() == nil {
+				lg.Error("claim webhook deliveries failed", "err", err)
+			}
+			return
+		}
+		if len(deliveries) == 0 {
+			break
+		}
+
+		var wg sync.WaitGroup
+		for i := range deliveries {
+			wg.Add(1)
+			go func(dl *repository.WebhookDelivery) {
+				defer wg.Done()
+				d.deliver(ctx, dl)
+			}(&deliveries[i])
+		}
+		wg.Wait()
+
+		if len(deliveries) < batchSize {
+			break
+		}
+	}
+
+	if err := d.Repo.DeleteDeliveriesBefore(ctx, now.Add(-deliveryRetention)); err != nil && ctx.Err() == nil {
+		lg.Warn("delete old webhook deliveries failed", "err", err)
+	}
+}
+
+// 配信を1回送信し、結果を記録する
+func (d *Dispatcher) deliver(ctx context.Context, dl *repository.WebhookDelivery) {
+	lg := slog.Default().With("module", "webhook",
+		"deliveryID", dl.ID, "webhookID", dl.WebhookID, "userID", dl.UserID, "eventType", dl.EventType, "attempt", dl.Attempts)
+
+	status, sendErr := d.send(ctx, dl)
+
+	now := time.Now()
+	dl.LastStatusCode = nil
+	if status != 0 {
+		dl.LastStatusCode = &status
+	}
+	dl.LastError = ""
+
+	switch {
+	case sendErr == nil:
+		dl.Status = repository.DeliverySucceeded
+		lg.Info("webhook delivered", "status", status)
+	case dl.Attempts >= maxAttempts:
+		dl.Status = repository.DeliveryFailed
+		dl.LastError = sendErr.Error()
+		lg.Warn("webhook delivery gave up", "status", status, "err", sendErr)
+	default:
+		dl.Status = repository.DeliveryPending
+		dl.NextAttemptAt = now.Add(Backoff(dl.Attempts))
+		dl.LastError = sendErr.Error()
+		lg.Info("webhook delivery failed, will retry", "status", status, "err", sendErr, "nextAttemptAt", dl.NextAttemptAt)
+	}
+
+	// 停止中でも結果は記録する（記録しないとlease後に再送される）
+	if err := d.Repo.FinishDelivery(context.WithoutCancel(ctx), dl, now); err != nil {
+		lg.Error("finish webhook delivery failed", "err", err)
+	}
+}
+
+// 送信してHTTPステータスを返す（接続できなかった場合は0）。2xx以外はエラー
+func (d *Dispatcher) send(ctx context.Context, dl *repository.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dl.URL, bytes.NewReader(dl.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "Minkan-Webhook/1.0")
+	req.Header.Set("X-Minkan-Event", dl.EventType)
+	req.Header.Set("X-Minkan-Delivery", strconv.FormatInt(dl.ID, 10))
+	req.Header.Set("X-Minkan-Timestamp", strconv.FormatInt(ts, 10))
+	req.Header.Set("X-Minkan-Signature", Sign(dl.Secret, ts, dl.Payload))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBytes))
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// Backoff はattempts回目の送信に失敗した後、次に送信するまでの間隔
+func Backoff(attempts int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
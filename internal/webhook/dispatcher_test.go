@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+func TestCheckPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		blocked bool
+	}{
+		{"8.8.8.8", false},
+		{"1.1.1.1", false},
+		{"2606:4700:4700::1111", false},
+		{"0.0.0.0", true},
+		{"10.1.2.3", true},
+		{"100.64.0.1", true},
+		{"100.127.255.254", true},
+		{"127.0.0.1", true},
+		{"169.254.169.254", true},
+		{"172.16.0.1", true},
+		{"192.0.0.8", true},
+		{"192.168.1.1", true},
+		{"198.18.0.1", true},
+		{"198.19.255.255", true},
+		{"224.0.0.1", true},
+		{"255.255.255.255", true},
+		{"::", true},
+		{"::1", true},
+		{"::ffff:127.0.0.1", true},
+		{"::ffff:10.0.0.1", true},
+		{"::ffff:8.8.8.8", false},
+		{"64:ff9b::a9fe:a9fe", true},
+		{"2002:7f00:1::", true},
+		{"fd00::1", true},
+		{"fe80::1%eth0", true},
+		{"ff02::1", true},
+	}
+
+	for _, tt := range tests {
+		err := CheckPublicAddr(netip.MustParseAddr(tt.addr))
+		if blocked := err != nil; blocked != tt.blocked {
+			t.Errorf("%s: blocked = %v, want %v (err = %v)", tt.addr, blocked, tt.blocked, err)
+		}
+		if err != nil && !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("%s: err = %v, want ErrForbiddenAddress", tt.addr, err)
+		}
+	}
+}
+
+// ループバックのみ許可し、判定したアドレスを記録するguard（ローカルの受信サーバー向け）
+type recordingGuard struct {
+	mu    sync.Mutex
+	addrs []netip.Addr
+}
+
+func (g *recordingGuard) check(addr netip.Addr) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.addrs = append(g.addrs, addr)
+	if !addr.Unmap().IsLoopback() {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+func TestSend(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	got := make(chan received, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got <- received{header: r.Header.Clone(), body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	guard := &recordingGuard{}
+	d := &Dispatcher{Client: NewClient(guard.check)}
+	dl := &repository.WebhookDelivery{
+		ID:        12,
+		EventType: "node.completed",
+		Payload:   []byte(`{"id":"evt_1","type":"node.completed"}`),
+		URL:       srv.URL + "/hook",
+		Secret:    "whsec_test",
+	}
+
+	status, err := d.send(context.Background(), dl)
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("send = %d, %v", status, err)
+	}
+	if len(guard.addrs) != 1 {
+		t.Errorf("guard checked %v, want one address", guard.addrs)
+	}
+
+	r := <-got
+	if string(r.body) != string(dl.Payload) {
+		t.Errorf("body = %s", r.body)
+	}
+	if r.header.Get("X-Minkan-Event") != "node.completed" || r.header.Get("X-Minkan-Delivery") != "12" {
+		t.Errorf("headers = %v", r.header)
+	}
+
+	// 受信側と同じ手順で署名を検証する
+	ts, err := strconv.ParseInt(r.header.Get("X-Minkan-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatalf("X-Minkan-Timestamp = %q", r.header.Get("X-Minkan-Timestamp"))
+	}
+	if sig := r.header.Get("X-Minkan-Signature"); sig != Sign("whsec_test", ts, r.body) {
+		t.Errorf("signature %q does not verify", sig)
+	}
+	if Sign("other", ts, r.body) == r.header.Get("X-Minkan-Signature") {
+		t.Error("signature verifies with another secret")
+	}
+}
+
+func TestSendFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirect":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		case "/error":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer srv.Close()
+
+	guard := &recordingGuard{}
+	d := &Dispatcher{Client: NewClient(guard.check)}
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/error", http.StatusInternalServerError},
+		// リダイレクトは追わずに失敗扱い
+		{"/redirect", http.StatusFound},
+	}
+	for _, tt := range tests {
+		status, err := d.send(context.Background(), &repository.WebhookDelivery{URL: srv.URL + tt.path, Payload: []byte(`{}`)})
+		if err == nil || status != tt.status {
+			t.Errorf("%s: send = %d, %v; want %d with error", tt.path, status, err, tt.status)
+		}
+	}
+
+	// 既定の判定ではローカルの受信サーバーに接続しない
+	d = &Dispatcher{Client: NewClient(CheckPublicAddr)}
+	status, err := d.send(context.Background(), &repository.WebhookDelivery{URL: srv.URL, Payload: []byte(`{}`)})
+	if !errors.Is(err, ErrForbiddenAddress) || status != 0 {
+		t.Errorf("send to loopback = %d, %v; want ErrForbiddenAddress", status, err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	if got := Backoff(1); got != baseBackoff {
+		t.Errorf("Backoff(1) = %v", got)
+	}
+	if got := Backoff(2); got != 2*baseBackoff {
+		t.Errorf("Backoff(2) = %v", got)
+	}
+	if got := Backoff(maxAttempts); got != maxBackoff {
+		t.Errorf("Backoff(%d) = %v", maxAttempts, got)
+	}
+}
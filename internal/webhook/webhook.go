@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/yopi416/mind-kanban-backend/internal/events"
+	"github.com/yopi416/mind-kanban-backend/internal/minkan"
+	"github.com/yopi416/mind-kanban-backend/internal/repository"
+)
+
+// 疎通確認用のイベントの種類（購読の有無に関わらず POST /webhooks/{webhookId}/ping で送る）
+const TypePing = "ping"
+
+// Payload は送信するリクエストボディ
+type Payload struct {
+	ID        string    `json:"id"` // イベントID（同じイベントは全ての送信先・再送で同じ値）
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+	Version   int32     `json:"version,omitempty"` // イベントが発生した更新後のstateのversion
+	Data      any       `json:"data"`
+}
+
+func NewEventID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return "evt_" + hex.EncodeToString(b)
+}
+
+// Sign はX-Minkan-Signatureの値を返す
+// 署名対象は "<X-Minkan-Timestamp>.<リクエストボディ>" で、受信側は同じ値を計算して比較する
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Enqueuer はstateの更新からイベントを検出し、購読しているWebhookへの配信を登録する
+type Enqueuer struct {
+	Repo *repository.WebhookRepository
+}
+
+func NewEnqueuer(repo *repository.WebhookRepository) *Enqueuer {
+	return &Enqueuer{Repo: repo}
+}
+
+// minkan.Store.InTx で更新フックとして登録する
+// 配信はstateの更新と同じトランザクションで登録するため、更新されたイベントは必ず配信され、
+// 登録に失敗した場合は更新ごと失敗する
+func (e *Enqueuer) Record(ctx context.Context, tx *sql.Tx, c *minkan.Change) error {
+	// 変換できなかった場合はStore側でログ出力済み
+	if c.Before == nil || c.After == nil {
+		return nil
+	}
+
+	hooks, err := e.Repo.ListWebhooks(ctx, c.UserID, true)
+	if err != nil {
+		return fmt.Errorf("list webhooks: %w", err)
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	deliveries := []repository.WebhookDelivery{}
+	for _, ev := range events.Diff(c.Before, c.After) {
+		p := &Payload{
+			ID:        NewEventID(),
+			Type:      ev.Type,
+			CreatedAt: c.UpdatedAt,
+			Version:   c.Version,
+			Data:      ev,
+		}
+		body, err := json.Marshal(p)
+		if err != nil {
+			return fmt.Errorf("marshal webhook payload: %w", err)
+		}
+
+		for _, w := range hooks {
+			if !slices.Contains(w.EventTypes, ev.Type) {
+				continue
+			}
+			deliveries = append(deliveries, repository.WebhookDelivery{
+				WebhookID: w.ID,
+				UserID:    c.UserID,
+				EventID:   p.ID,
+				EventType: p.Type,
+				Payload:   body,
+			})
+		}
+	}
+
+	if err := e.Repo.InsertDeliveries(ctx, tx, deliveries); err != nil {
+		return fmt.Errorf("insert webhook deliveries: %w", err)
+	}
+	return nil
+}